@@ -11,5 +11,8 @@ func initServer() {
 	// Create a new server
 	server, err := server.NewServer()
 
-	server.Logger.Fatal(err)
+	// Exit if the server wasn't shut down gracefully
+	if err != nil {
+		server.Logger.Fatal(err)
+	}
 }
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"main/core/constants"
+	"main/internal/providers/storage"
+)
+
+// initStorage is a helper function that connects to the storage
+// of the uploaded assets.
+//
+// Returns void
+func initStorage() {
+	// Connect to the storage
+	err := storage.Connect()
+
+	// Check if there is an error connecting to the storage
+	if err != nil {
+		panic("Error connecting to the storage: " + err.Error())
+	}
+
+	// Move the private assets stored before the private storage existed out of the public storage
+	err = storage.MoveToPrivate(constants.PRIVATE_STORAGE_PATHS)
+
+	// Check if there is an error moving the private assets
+	if err != nil {
+		panic("Error moving the private assets: " + err.Error())
+	}
+}
@@ -6,7 +6,7 @@ import (
 )
 
 // LoadEnv is a function that loads the environment variables.
-// It loads the database, JWT, midtrans, and server configuration.
+// It loads the database, JWT, midtrans, server, upload, storage, mail, and review configuration.
 //
 // Returns void.
 func LoadEnv() {
@@ -14,7 +14,7 @@ func LoadEnv() {
 	var wg sync.WaitGroup
 
 	// Add the number of configurations to load
-	wg.Add(4)
+	wg.Add(8)
 
 	// Load the configurations in parallel
 	go func() {
@@ -38,6 +38,30 @@ func LoadEnv() {
 		wg.Done()
 	}()
 
+	go func() {
+		config.UploadConfig.LoadData()
+
+		wg.Done()
+	}()
+
+	go func() {
+		config.StorageConfig.LoadData()
+
+		wg.Done()
+	}()
+
+	go func() {
+		config.MailConfig.LoadData()
+
+		wg.Done()
+	}()
+
+	go func() {
+		config.ReviewConfig.LoadData()
+
+		wg.Done()
+	}()
+
 	// Wait for all the configurations to load
 	wg.Wait()
 }
@@ -10,6 +10,9 @@ func Run() {
 	// Initialize Midtrans
 	initMidtrans()
 
+	// Initialize the storage
+	initStorage()
+
 	// Initialize the server
 	initServer()
 }
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"main/core/config"
+	"main/core/constants"
+	"main/internal/providers/storage"
+	"mime"
+	"path"
+
+	"github.com/joho/godotenv"
+)
+
+// copyAssets is a function that copies the assets of a storage path from the source
+// storage to the destination storage, assets already in the destination are skipped.
+//
+// src: The source storage.
+// dst: The destination storage.
+// prefix: The storage path.
+//
+// Returns the number of copied and skipped assets.
+func copyAssets(src storage.Storage, dst storage.Storage, prefix string) (int, int) {
+	// Get the keys of the assets
+	keys, err := src.List(prefix)
+
+	// Check if there is an error
+	if err != nil {
+		panic("Failed to list " + prefix + ": " + err.Error())
+	}
+
+	// Create the counters
+	copied, skipped := 0, 0
+
+	// Loop through the keys
+	for _, key := range keys {
+		// Check if the asset is already copied
+		exists, err := dst.Exists(key)
+
+		// Check if there is an error
+		if err != nil {
+			panic("Failed to check " + key + ": " + err.Error())
+		}
+
+		// Skip the copied asset
+		if exists {
+			skipped++
+
+			continue
+		}
+
+		// Read the asset
+		data, err := src.Get(key)
+
+		// Check if there is an error
+		if err != nil {
+			panic("Failed to read " + key + ": " + err.Error())
+		}
+
+		// Write the asset
+		err = dst.Put(key, data, mime.TypeByExtension(path.Ext(key)))
+
+		// Check if there is an error
+		if err != nil {
+			panic("Failed to write " + key + ": " + err.Error())
+		}
+
+		fmt.Println("Copied", key)
+
+		copied++
+	}
+
+	return copied, skipped
+}
+
+// main is the entry point of the program.
+func main() {
+	// Get the storage drivers from the flags
+	from := flag.String("from", "local", "The source storage driver [local|s3]")
+	to := flag.String("to", "s3", "The destination storage driver [local|s3]")
+
+	flag.Parse()
+
+	// Check if the storage drivers are different
+	if *from == *to {
+		panic("Source and destination storage drivers must be different")
+	}
+
+	// Load the environment variables
+	err := godotenv.Load()
+
+	// Check if there is an error loading the environment variables
+	if err != nil {
+		panic("Error loading environment variables: " + err.Error())
+	}
+
+	// Load the storage configuration
+	config.StorageConfig.LoadData()
+
+	// Create the source storage
+	src, err := storage.New(*from, config.StorageConfig)
+
+	// Check if there is an error
+	if err != nil {
+		panic("Error connecting to the source storage: " + err.Error())
+	}
+
+	// Create the destination storage
+	dst, err := storage.New(*to, config.StorageConfig)
+
+	// Check if there is an error
+	if err != nil {
+		panic("Error connecting to the destination storage: " + err.Error())
+	}
+
+	// Create the private source storage
+	privateSrc, err := storage.NewPrivate(*from, config.StorageConfig)
+
+	// Check if there is an error
+	if err != nil {
+		panic("Error connecting to the private source storage: " + err.Error())
+	}
+
+	// Create the private destination storage
+	privateDst, err := storage.NewPrivate(*to, config.StorageConfig)
+
+	// Check if there is an error
+	if err != nil {
+		panic("Error connecting to the private destination storage: " + err.Error())
+	}
+
+	fmt.Println("Migrate storage program")
+	fmt.Println("=====================================")
+
+	// Create the counters
+	totalCopied, totalSkipped := 0, 0
+
+	// Loop through the storage paths
+	for _, prefix := range constants.STORAGE_PATHS {
+		copied, skipped := copyAssets(src, dst, prefix+"/")
+
+		totalCopied += copied
+		totalSkipped += skipped
+	}
+
+	// Loop through the private storage paths
+	for _, prefix := range constants.PRIVATE_STORAGE_PATHS {
+		copied, skipped := copyAssets(privateSrc, privateDst, prefix+"/")
+
+		totalCopied += copied
+		totalSkipped += skipped
+	}
+
+	fmt.Printf("\nStorage migrated successfully! %d copied, %d skipped\n", totalCopied, totalSkipped)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"main/core/config"
+	"main/internal/providers/mysql"
+	"main/internal/repository"
+
+	"github.com/joho/godotenv"
+)
+
+// main is the entry point of the program.
+func main() {
+	// Load the environment variables
+	err := godotenv.Load()
+
+	// Check if there is an error loading the environment variables
+	if err != nil {
+		panic("Error loading environment variables: " + err.Error())
+	}
+
+	// Load the database configuration
+	config.DBConfig.LoadData()
+
+	// Connect to the database
+	err = mysql.Connect()
+
+	// Check if there is an error connecting to the database
+	if err != nil {
+		panic("Error connecting to the database: " + err.Error())
+	}
+
+	// Close the database connection
+	defer func() {
+		err := mysql.CloseConnection()
+
+		// Check if there is an error closing the database connection
+		if err != nil {
+			panic("Error closing the database connection: " + err.Error())
+		}
+	}()
+
+	fmt.Println("Rebuild review summaries program")
+	fmt.Println("=====================================")
+
+	// Rebuild the review summaries
+	count, err := repository.NewReviewSummaryRepository().Rebuild()
+
+	// Check if there is an error rebuilding the review summaries
+	if err != nil {
+		panic("Failed to rebuild review summaries: " + err.Error())
+	}
+
+	fmt.Printf("Rebuilt %d review summaries\n", count)
+}
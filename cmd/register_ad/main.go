@@ -4,7 +4,6 @@ import (
 	"bufio"
 	"fmt"
 	"main/core/config"
-	"main/core/enums"
 	"main/data/models"
 	"main/internal/providers/mysql"
 	"main/internal/repository"
@@ -27,12 +26,16 @@ type RegisterForm struct {
 
 	// CourtType is the type of the court.
 	CourtType string
+
+	// CourtTypeID is the id of the court type.
+	CourtTypeID uint
 }
 
 // Repository initialization
 var (
 	VendorRepository        *repository.VendorRepository
 	AdvertisementRepository *repository.AdvertisementRepository
+	CourtTypeRepository     *repository.CourtTypeRepository
 )
 
 // sanitizeForm is a helper function that sanitizes the register input.
@@ -81,10 +84,21 @@ func validateForm(form *RegisterForm) {
 		panic("Court type is required")
 	}
 
+	// Try to get the court type using the court type name
+	courtType, err := CourtTypeRepository.GetUsingType(form.CourtType)
+
 	// Check if the court type is invalid
-	if !enums.InCourtType(form.CourtType) {
+	if err == gorm.ErrRecordNotFound {
 		panic("Invalid court type")
 	}
+
+	// Check if there is an error
+	if err != nil {
+		panic(err.Error())
+	}
+
+	// Set the court type id
+	form.CourtTypeID = courtType.ID
 }
 
 // registerAd is a function that registers an advertisement.
@@ -97,7 +111,7 @@ func registerAd(form *RegisterForm) {
 	ad := models.Advertisement{
 		Image:       form.ImageName,
 		VendorID:    form.VendorID,
-		CourtTypeID: enums.GetCourtTypeID(form.CourtType),
+		CourtTypeID: form.CourtTypeID,
 	}
 
 	// Create the advertisement
@@ -152,7 +166,7 @@ func main() {
 	reader := bufio.NewReader(os.Stdin)
 
 	// Get the image name
-	fmt.Print("Enter image name[Include file extension][See assets/ads]: ")
+	fmt.Print("Enter image name[Include file extension][See the ads storage path]: ")
 
 	// Read the image name
 	line, err := reader.ReadString('\n')
@@ -188,8 +202,24 @@ func main() {
 	// Set the vendor id
 	form.VendorID = uint(vendorID)
 
+	// Get the available court types
+	courtTypes, err := CourtTypeRepository.GetActive()
+
+	// Return an error if any
+	if err != nil {
+		panic("Failed to get court types: " + err.Error())
+	}
+
+	// Create the court type names
+	courtTypeNames := []string{}
+
+	// Loop through the court types
+	for _, courtType := range *courtTypes {
+		courtTypeNames = append(courtTypeNames, courtType.Type)
+	}
+
 	// Get the court type
-	fmt.Print("Enter court type[Football|Basketball|Tennis|Volleyball|Badminton]: ")
+	fmt.Printf("Enter court type[%s]: ", strings.Join(courtTypeNames, "|"))
 
 	// Read the court type
 	line, err = reader.ReadString('\n')
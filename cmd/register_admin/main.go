@@ -0,0 +1,184 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"main/core/config"
+	"main/core/constants"
+	"main/data/models"
+	"main/domain/usecases"
+	"main/internal/providers/mysql"
+	"main/internal/repository"
+	"main/pkg/utils"
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+// RegisterForm is a struct that defines the register form.
+type RegisterForm struct {
+	// Username is the username of the admin.
+	Username string
+
+	// Password is the password of the admin.
+	Password string
+}
+
+// sanitizeForm is a helper function that sanitizes the register input.
+//
+// form: The register form.
+//
+// Returns void
+func sanitizeForm(form *RegisterForm) {
+	form.Username = strings.TrimSpace(form.Username)
+	form.Password = strings.TrimRight(form.Password, "\r\n")
+}
+
+// validateForm is a function that validates the register form.
+//
+// form: The register form.
+//
+// Returns void
+func validateForm(form *RegisterForm) {
+	// Check if the username is blank
+	if utils.IsBlank(form.Username) {
+		panic("Username is required")
+	}
+
+	// Check if the password is blank
+	if utils.IsBlank(form.Password) {
+		panic("Password is required")
+	}
+
+	// Check if the password is too short
+	if len(form.Password) < constants.MINIMUM_PASSWORD_LENGTH {
+		panic(fmt.Sprintf("Password must be at least %d characters long", constants.MINIMUM_PASSWORD_LENGTH))
+	}
+}
+
+// registerAdmin is a function that registers an admin.
+//
+// form: The register form.
+//
+// Returns void
+func registerAdmin(form *RegisterForm) {
+	// Create a new admin repository
+	ad := repository.NewAdminRepository()
+
+	// Check if the username is taken
+	taken, err := ad.IsUsernameTaken(form.Username)
+
+	// Return an error if any
+	if err != nil {
+		panic("\nFailed to check admin username: " + err.Error())
+	}
+
+	if taken {
+		panic("\nUsername is already taken")
+	}
+
+	// Create a new auth use case
+	a := usecases.NewAuthUseCase()
+
+	// Hash the password
+	hashedPassword, err := a.HashPassword(form.Password)
+
+	// Return an error if any
+	if err != nil {
+		panic("\nFailed to hash password: " + err.Error())
+	}
+
+	// Create a new admin object
+	admin := models.Admin{
+		Username: form.Username,
+		Password: hashedPassword,
+	}
+
+	// Create the admin
+	err = ad.Create(&admin)
+
+	// Return an error if any
+	if err != nil {
+		panic("\nFailed to register admin: " + err.Error())
+	}
+
+	fmt.Println("\nAdmin registered successfully!")
+
+	// Print the account details
+	fmt.Println("Username: ", admin.Username)
+}
+
+// main is the entry point of the program.
+func main() {
+	// Load the environment variables
+	err := godotenv.Load()
+
+	// Check if there is an error loading the environment variables
+	if err != nil {
+		panic("Error loading environment variables: " + err.Error())
+	}
+
+	// Load the database configuration
+	config.DBConfig.LoadData()
+
+	// Connect to the database
+	err = mysql.Connect()
+
+	// Check if there is an error connecting to the database
+	if err != nil {
+		panic("Error connecting to the database: " + err.Error())
+	}
+
+	// Close the database connection
+	defer func() {
+		err := mysql.CloseConnection()
+
+		// Check if there is an error closing the database connection
+		if err != nil {
+			panic("Error closing the database connection: " + err.Error())
+		}
+	}()
+
+	fmt.Println("Register admin program")
+	fmt.Println("=====================================")
+
+	// Get the admin register form
+	form := RegisterForm{}
+
+	// Create a new reader instance
+	reader := bufio.NewReader(os.Stdin)
+
+	// Get the admin username
+	fmt.Print("Enter admin username: ")
+	line, err := reader.ReadString('\n')
+
+	// Return an error if any
+	if err != nil {
+		panic("Failed to get admin username: " + err.Error())
+	}
+
+	// Set the admin username
+	form.Username = line
+
+	// Get the admin password
+	fmt.Print("Enter admin password: ")
+	line, err = reader.ReadString('\n')
+
+	// Return an error if any
+	if err != nil {
+		panic("Failed to get admin password: " + err.Error())
+	}
+
+	// Set the admin password
+	form.Password = line
+
+	// Sanitize the form
+	sanitizeForm(&form)
+
+	// Validate the form
+	validateForm(&form)
+
+	// Register the admin
+	registerAdmin(&form)
+}
@@ -11,6 +11,7 @@ import (
 	"main/internal/repository"
 	"main/pkg/utils"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -25,6 +26,12 @@ type RegisterForm struct {
 	// Address is the address of the vendor.
 	Address string
 
+	// Latitude is the latitude of the vendor location.
+	Latitude *float64
+
+	// Longitude is the longitude of the vendor location.
+	Longitude *float64
+
 	// Email is the email of the vendor.
 	Email string
 
@@ -49,6 +56,31 @@ func sanitizeForm(form *RegisterForm) {
 	form.Email = strings.TrimSpace(form.Email)
 }
 
+// parseCoordinate is a helper function that parses an optional coordinate input.
+//
+// line: The input line.
+//
+// Returns the parsed coordinate, or nil if the input is blank.
+func parseCoordinate(line string) *float64 {
+	// Trim the line
+	line = strings.TrimSpace(line)
+
+	// Check if the coordinate is skipped
+	if utils.IsBlank(line) {
+		return nil
+	}
+
+	// Parse the coordinate
+	coordinate, err := strconv.ParseFloat(line, 64)
+
+	// Return an error if any
+	if err != nil {
+		panic("Failed to parse coordinate: " + err.Error())
+	}
+
+	return &coordinate
+}
+
 // validateForm is a function that validates the register form.
 //
 // form: The register form.
@@ -65,6 +97,21 @@ func validateForm(form *RegisterForm) {
 		panic("Address is required")
 	}
 
+	// Check if the location is incomplete
+	if (form.Latitude == nil) != (form.Longitude == nil) {
+		panic("Latitude and longitude must be provided together")
+	}
+
+	// Check if the latitude is valid
+	if form.Latitude != nil && (*form.Latitude < -90 || *form.Latitude > 90) {
+		panic("Latitude must be between -90 and 90")
+	}
+
+	// Check if the longitude is valid
+	if form.Longitude != nil && (*form.Longitude < -180 || *form.Longitude > 180) {
+		panic("Longitude must be between -180 and 180")
+	}
+
 	// Check if the email is blank
 	if utils.IsBlank(form.Email) {
 		panic("Email is required")
@@ -118,6 +165,8 @@ func registerVendor(form *RegisterForm) {
 	vendor := models.Vendor{
 		Name:      form.Name,
 		Address:   form.Address,
+		Latitude:  form.Latitude,
+		Longitude: form.Longitude,
 		Email:     form.Email,
 		Password:  form.Password,
 		OpenTime:  form.OpenTime,
@@ -206,6 +255,30 @@ func main() {
 	// Set the vendor address
 	form.Address = line
 
+	// Get the vendor latitude
+	fmt.Print("Enter vendor latitude (leave blank to skip): ")
+	line, err = reader.ReadString('\n')
+
+	// Return an error if any
+	if err != nil {
+		panic("Failed to get vendor latitude: " + err.Error())
+	}
+
+	// Set the vendor latitude
+	form.Latitude = parseCoordinate(line)
+
+	// Get the vendor longitude
+	fmt.Print("Enter vendor longitude (leave blank to skip): ")
+	line, err = reader.ReadString('\n')
+
+	// Return an error if any
+	if err != nil {
+		panic("Failed to get vendor longitude: " + err.Error())
+	}
+
+	// Set the vendor longitude
+	form.Longitude = parseCoordinate(line)
+
 	// Get the vendor email
 	fmt.Print("Enter vendor email: ")
 	line, err = reader.ReadString('\n')
@@ -10,5 +10,8 @@ func Run() {
 	go execInterval(24*time.Hour, func() {
 		// Run the delete blacklist token routine
 		go runClearBlacklistedToken()
+
+		// Run the delete expired refresh token routine
+		go runClearRefreshToken()
 	})
 }
@@ -0,0 +1,37 @@
+package routines
+
+import (
+	"log"
+	"main/internal/providers/mysql"
+	"main/internal/repository"
+)
+
+// runClearRefreshToken is a helper function that runs the clear refresh token routine.
+// This routine will delete the expired refresh tokens every 24 hours.
+//
+// Returns void
+func runClearRefreshToken() {
+	// Check for database connection
+	err := mysql.Ping()
+
+	// Check if there is an error with the database connection
+	if err != nil {
+		log.Fatal("Error connecting to the database: " + err.Error())
+	}
+
+	// Get the refresh token repository
+	refreshTokenRepository := repository.NewRefreshTokenRepository()
+
+	// Delete the expired refresh tokens
+	err = refreshTokenRepository.Clear()
+
+	// Check if there is an error deleting the expired refresh tokens
+	if err != nil {
+		log.Fatal("Error deleting expired refresh tokens: " + err.Error())
+
+		return
+	}
+
+	// Log the success of the cleanup
+	log.Println("Expired refresh tokens cleaned up")
+}
@@ -1,11 +1,22 @@
 package config
 
-import "main/pkg/utils"
+import (
+	"log"
+	"main/pkg/utils"
+	"strconv"
+	"time"
+)
 
 // JWT is a struct that contains the JWT configuration.
 type JWT struct {
 	// Secret is the secret used to sign the JWT token.
 	Secret string
+
+	// AccessTokenExpiry is how long a JWT access token is valid after it's issued.
+	AccessTokenExpiry time.Duration
+
+	// RefreshTokenExpiry is how long a refresh token is valid after it's issued.
+	RefreshTokenExpiry time.Duration
 }
 
 // JWTConfig is the global variable that holds the JWT configuration.
@@ -15,5 +26,25 @@ var JWTConfig = JWT{}
 func (j JWT) LoadData() {
 	j.Secret = utils.GetEnv("JWT_SECRET", "my_secret")
 
+	// Get the access token expiry in minutes from the environment variables
+	accessTokenExpiry, err := strconv.Atoi(utils.GetEnv("JWT_ACCESS_TOKEN_EXPIRY_MINUTES", "15"))
+
+	// Check if the access token expiry is valid
+	if err != nil || accessTokenExpiry <= 0 {
+		log.Fatal("Invalid JWT access token expiry")
+	}
+
+	j.AccessTokenExpiry = time.Duration(accessTokenExpiry) * time.Minute
+
+	// Get the refresh token expiry in days from the environment variables
+	refreshTokenExpiry, err := strconv.Atoi(utils.GetEnv("JWT_REFRESH_TOKEN_EXPIRY_DAYS", "30"))
+
+	// Check if the refresh token expiry is valid
+	if err != nil || refreshTokenExpiry <= 0 {
+		log.Fatal("Invalid JWT refresh token expiry")
+	}
+
+	j.RefreshTokenExpiry = time.Duration(refreshTokenExpiry) * 24 * time.Hour
+
 	JWTConfig = j
 }
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"log"
+	"main/pkg/utils"
+	"strconv"
+)
+
+// Mail is a struct that contains the mail configuration.
+type Mail struct {
+	// SMTPHost is the host of the SMTP server, the mails are only logged when it's empty.
+	SMTPHost string
+
+	// SMTPPort is the port of the SMTP server.
+	SMTPPort int
+
+	// SMTPUsername is the username of the SMTP server.
+	SMTPUsername string
+
+	// SMTPPassword is the password of the SMTP server.
+	SMTPPassword string
+
+	// From is the sender address of the mails.
+	From string
+
+	// EmailVerificationURL is the frontend page the email verification token is sent to.
+	EmailVerificationURL string
+}
+
+// MailConfig is the global variable that holds the mail configuration.
+var MailConfig = Mail{}
+
+// LoadData is a method that loads the mail configuration from the environment variables.
+func (m Mail) LoadData() {
+	m.SMTPHost = utils.GetEnv("SMTP_HOST", "")
+
+	// Get the SMTP port from the environment variables
+	port, err := strconv.Atoi(utils.GetEnv("SMTP_PORT", "587"))
+
+	// Check if the SMTP port is valid
+	if err != nil || port <= 0 {
+		log.Fatal("Invalid SMTP port")
+	}
+
+	m.SMTPPort = port
+
+	m.SMTPUsername = utils.GetEnv("SMTP_USERNAME", "")
+
+	m.SMTPPassword = utils.GetEnv("SMTP_PASSWORD", "")
+
+	m.From = utils.GetEnv("MAIL_FROM", "no-reply@courtly.local")
+
+	m.EmailVerificationURL = utils.GetEnv("EMAIL_VERIFICATION_URL", "")
+
+	MailConfig = m
+}
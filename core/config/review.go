@@ -0,0 +1,108 @@
+package config
+
+import (
+	"bufio"
+	"log"
+	"main/core/constants"
+	"main/pkg/utils"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Review is a struct that contains the review configuration.
+type Review struct {
+	// EditWindow is how long a review can be edited after it's created.
+	EditWindow time.Duration
+
+	// BannedWords is the lowercased word list of the review filter.
+	BannedWords map[string]bool
+
+	// AutoHold is the flag whether a review with a banned word is held for moderation,
+	// the banned words are masked instead when it's false.
+	AutoHold bool
+}
+
+// ReviewConfig is the global variable that holds the review configuration.
+var ReviewConfig = Review{}
+
+// LoadData is a method that loads the review configuration from the environment variables.
+func (r Review) LoadData() {
+	// Get the edit window in hours from the environment variables
+	editWindow, err := strconv.Atoi(utils.GetEnv("REVIEW_EDIT_WINDOW_HOURS", "168"))
+
+	// Check if the edit window is valid
+	if err != nil || editWindow < 0 {
+		log.Fatal("Invalid review edit window")
+	}
+
+	r.EditWindow = time.Duration(editWindow) * time.Hour
+
+	// Get the auto hold flag from the environment variables
+	autoHold, err := strconv.ParseBool(utils.GetEnv("REVIEW_AUTO_HOLD", "true"))
+
+	// Check if the auto hold flag is valid
+	if err != nil {
+		log.Fatal("Invalid review auto hold flag")
+	}
+
+	r.AutoHold = autoHold
+
+	// Use the default word list when there is no word list file
+	words := constants.REVIEW_BANNED_WORDS
+
+	// Get the word list file from the environment variables
+	if path := utils.GetEnv("REVIEW_BANNED_WORDS_FILE", ""); !utils.IsBlank(path) {
+		words = readWordList(path)
+	}
+
+	r.BannedWords = make(map[string]bool, len(words))
+
+	for _, word := range words {
+		r.BannedWords[strings.ToLower(word)] = true
+	}
+
+	ReviewConfig = r
+}
+
+// readWordList is a helper function that reads the word list file, the file contains
+// a word on each line, the blank lines and the lines starting with # are skipped.
+//
+// path: The path of the word list file.
+//
+// Returns the words.
+func readWordList(path string) []string {
+	// Open the word list file
+	file, err := os.Open(path)
+
+	// Check if the word list file can be opened
+	if err != nil {
+		log.Fatal("Invalid review banned words file: " + err.Error())
+	}
+
+	defer file.Close()
+
+	// Read the words
+	words := []string{}
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		word := strings.TrimSpace(scanner.Text())
+
+		// Skip the blank lines and the comments
+		if word == "" || strings.HasPrefix(word, "#") {
+			continue
+		}
+
+		words = append(words, word)
+	}
+
+	// Check if the word list file can be read
+	if err := scanner.Err(); err != nil {
+		log.Fatal("Invalid review banned words file: " + err.Error())
+	}
+
+	return words
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"fmt"
+	"log"
+	"main/pkg/utils"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Storage is a struct that contains the uploaded assets storage configuration.
+type Storage struct {
+	// Driver is the storage backend, either local or s3.
+	Driver string
+
+	// PublicURL is the base URL the stored assets are served from.
+	PublicURL string
+
+	// LocalDir is the directory of the local storage.
+	LocalDir string
+
+	// PrivateLocalDir is the directory of the private local storage, it must be outside
+	// of the local storage directory since that one is served publicly.
+	PrivateLocalDir string
+
+	// S3Endpoint is the host of the S3 compatible storage.
+	S3Endpoint string
+
+	// S3Region is the region of the S3 compatible storage.
+	S3Region string
+
+	// S3Bucket is the bucket the assets are stored in.
+	S3Bucket string
+
+	// S3PrivateBucket is the bucket the private assets are stored in, it must not allow public reads.
+	S3PrivateBucket string
+
+	// S3AccessKey is the access key of the S3 compatible storage.
+	S3AccessKey string
+
+	// S3SecretKey is the secret key of the S3 compatible storage.
+	S3SecretKey string
+
+	// S3UseSSL is the flag whether the S3 compatible storage is accessed using HTTPS.
+	S3UseSSL bool
+}
+
+// StorageConfig is the global variable that holds the storage configuration.
+var StorageConfig = Storage{}
+
+// LoadData is a method that loads the storage configuration from the environment variables.
+func (s Storage) LoadData() {
+	// Get the storage driver from the environment variables
+	s.Driver = utils.GetEnv("STORAGE_DRIVER", "local")
+
+	// Get the public URL from the environment variables
+	s.PublicURL = utils.GetEnv("STORAGE_PUBLIC_URL", "")
+
+	// Get the local storage directory from the environment variables
+	s.LocalDir = utils.GetEnv("STORAGE_LOCAL_DIR", "assets")
+
+	// Get the private local storage directory from the environment variables
+	s.PrivateLocalDir = utils.GetEnv("STORAGE_PRIVATE_LOCAL_DIR", "private_assets")
+
+	// Get the S3 compatible storage configuration from the environment variables
+	s.S3Endpoint = utils.GetEnv("S3_ENDPOINT", "")
+	s.S3Region = utils.GetEnv("S3_REGION", "us-east-1")
+	s.S3Bucket = utils.GetEnv("S3_BUCKET", "")
+	s.S3PrivateBucket = utils.GetEnv("S3_PRIVATE_BUCKET", "")
+	s.S3AccessKey = utils.GetEnv("S3_ACCESS_KEY", "")
+	s.S3SecretKey = utils.GetEnv("S3_SECRET_KEY", "")
+
+	// Get the S3 SSL flag from the environment variables
+	useSSL, err := strconv.ParseBool(utils.GetEnv("S3_USE_SSL", "true"))
+
+	// Check if the S3 SSL flag is valid
+	if err != nil {
+		log.Fatal("Invalid S3 use SSL flag")
+	}
+
+	s.S3UseSSL = useSSL
+
+	// Check the configuration of the storage driver
+	switch s.Driver {
+	case "local":
+		// Serve the local assets from the static route by default
+		if utils.IsBlank(s.PublicURL) {
+			s.PublicURL = "static"
+		}
+
+		// Check if the private local storage directory is outside of the served directory
+		if isSubDir(s.LocalDir, s.PrivateLocalDir) {
+			log.Fatal("Private local storage directory must be outside of the local storage directory")
+		}
+	case "s3":
+		// Check if the S3 compatible storage is configured
+		if utils.IsBlank(s.S3Endpoint) || utils.IsBlank(s.S3Bucket) || utils.IsBlank(s.S3AccessKey) || utils.IsBlank(s.S3SecretKey) {
+			log.Fatal("S3 endpoint, bucket, access key and secret key are required for the s3 storage driver")
+		}
+
+		// Store the private assets in their own bucket by default
+		if utils.IsBlank(s.S3PrivateBucket) {
+			s.S3PrivateBucket = s.S3Bucket + "-private"
+		}
+
+		// Check if the private bucket is not the public bucket
+		if s.S3PrivateBucket == s.S3Bucket {
+			log.Fatal("S3 private bucket must be different from the S3 bucket")
+		}
+
+		// Get the scheme of the S3 compatible storage
+		scheme := "http"
+
+		if s.S3UseSSL {
+			scheme = "https"
+		}
+
+		// Serve the assets from the bucket by default
+		if utils.IsBlank(s.PublicURL) {
+			s.PublicURL = fmt.Sprintf("%s://%s/%s", scheme, s.S3Endpoint, s.S3Bucket)
+		}
+	default:
+		log.Fatal("Invalid storage driver")
+	}
+
+	StorageConfig = s
+}
+
+// isSubDir is a helper function that checks if the directory is the parent
+// directory or one of its sub directories.
+//
+// parent: The parent directory.
+// dir: The directory.
+//
+// Returns true if the directory is inside the parent directory.
+func isSubDir(parent string, dir string) bool {
+	// Get the absolute paths of the directories
+	parentPath, err := filepath.Abs(parent)
+
+	if err != nil {
+		return false
+	}
+
+	dirPath, err := filepath.Abs(dir)
+
+	if err != nil {
+		return false
+	}
+
+	// Get the path of the directory relative to the parent directory
+	rel, err := filepath.Rel(parentPath, dirPath)
+
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
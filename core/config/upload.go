@@ -0,0 +1,70 @@
+package config
+
+import (
+	"log"
+	"main/pkg/utils"
+	"strconv"
+)
+
+// Upload is a struct that contains the image upload configuration.
+type Upload struct {
+	// MaxSize is the maximum size of an uploaded image in bytes.
+	MaxSize int64
+
+	// MaxRequestSize is the maximum total size of the files uploaded in a single request in bytes.
+	MaxRequestSize int64
+
+	// MaxDimension is the maximum width and height of an uploaded image in pixels.
+	MaxDimension int
+
+	// JPEGQuality is the quality used to re-encode the uploaded images.
+	JPEGQuality int
+}
+
+// UploadConfig is the global variable that holds the image upload configuration.
+var UploadConfig = Upload{}
+
+// LoadData is a method that loads the image upload configuration from the environment variables.
+func (u Upload) LoadData() {
+	// Get the maximum image size in megabytes from the environment variables
+	maxSize, err := strconv.Atoi(utils.GetEnv("UPLOAD_MAX_SIZE_MB", "5"))
+
+	// Check if the maximum image size is valid
+	if err != nil || maxSize <= 0 {
+		log.Fatal("Invalid upload max size")
+	}
+
+	u.MaxSize = int64(maxSize) << 20
+
+	// Get the maximum total size of the files of a request in megabytes from the environment variables
+	maxRequestSize, err := strconv.Atoi(utils.GetEnv("UPLOAD_MAX_REQUEST_SIZE_MB", "10"))
+
+	// Check if the maximum request size is valid, a single file must fit in a request
+	if err != nil || maxRequestSize < maxSize {
+		log.Fatal("Invalid upload max request size")
+	}
+
+	u.MaxRequestSize = int64(maxRequestSize) << 20
+
+	// Get the maximum image dimension from the environment variables
+	maxDimension, err := strconv.Atoi(utils.GetEnv("UPLOAD_MAX_DIMENSION", "4096"))
+
+	// Check if the maximum image dimension is valid
+	if err != nil || maxDimension <= 0 {
+		log.Fatal("Invalid upload max dimension")
+	}
+
+	u.MaxDimension = maxDimension
+
+	// Get the JPEG quality from the environment variables
+	quality, err := strconv.Atoi(utils.GetEnv("UPLOAD_JPEG_QUALITY", "85"))
+
+	// Check if the JPEG quality is valid
+	if err != nil || quality < 1 || quality > 100 {
+		log.Fatal("Invalid upload JPEG quality")
+	}
+
+	u.JPEGQuality = quality
+
+	UploadConfig = u
+}
@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 var (
 	// MINIMUM_USERNAME_LENGTH is the minimum length of the user username
 	MINIMUM_USERNAME_LENGTH = 5
@@ -7,18 +9,152 @@ var (
 	// MINIMUM_PASSWORD_LENGTH is the minimum length of the user password
 	MINIMUM_PASSWORD_LENGTH = 8
 
-	// PATH_TO_USER_PROFILE_PICTURES is the path to the user profile pictures
-	PATH_TO_USER_PROFILE_PICTURES = "assets/user_profiles"
+	// PATH_TO_USER_PROFILE_PICTURES is the storage path to the user profile pictures
+	PATH_TO_USER_PROFILE_PICTURES = "user_profiles"
+
+	// PATH_TO_COURT_IMAGES is the storage path to the court images
+	PATH_TO_COURT_IMAGES = "court_images"
+
+	// PATH_TO_ADVERTISEMENTS is the storage path to the advertisement images
+	PATH_TO_ADVERTISEMENTS = "ads"
+
+	// PATH_TO_COURT_TYPE_ICONS is the storage path to the court type icons
+	PATH_TO_COURT_TYPE_ICONS = "court_type_icons"
+
+	// PATH_TO_GALLERY_IMAGES is the storage path to the court and venue gallery images
+	PATH_TO_GALLERY_IMAGES = "gallery_images"
+
+	// PATH_TO_VENDOR_DOCUMENTS is the private storage path to the vendor registration documents
+	PATH_TO_VENDOR_DOCUMENTS = "vendor_documents"
+
+	// PATH_TO_REVIEW_IMAGES is the storage path to the review photos
+	PATH_TO_REVIEW_IMAGES = "review_images"
+
+	// STORAGE_PATHS is the list of the storage paths of the uploaded assets
+	STORAGE_PATHS = []string{
+		PATH_TO_USER_PROFILE_PICTURES,
+		PATH_TO_COURT_IMAGES,
+		PATH_TO_ADVERTISEMENTS,
+		PATH_TO_COURT_TYPE_ICONS,
+		PATH_TO_GALLERY_IMAGES,
+		PATH_TO_REVIEW_IMAGES,
+	}
+
+	// PRIVATE_STORAGE_PATHS is the list of the private storage paths of the uploaded assets
+	PRIVATE_STORAGE_PATHS = []string{
+		PATH_TO_VENDOR_DOCUMENTS,
+	}
+
+	// VENDOR_DOCUMENT_TYPES is the business document types of the vendor registration,
+	// each type is mapped to whether it's required
+	VENDOR_DOCUMENT_TYPES = map[string]bool{
+		"business_license": true,
+		"owner_id":         true,
+		"tax_id":           false,
+	}
+
+	// MAX_REJECTION_REASON_LENGTH is the maximum length of the vendor registration rejection reason
+	MAX_REJECTION_REASON_LENGTH = 500
+
+	// EMAIL_VERIFICATION_EXPIRY is the time the email verification token is valid for
+	EMAIL_VERIFICATION_EXPIRY = 24 * time.Hour
+
+	// MAX_GALLERY_IMAGES is the maximum number of gallery images of a court or a venue
+	MAX_GALLERY_IMAGES = 10
+
+	// IMAGE_SIZES is the thumbnail sizes generated for the uploaded images,
+	// each size is the maximum width and height in pixels
+	IMAGE_SIZES = map[string]int{
+		"small":  200,
+		"medium": 640,
+		"large":  1280,
+	}
+
+	// DEFAULT_NEARBY_RADIUS_KM is the default search radius of the courts near a location
+	DEFAULT_NEARBY_RADIUS_KM = 10.0
+
+	// MAX_NEARBY_RADIUS_KM is the maximum search radius of the courts near a location
+	MAX_NEARBY_RADIUS_KM = 100.0
+
+	// EARTH_RADIUS_KM is the mean radius of the earth in kilometers
+	EARTH_RADIUS_KM = 6371.0
 
-	// PATH_TO_COURT_IMAGES is the path to the court images
-	PATH_TO_COURT_IMAGES = "assets/court_images"
+	// COURT_SORT_ORDERS is the sort orders of the courts catalogue, each sort order
+	// is mapped to whether it's sorted in descending order by default
+	COURT_SORT_ORDERS = map[string]bool{
+		"rating":   true,
+		"reviews":  true,
+		"price":    false,
+		"newest":   true,
+		"distance": false,
+	}
 
-	// PATH_TO_ADVERTISEMENTS is the path to the advertisement images
-	PATH_TO_ADVERTISEMENTS = "assets/ads"
+	// REVIEW_SORT_ORDERS is the sort orders of the reviews, each sort order
+	// is mapped to whether it's sorted in descending order
+	REVIEW_SORT_ORDERS = map[string]bool{
+		"newest":         true,
+		"highest_rating": true,
+		"lowest_rating":  false,
+		"most_helpful":   true,
+	}
+
+	// DEFAULT_PAGE_LIMIT is the default number of items in a page
+	DEFAULT_PAGE_LIMIT = 20
+
+	// MAX_PAGE_LIMIT is the maximum number of items in a page
+	MAX_PAGE_LIMIT = 100
 
 	// APP_FEE_PRICE is the price of the app fee
 	APP_FEE_PRICE = 1000.0
 
 	// LATEST_ORDER_LIMIT is the limit of latest order to get from database
 	LATEST_ORDER_LIMIT = 3
+
+	// RECENT_REVIEWS_LIMIT is the limit of recent reviews shown in the vendor profile
+	RECENT_REVIEWS_LIMIT = 5
+
+	// PROFILE_SCHEDULE_DAYS is the number of days of opening hours shown in the vendor profile
+	PROFILE_SCHEDULE_DAYS = 7
+
+	// MAX_SPECIAL_DAYS_AHEAD is the maximum number of days ahead a special day can be set
+	MAX_SPECIAL_DAYS_AHEAD = 365
+
+	// ADVERTISEMENTS_LIMIT is the maximum number of advertisements shown at once
+	ADVERTISEMENTS_LIMIT = 5
+
+	// MAX_ADVERTISEMENT_WEIGHT is the maximum priority weight of an advertisement
+	MAX_ADVERTISEMENT_WEIGHT = 100
+
+	// ADVERTISEMENT_STATS_FLUSH_INTERVAL is the interval the buffered advertisement impressions and clicks are written
+	ADVERTISEMENT_STATS_FLUSH_INTERVAL = time.Minute
+
+	// SERVER_SHUTDOWN_TIMEOUT is the maximum duration the server waits for the running requests on shutdown
+	SERVER_SHUTDOWN_TIMEOUT = 10 * time.Second
+
+	// ADVERTISEMENT_STATS_DAYS is the default number of days of the advertisement stats
+	ADVERTISEMENT_STATS_DAYS = 30
+
+	// MAX_ADVERTISEMENT_STATS_DAYS is the maximum number of days of the advertisement stats at once
+	MAX_ADVERTISEMENT_STATS_DAYS = 90
+
+	// MAX_REVIEW_REPLY_LENGTH is the maximum length of the vendor reply to a review
+	MAX_REVIEW_REPLY_LENGTH = 1000
+
+	// MAX_REVIEW_REPORT_REASON_LENGTH is the maximum length of the review report reason
+	MAX_REVIEW_REPORT_REASON_LENGTH = 500
+
+	// MAX_REVIEW_IMAGES is the maximum number of photos attached to a review
+	MAX_REVIEW_IMAGES = 5
+
+	// MAX_AUDIT_LOG_VALUE_LENGTH is the maximum length of a request body value recorded
+	// in the admin audit trail, longer values like the encoded images are omitted
+	MAX_AUDIT_LOG_VALUE_LENGTH = 500
+
+	// REVIEW_BANNED_WORDS is the default word list of the review filter, in Indonesian and English,
+	// it's replaced by the word list file when given
+	REVIEW_BANNED_WORDS = []string{
+		"anjing", "asu", "babi", "bajingan", "bangsat", "brengsek", "goblok", "jancok",
+		"kampret", "kontol", "memek", "ngentot", "tolol", "asshole", "bastard", "bitch",
+		"bullshit", "cunt", "dick", "fuck", "fucking", "motherfucker", "shit",
+	}
 )
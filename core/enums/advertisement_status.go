@@ -0,0 +1,23 @@
+package enums
+
+// AdvertisementStatus is an enum that defines the status of an advertisement campaign.
+type AdvertisementStatus int
+
+const (
+	AdvertisementActive AdvertisementStatus = iota
+	AdvertisementScheduled
+	AdvertisementEnded
+	AdvertisementPaused
+)
+
+// Label is a function that returns the label of the advertisement status.
+//
+// Returns the label of the advertisement status.
+func (a AdvertisementStatus) Label() string {
+	return map[AdvertisementStatus]string{
+		AdvertisementActive:    "Active",
+		AdvertisementScheduled: "Scheduled",
+		AdvertisementEnded:     "Ended",
+		AdvertisementPaused:    "Paused",
+	}[a]
+}
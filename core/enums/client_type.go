@@ -8,18 +8,19 @@ type ClientType int
 const (
 	User ClientType = iota
 	Vendor
+	Admin
 )
 
 // String is a function that returns the string representation of the user role.
 //
 // Returns a string containing the user role.
 func (c ClientType) String() string {
-	return [...]string{"User", "Vendor"}[c]
+	return [...]string{"User", "Vendor", "Admin"}[c]
 }
 
 // Model is a function that returns the model of the client type.
 //
 // 
 func (c ClientType) Model() any {
-	return [...]any{models.User{}, models.Vendor{}}[c]
+	return [...]any{models.User{}, models.Vendor{}, models.Admin{}}[c]
 }
@@ -0,0 +1,19 @@
+package enums
+
+// NotificationType is an enum that defines the type of a user notification.
+type NotificationType int
+
+const (
+	// NotificationReviewReply is sent when a vendor replies to the user review,
+	// it references the replied review.
+	NotificationReviewReply NotificationType = iota
+)
+
+// Label is a function that returns the label of the notification type.
+//
+// Returns the label of the notification type.
+func (n NotificationType) Label() string {
+	return map[NotificationType]string{
+		NotificationReviewReply: "review_reply",
+	}[n]
+}
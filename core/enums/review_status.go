@@ -0,0 +1,26 @@
+package enums
+
+// ReviewStatus is an enum that defines the moderation status of a review.
+type ReviewStatus int
+
+const (
+	// ReviewPublished is the status of a review shown to everyone.
+	ReviewPublished ReviewStatus = iota
+
+	// ReviewHeld is the status of a review held by the word filter until an admin approves it.
+	ReviewHeld
+
+	// ReviewHidden is the status of a review hidden by an admin.
+	ReviewHidden
+)
+
+// Label is a function that returns the label of the review status.
+//
+// Returns the label of the review status.
+func (r ReviewStatus) Label() string {
+	return map[ReviewStatus]string{
+		ReviewPublished: "Published",
+		ReviewHeld:      "Held",
+		ReviewHidden:    "Hidden",
+	}[r]
+}
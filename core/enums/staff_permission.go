@@ -0,0 +1,40 @@
+package enums
+
+// StaffPermission is an enum that defines what a vendor staff account is allowed to do.
+type StaffPermission int
+
+const (
+	// PermissionViewBookings allows viewing the orders, the courts and their bookings.
+	PermissionViewBookings StaffPermission = iota
+
+	// PermissionViewRevenue allows viewing the orders stats.
+	PermissionViewRevenue
+
+	// PermissionManageCourts allows creating, updating, linking and deleting the courts,
+	// including their prices.
+	PermissionManageCourts
+
+	// PermissionManageVenue allows updating the vendor profile, location, schedule and gallery,
+	// and replying to the reviews.
+	PermissionManageVenue
+
+	// PermissionManageAccount allows changing the vendor password and managing the staff accounts.
+	PermissionManageAccount
+
+	// PermissionRecordWalkIns allows recording the bookings of the walk-in customers.
+	PermissionRecordWalkIns
+)
+
+// Label is a function that returns the label of the staff permission.
+//
+// Returns the label of the staff permission.
+func (s StaffPermission) Label() string {
+	return map[StaffPermission]string{
+		PermissionViewBookings:  "view_bookings",
+		PermissionViewRevenue:   "view_revenue",
+		PermissionManageCourts:  "manage_courts",
+		PermissionManageVenue:   "manage_venue",
+		PermissionManageAccount: "manage_account",
+		PermissionRecordWalkIns: "record_walk_ins",
+	}[s]
+}
@@ -0,0 +1,63 @@
+package enums
+
+import (
+	"slices"
+	"strings"
+)
+
+// StaffRole is an enum that defines the role of a vendor staff account.
+type StaffRole int
+
+const (
+	StaffOwner StaffRole = iota
+	StaffManager
+	StaffCashier
+)
+
+// Label is a function that returns the label of the staff role.
+//
+// Returns the label of the staff role.
+func (s StaffRole) Label() string {
+	return map[StaffRole]string{
+		StaffOwner:   "Owner",
+		StaffManager: "Manager",
+		StaffCashier: "Cashier",
+	}[s]
+}
+
+// ParseStaffRole is a function that returns the staff role of the label,
+// the label is matched case insensitively.
+//
+// label: The staff role label.
+//
+// Returns the staff role and whether the label is valid.
+func ParseStaffRole(label string) (StaffRole, bool) {
+	// Loop through the staff roles
+	for _, role := range []StaffRole{StaffOwner, StaffManager, StaffCashier} {
+		if strings.EqualFold(label, role.Label()) {
+			return role, true
+		}
+	}
+
+	return 0, false
+}
+
+// Permissions is a function that returns the permissions granted to the staff role.
+//
+// Returns the permissions of the staff role.
+func (s StaffRole) Permissions() []StaffPermission {
+	return map[StaffRole][]StaffPermission{
+		StaffOwner:   {PermissionViewBookings, PermissionViewRevenue, PermissionManageCourts, PermissionManageVenue, PermissionManageAccount, PermissionRecordWalkIns},
+		StaffManager: {PermissionViewBookings, PermissionViewRevenue, PermissionManageCourts, PermissionManageVenue, PermissionRecordWalkIns},
+		StaffCashier: {PermissionViewBookings, PermissionRecordWalkIns},
+	}[s]
+}
+
+// HasPermission is a function that checks if the staff role is granted the permission.
+//
+// permission: The staff permission.
+//
+// Returns true if the permission is granted, false otherwise.
+func (s StaffRole) HasPermission(permission StaffPermission) bool {
+	return slices.Contains(s.Permissions(), permission)
+}
@@ -0,0 +1,23 @@
+package enums
+
+// VendorStatus is an enum that defines the approval status of a vendor.
+type VendorStatus int
+
+const (
+	VendorApproved VendorStatus = iota
+	VendorPending
+	VendorRejected
+	VendorSuspended
+)
+
+// Label is a function that returns the label of the vendor status.
+//
+// Returns the label of the vendor status.
+func (v VendorStatus) Label() string {
+	return map[VendorStatus]string{
+		VendorApproved:  "Approved",
+		VendorPending:   "Pending",
+		VendorRejected:  "Rejected",
+		VendorSuspended: "Suspended",
+	}[v]
+}
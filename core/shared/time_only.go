@@ -57,6 +57,13 @@ func (timeOnly TimeOnly) GetTime() time.Time {
 //
 // Returns An error if any.
 func (timeOnly *TimeOnly) Scan(value any) error {
+	// If the value is null, set the zero time
+	if value == nil {
+		*timeOnly = TimeOnly{}
+
+		return nil
+	}
+
 	scanned, ok := value.([]byte)
 
 	// If the value is not a byte slice, return an error
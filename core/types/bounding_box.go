@@ -0,0 +1,16 @@
+package types
+
+// BoundingBox is a struct that represents the area between two latitudes and two longitudes.
+type BoundingBox struct {
+	// MinLatitude is the southern latitude of the area.
+	MinLatitude float64
+
+	// MaxLatitude is the northern latitude of the area.
+	MaxLatitude float64
+
+	// MinLongitude is the western longitude of the area.
+	MinLongitude float64
+
+	// MaxLongitude is the eastern longitude of the area.
+	MaxLongitude float64
+}
@@ -1,12 +1,10 @@
 package types
 
 // CourtCountsMap is a type that represents the court counts map.
-// CourtCountsMap should be formatted as following.
+// CourtCountsMap is keyed by the court type name, as following.
 // {
 //     "Football": ...,
 //     "Basketball": ...,
-//     "Volleyball": ...,
-//     "Tennis": ...,
-//     "Badminton": ...
+//     ...
 // }
 type CourtCountsMap map[string]int64
@@ -6,8 +6,14 @@ import "main/data/models"
 // This map type should be formatted as following
 // {
 //     "court": ...,
-//     "total_rating": ...
+//     "price": ...,
+//     "max_price": ...,
+//     "total_rating": ...,
+//     "total_reviews": ...,
+//     "distance": ...
 // }
+// The price range and the total reviews are only set in the courts catalogue and
+// the distance is only set when the courts are searched near a location.
 type CourtMap map[string]any
 
 // GetCourt is a function that returns the court from the court map.
@@ -17,6 +23,53 @@ func (c CourtMap) GetCourt() models.Court {
 	return c["court"].(models.Court)
 }
 
+// GetPriceRange is a function that returns the cheapest and the most expensive
+// court prices from the court map.
+//
+// Returns the minimum and maximum prices, nil if they're not set.
+func (c CourtMap) GetPriceRange() (*float64, *float64) {
+	// Get the prices
+	price, ok := c["price"].(float64)
+	maxPrice, maxOk := c["max_price"].(float64)
+
+	// Return nil if the price range is not set
+	if !ok || !maxOk {
+		return nil, nil
+	}
+
+	return &price, &maxPrice
+}
+
+// GetDistance is a function that returns the distance from the court map.
+//
+// Returns the distance in kilometers, nil if it's not set.
+func (c CourtMap) GetDistance() *float64 {
+	// Get the distance
+	distance, ok := c["distance"].(float64)
+
+	// Return nil if the distance is not set
+	if !ok {
+		return nil
+	}
+
+	return &distance
+}
+
+// GetTotalReviews is a function that returns the total reviews from the court map.
+//
+// Returns the total reviews, nil if it's not set.
+func (c CourtMap) GetTotalReviews() *int64 {
+	// Get the total reviews
+	totalReviews, ok := c["total_reviews"].(int64)
+
+	// Return nil if the total reviews is not set
+	if !ok {
+		return nil
+	}
+
+	return &totalReviews
+}
+
 // GetTotalRating is a function that returns the total rating from the court map.
 //
 // Returns the total rating.
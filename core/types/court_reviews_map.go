@@ -6,6 +6,7 @@ package types
 //     "reviews_total": ...,
 //     "star_counts": ...,
 //     "reviews": ...,
+//     "next_cursor": ...,
 //     "total_rating": ...
 // }
 type CourtReviewsMap map[string]any
@@ -0,0 +1,9 @@
+package types
+
+// CourtStatsMap is a map of court stats
+// CourtStatsMap consists of followings:
+// {
+//     "total_court_count": ...,
+//     "court_counts": ...
+// }
+type CourtStatsMap map[string]any
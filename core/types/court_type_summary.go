@@ -0,0 +1,14 @@
+package types
+
+// CourtTypeSummary is a struct that represents the summary of the courts of a vendor
+// in a court type.
+type CourtTypeSummary struct {
+	// Type is the court type.
+	Type string
+
+	// CourtCount is the number of courts in the court type.
+	CourtCount int64
+
+	// StartingPrice is the lowest price of the courts in the court type.
+	StartingPrice float64
+}
@@ -0,0 +1,54 @@
+package types
+
+import "time"
+
+// CourtsFilter is a struct that represents the filters, the sort order and
+// the page of the courts catalogue.
+type CourtsFilter struct {
+	// CourtTypes is the court types to filter, empty to get every court type.
+	CourtTypes []string
+
+	// Search is the vendor name to search.
+	Search string
+
+	// MinPrice is the minimum price of the courts.
+	MinPrice *float64
+
+	// MaxPrice is the maximum price of the courts.
+	MaxPrice *float64
+
+	// MinRating is the minimum rating of the courts.
+	MinRating *float64
+
+	// OpenAt is the time the vendors should be open at, formatted as HH:MM.
+	OpenAt string
+
+	// OpenOn is the date the vendors should be open at the open time, the opening
+	// hours of the date are used.
+	OpenOn time.Time
+
+	// Latitude is the latitude of the location to search near.
+	Latitude *float64
+
+	// Longitude is the longitude of the location to search near.
+	Longitude *float64
+
+	// RadiusKm is the search radius from the location in kilometers.
+	RadiusKm float64
+
+	// Box is the bounding box of the search radius, it pre-filters the vendors
+	// before the exact distances are calculated.
+	Box *BoundingBox
+
+	// Sort is the sort order of the courts.
+	Sort string
+
+	// Descending is whether the courts are sorted in descending order.
+	Descending bool
+
+	// Limit is the maximum number of courts to get.
+	Limit int
+
+	// Cursor is the position to start the page after, nil to get the first page.
+	Cursor *Cursor
+}
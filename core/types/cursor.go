@@ -0,0 +1,11 @@
+package types
+
+// Cursor is a struct that represents the position of the last item of a page,
+// the next page starts right after it.
+type Cursor struct {
+	// Value is the sorted value of the last item.
+	Value any `json:"v"`
+
+	// ID is the id of the last item, it breaks the ties of the sorted value.
+	ID uint `json:"id"`
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	"main/core/shared"
+	"time"
+)
+
+// OpeningHours is a struct that represents the effective opening hours of a vendor on a date.
+type OpeningHours struct {
+	// Date is the date of the opening hours.
+	Date time.Time
+
+	// IsClosed is whether the vendor is closed on the date.
+	IsClosed bool
+
+	// OpenTime is the opening time on the date.
+	OpenTime shared.TimeOnly
+
+	// CloseTime is the closing time on the date.
+	CloseTime shared.TimeOnly
+
+	// IsSpecial is whether the opening hours come from a special day.
+	IsSpecial bool
+
+	// Note is the note of the special day.
+	Note string
+}
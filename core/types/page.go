@@ -0,0 +1,10 @@
+package types
+
+// Page is a struct that represents a page of a cursor paginated list.
+type Page struct {
+	// Limit is the maximum number of items in the page.
+	Limit int
+
+	// Cursor is the position to start the page after, nil to get the first page.
+	Cursor *Cursor
+}
@@ -0,0 +1,11 @@
+package types
+
+// ReviewsFilter is a struct that represents the filters and the sort order of the reviews,
+// the rating is filtered separately.
+type ReviewsFilter struct {
+	// WithPhotos is whether to get only the reviews with photos.
+	WithPhotos bool
+
+	// Sort is the sort order of the reviews, the newest reviews come first when empty.
+	Sort string
+}
@@ -0,0 +1,14 @@
+package types
+
+// VendorProfileMap is a map that maps a string to any.
+// VendorProfileMap should contains as follows:
+// {
+//     "vendor": ...,
+//     "court_types": ...,
+//     "total_rating": ...,
+//     "reviews_total": ...,
+//     "star_counts": ...,
+//     "reviews": ...,
+//     "opening_hours": ...
+// }
+type VendorProfileMap map[string]any
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// Admin is the model for the admin table.
+type Admin struct {
+	// ID is the primary key of the admin.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// Username is the username of the admin.
+	Username string `gorm:"not null;unique;type:varchar(255)"`
+
+	// Password is the password of the admin.
+	Password string `gorm:"not null;type:varchar(255)"`
+
+	// CreatedAt is the time when the admin was created.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// UpdatedAt is the time when the admin was updated.
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// AdminAuditLog is the model for the admin audit log table.
+// A log is recorded for every change made through the admin endpoints.
+type AdminAuditLog struct {
+	// ID is the primary key of the admin audit log.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// AdminID is the id of the admin who made the change.
+	AdminID uint `gorm:"not null;index"`
+
+	// AdminUsername is the username of the admin when the change was made.
+	AdminUsername string `gorm:"not null;type:varchar(255)"`
+
+	// Method is the HTTP method of the request.
+	Method string `gorm:"not null;type:varchar(10)"`
+
+	// Path is the path of the request.
+	Path string `gorm:"not null;type:varchar(255)"`
+
+	// Status is the HTTP status code of the response.
+	Status int `gorm:"not null"`
+
+	// TargetType is the type of the changed record, like "vendors", empty when the change has no target.
+	TargetType string `gorm:"type:varchar(50);index:idx_admin_audit_logs_target"`
+
+	// TargetID is the id of the changed record, nil when the change has no target.
+	TargetID *uint `gorm:"index:idx_admin_audit_logs_target"`
+
+	// RequestBody is the request body as JSON, the long values like the encoded images are omitted.
+	RequestBody *string `gorm:"type:text"`
+
+	// CreatedAt is the time when the log was recorded.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -1,22 +1,52 @@
 package models
 
-import "time"
+import (
+	"main/core/shared"
+	"time"
+)
 
 // Advertisement is the model for the advertisement table.
 type Advertisement struct {
 	// ID is the primary key of the advertisement.
 	ID uint `gorm:"primaryKey;autoIncrement"`
 
+	// Name is the campaign name of the advertisement, only shown to the admins
+	// and the advertised vendor.
+	Name string `gorm:"type:varchar(255);not null;default:''"`
+
 	// Vendor is the foreign key of the vendor.
 	VendorID uint   `gorm:"not null"`
 	Vendor   Vendor `gorm:"foreignKey:VendorID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 
-	// CourtType is the foreign key of the court type.
+	// CourtType is the foreign key of the court type, the advertisement is
+	// targeted to the users looking for this court type.
 	CourtTypeID uint      `gorm:"not null"`
 	CourtType   CourtType `gorm:"foreignKey:CourtTypeID;references:ID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 
 	// Image is the image of the advertisement.
 	Image string `gorm:"not null"`
 
+	// StartDate is the first date the advertisement is shown, null to start right away.
+	StartDate *shared.DateOnly `gorm:"index"`
+
+	// EndDate is the last date the advertisement is shown, null to never end.
+	EndDate *shared.DateOnly `gorm:"index"`
+
+	// Weight is the priority weight of the advertisement, an advertisement with a
+	// higher weight is shown more often.
+	Weight uint `gorm:"not null;default:1"`
+
+	// Latitude is the latitude of the targeted area center, null to target every area.
+	Latitude *float64
+
+	// Longitude is the longitude of the targeted area center, null to target every area.
+	Longitude *float64
+
+	// RadiusKm is the radius of the targeted area in kilometers.
+	RadiusKm *float64
+
+	// PausedAt is the time the advertisement is paused, null if it's running.
+	PausedAt *time.Time
+
 	CreatedAt time.Time `gorm:"autoCreateTime"`
 }
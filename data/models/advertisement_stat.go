@@ -0,0 +1,29 @@
+package models
+
+import (
+	"main/core/shared"
+	"time"
+)
+
+// AdvertisementStat is the model for the advertisement stat table.
+// A stat aggregates the impressions and clicks of an advertisement on a date.
+type AdvertisementStat struct {
+	// ID is the primary key of the advertisement stat.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// AdvertisementID is the foreign key of the advertisement.
+	AdvertisementID uint          `gorm:"not null;uniqueIndex:idx_advertisement_stat_ad_date"`
+	Advertisement   Advertisement `gorm:"foreignKey:AdvertisementID;constraint:OnDelete:CASCADE"`
+
+	// Date is the date of the stat.
+	Date shared.DateOnly `gorm:"not null;uniqueIndex:idx_advertisement_stat_ad_date"`
+
+	// Impressions is the number of times the advertisement is served on the date.
+	Impressions uint `gorm:"not null"`
+
+	// Clicks is the number of times the advertisement is clicked on the date.
+	Clicks uint `gorm:"not null"`
+
+	// UpdatedAt is the time when the stat was last written.
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
@@ -13,9 +13,9 @@ type Booking struct {
 	OrderID uint  `gorm:"not null"`
 	Order   Order `gorm:"foreignKey:OrderID"`
 
-	// UserID is the foreign key of the user.
-	UserID uint `gorm:"not null;index"`
-	User   User `gorm:"foreignKey:UserID"`
+	// UserID is the foreign key of the user, nil for the walk-in bookings.
+	UserID *uint `gorm:"index"`
+	User   *User `gorm:"foreignKey:UserID"`
 
 	// VendorID is the foreign key of the vendor.
 	VendorID uint   `gorm:"not null;index"`
@@ -25,6 +25,10 @@ type Booking struct {
 	CourtID uint  `gorm:"not null;index;constraint:OnDelete:SET NULL"`
 	Court   Court `gorm:"foreignKey:CourtID"`
 
+	// CourtTypeID is the foreign key of the court type the court was booked as.
+	CourtTypeID *uint     `gorm:"index"`
+	CourtType   CourtType `gorm:"foreignKey:CourtTypeID"`
+
 	// Date is the date of the book was created.
 	Date shared.DateOnly `gorm:"autoCreateTime;type:DATE"`
 
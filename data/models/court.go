@@ -11,10 +11,18 @@ type Court struct {
 	VendorID uint   `gorm:"not null;index"`
 	Vendor   Vendor `gorm:"foreignKey:VendorID"`
 
-	// CourtTypeID is the foreign key of the court type.
+	// CourtTypeID is the foreign key of the primary court type,
+	// the court type the court was created in.
 	CourtTypeID uint      `gorm:"not null;index"`
 	CourtType   CourtType `gorm:"foreignKey:CourtTypeID"`
 
+	// CourtTypeLinks is the list of court types the court can be booked as,
+	// including the primary court type.
+	CourtTypeLinks []CourtTypeLink `gorm:"foreignKey:CourtID;constraint:OnDelete:CASCADE"`
+
+	// GalleryImages is the list of gallery images of the court.
+	GalleryImages []GalleryImage `gorm:"foreignKey:CourtID"`
+
 	// Name is the name of the court.
 	Name string `gorm:"not null;type:varchar(255)"`
 
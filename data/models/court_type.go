@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // CourtType is a struct that represents the court type models.
 type CourtType struct {
 	// ID is the primary key of the court type.
@@ -8,6 +10,19 @@ type CourtType struct {
 	// Type is the type of the court.
 	Type string `gorm:"not null;unique;type:varchar(255);index"`
 
+	// Icon is the icon file name of the court type.
+	Icon string `gorm:"type:varchar(255)"`
+
+	// RetiredAt is the time the court type was retired.
+	// Retired court types can't be used for new courts.
+	RetiredAt *time.Time `gorm:"index"`
+
+	// CreatedAt is the time the court type was created.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// UpdatedAt is the time the court type was updated.
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+
 	// Courts is the list of courts that have the court type.
 	Courts []Court `gorm:"foreignKey:CourtTypeID"`
 }
@@ -0,0 +1,21 @@
+package models
+
+import "time"
+
+// CourtTypeLink is the model for the court type link table.
+// It links a physical court to every court type it can be booked as.
+type CourtTypeLink struct {
+	// ID is the primary key of the court type link.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// CourtID is the foreign key of the court.
+	CourtID uint  `gorm:"not null;uniqueIndex:idx_court_type_link"`
+	Court   Court `gorm:"foreignKey:CourtID"`
+
+	// CourtTypeID is the foreign key of the court type.
+	CourtTypeID uint      `gorm:"not null;uniqueIndex:idx_court_type_link;index"`
+	CourtType   CourtType `gorm:"foreignKey:CourtTypeID"`
+
+	// CreatedAt is the time when the court type link was created.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// GalleryImage is the model for the gallery image table.
+// A gallery image without a court belongs to the venue of the vendor.
+type GalleryImage struct {
+	// ID is the primary key of the gallery image.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// VendorID is the foreign key of the vendor.
+	VendorID uint   `gorm:"not null;index"`
+	Vendor   Vendor `gorm:"foreignKey:VendorID;constraint:OnDelete:CASCADE"`
+
+	// CourtID is the foreign key of the court, nil for venue images.
+	CourtID *uint  `gorm:"index"`
+	Court   *Court `gorm:"foreignKey:CourtID;constraint:OnDelete:CASCADE"`
+
+	// Image is the image file name of the gallery image.
+	Image string `gorm:"not null;type:varchar(255)"`
+
+	// Caption is the caption of the gallery image.
+	Caption string `gorm:"type:varchar(255)"`
+
+	// Position is the order of the gallery image in the gallery.
+	Position int `gorm:"not null;default:0"`
+
+	// IsCover is the flag whether the gallery image is the cover image.
+	IsCover bool `gorm:"not null;default:false"`
+
+	// CreatedAt is the time when the gallery image was created.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// UpdatedAt is the time when the gallery image was updated.
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
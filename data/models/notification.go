@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// Notification is the model for the notification table.
+// A notification tells the user about something happened to the user data.
+type Notification struct {
+	// ID is the primary key of the notification.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// UserID is the foreign key of the notified user.
+	UserID uint `gorm:"not null;index"`
+	User   User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+
+	// Type is the type of the notification.
+	Type string `gorm:"not null;type:varchar(30)"`
+
+	// Message is the message of the notification.
+	Message string `gorm:"not null;type:varchar(255)"`
+
+	// ReferenceID is the id of the data the notification is about, like the
+	// replied review, the referenced data depends on the notification type.
+	ReferenceID *uint
+
+	// ReadAt is the time when the notification was read, null if it's unread.
+	ReadAt *time.Time
+
+	// CreatedAt is the time when the notification was created.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"main/core/shared"
+	"time"
+)
+
+// OpeningHour is the model for the opening hour table.
+// An opening hour overrides the vendor open and close time on a day of the week.
+type OpeningHour struct {
+	// ID is the primary key of the opening hour.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// VendorID is the foreign key of the vendor.
+	VendorID uint   `gorm:"not null;uniqueIndex:idx_opening_hour_vendor_weekday"`
+	Vendor   Vendor `gorm:"foreignKey:VendorID;constraint:OnDelete:CASCADE"`
+
+	// Weekday is the day of the week of the opening hour, 0 is Sunday.
+	Weekday int `gorm:"not null;uniqueIndex:idx_opening_hour_vendor_weekday"`
+
+	// IsClosed is the flag whether the vendor is closed on the day.
+	IsClosed bool `gorm:"not null;default:false"`
+
+	// OpenTime is the opening time of the day, empty when the vendor is closed.
+	OpenTime shared.TimeOnly
+
+	// CloseTime is the closing time of the day, empty when the vendor is closed.
+	CloseTime shared.TimeOnly
+
+	// CreatedAt is the time when the opening hour was created.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// UpdatedAt is the time when the opening hour was updated.
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
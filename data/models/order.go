@@ -19,6 +19,9 @@ type Order struct {
 	// Status is the status of the order.
 	Status string `gorm:"not null"`
 
+	// CustomerName is the name of the walk-in customer, nil for the orders made by the users.
+	CustomerName *string `gorm:"type:varchar(255);default:null"`
+
 	// CreatedAt is the time the order was created.
 	CreatedAt time.Time `gorm:"autoCreateTime"`
 
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// RefreshToken is the model for the refresh token table. Only the hash of the
+// opaque refresh token is stored, a refresh token can be used once and is
+// rotated to a new token of the same family.
+type RefreshToken struct {
+	// ID is the primary key of the refresh token.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// FamilyID is the id of the token family, the tokens rotated from the same login share it.
+	FamilyID string `gorm:"not null;type:char(64);index"`
+
+	// TokenHash is the SHA-256 hash of the refresh token.
+	TokenHash string `gorm:"not null;type:char(64);uniqueIndex"`
+
+	// ClientID is the id of the user, vendor or admin the token is issued to.
+	ClientID uint `gorm:"not null;index:idx_refresh_tokens_client"`
+
+	// ClientType is the client type the token is issued to.
+	ClientType int `gorm:"not null;index:idx_refresh_tokens_client"`
+
+	// StaffID is the foreign key of the vendor staff, null when the token
+	// isn't issued to a vendor staff.
+	StaffID *uint        `gorm:"index"`
+	Staff   *VendorStaff `gorm:"foreignKey:StaffID;constraint:OnDelete:CASCADE"`
+
+	// ExpiresAt is the time when the refresh token expires.
+	ExpiresAt time.Time `gorm:"not null;index"`
+
+	// UsedAt is the time when the refresh token was rotated, null if it's never used.
+	UsedAt *time.Time
+
+	// RevokedAt is the time when the token family was revoked, null if it's not revoked.
+	RevokedAt *time.Time
+
+	// CreatedAt is the time when the refresh token was issued.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -2,25 +2,34 @@ package models
 
 import (
 	"main/core/shared"
+	"time"
+
+	"gorm.io/gorm"
 )
 
-// Review is the model for the review table.
+// Review is the model for the review table, each user can have one review of a vendor court type
+// that is not deleted.
 type Review struct {
 	// ID is the primary key of the review.
 	ID uint `gorm:"primary_key;autoIncrement"`
 
 	// UserID is the foreign key of the user.
-	UserID uint `gorm:"not null"`
+	UserID uint `gorm:"not null;uniqueIndex:idx_reviews_user_court_type"`
 	User   User `gorm:"foreignKey:UserID"`
 
 	// VendorID is the foreign key of the vendor.
-	VendorID uint `gorm:"not null;index"`
+	VendorID uint   `gorm:"not null;index;uniqueIndex:idx_reviews_user_court_type"`
 	Vendor   Vendor `gorm:"foreignKey:VendorID"`
 
 	// CourtTypeID is the foreign key of the court type.
-	CourtTypeID uint `gorm:"not null;index"`
+	CourtTypeID uint      `gorm:"not null;index;uniqueIndex:idx_reviews_user_court_type"`
 	CourtType   CourtType `gorm:"foreignKey:CourtTypeID"`
 
+	// BookingID is the foreign key of the completed booking the review is written for,
+	// null for the reviews written before the reviews are tied to a booking.
+	BookingID *uint    `gorm:"index"`
+	Booking   *Booking `gorm:"foreignKey:BookingID;constraint:OnDelete:SET NULL"`
+
 	// Rating is the rating of the review.
 	Rating int8 `gorm:"not null"`
 
@@ -29,4 +38,35 @@ type Review struct {
 
 	// Date is the date of the review was created.
 	Date shared.DateOnly `gorm:"type:date;autoCreateTime;not null"`
+
+	// Reply is the reply of the vendor, nil if the vendor hasn't replied.
+	Reply *ReviewReply `gorm:"foreignKey:ReviewID"`
+
+	// HelpfulCount is the number of users who marked the review as helpful.
+	HelpfulCount int `gorm:"not null;default:0;index"`
+
+	// Images is the photos attached to the review.
+	Images []ReviewImage `gorm:"foreignKey:ReviewID"`
+
+	// Reports is the unresolved reports of the review, only loaded for moderation.
+	Reports []ReviewReport `gorm:"foreignKey:ReviewID"`
+
+	// Status is the moderation status of the review, only the published
+	// reviews are shown and counted in the rating.
+	Status string `gorm:"not null;type:varchar(20);default:Published;index"`
+
+	// CreatedAt is the time when the review was created, the review can only be
+	// edited within the edit window after it.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// EditedAt is the time when the review was last edited, null if it's never edited.
+	EditedAt *time.Time
+
+	// DeletedAt is the time when the review was deleted, the deleted review
+	// is hidden but kept for moderation.
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+
+	// Live is true for the reviews that are not deleted and null for the deleted ones,
+	// so that the unique index of the user and the vendor court type ignores the deleted reviews.
+	Live *bool `gorm:"->;type:tinyint(1) GENERATED ALWAYS AS (IF(deleted_at IS NULL, 1, NULL)) STORED;uniqueIndex:idx_reviews_user_court_type"`
 }
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// ReviewImage is the model for the review image table.
+// A review can have a few photos attached by the reviewer.
+type ReviewImage struct {
+	// ID is the primary key of the review image.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// ReviewID is the id of the review.
+	ReviewID uint `gorm:"not null;index"`
+
+	// Image is the image file name of the review image.
+	Image string `gorm:"not null;type:varchar(255)"`
+
+	// Position is the order of the image in the review.
+	Position int `gorm:"not null;default:0"`
+
+	// CreatedAt is the time when the review image was uploaded.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+// ReviewReply is the model for the review reply table.
+// A vendor can post one public reply to each review of the vendor.
+type ReviewReply struct {
+	// ID is the primary key of the review reply.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// ReviewID is the id of the replied review.
+	ReviewID uint `gorm:"not null;uniqueIndex"`
+
+	// VendorID is the foreign key of the vendor.
+	VendorID uint   `gorm:"not null;index"`
+	Vendor   Vendor `gorm:"foreignKey:VendorID;constraint:OnDelete:CASCADE"`
+
+	// Reply is the reply of the vendor.
+	Reply string `gorm:"not null;type:text"`
+
+	// CreatedAt is the time when the reply was posted.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// UpdatedAt is the time when the reply was last updated.
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
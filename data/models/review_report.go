@@ -0,0 +1,28 @@
+package models
+
+import "time"
+
+// ReviewReport is the model for the review report table.
+// A review can be reported by the users and the reviewed vendor for moderation.
+type ReviewReport struct {
+	// ID is the primary key of the review report.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// ReviewID is the id of the reported review.
+	ReviewID uint `gorm:"not null;index"`
+
+	// ReporterType is the client type of the reporter, either User or Vendor.
+	ReporterType string `gorm:"not null;type:varchar(10)"`
+
+	// ReporterID is the id of the reporting user or vendor.
+	ReporterID uint `gorm:"not null"`
+
+	// Reason is the reason of the report.
+	Reason string `gorm:"not null;type:varchar(500)"`
+
+	// CreatedAt is the time when the review was reported.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// ResolvedAt is the time when an admin moderated the review, null if it's not resolved yet.
+	ResolvedAt *time.Time `gorm:"index"`
+}
@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+// ReviewRevision is the model for the review revision table.
+// A revision keeps a previous version of an edited review for moderation.
+type ReviewRevision struct {
+	// ID is the primary key of the review revision.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// ReviewID is the id of the edited review.
+	ReviewID uint `gorm:"not null;index"`
+
+	// Rating is the rating of the previous version.
+	Rating int8 `gorm:"not null"`
+
+	// Review is the review of the previous version.
+	Review string `gorm:"not null;type:text"`
+
+	// WrittenAt is the time when the previous version was written.
+	WrittenAt time.Time `gorm:"not null"`
+
+	// CreatedAt is the time when the previous version was replaced.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
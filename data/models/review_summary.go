@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// ReviewSummary is the model for the review summary table.
+// It keeps the rating aggregates of the published reviews of each vendor and court type,
+// it's updated in the same transaction as the reviews.
+type ReviewSummary struct {
+	// VendorID is the foreign key of the vendor.
+	VendorID uint   `gorm:"primaryKey;autoIncrement:false"`
+	Vendor   Vendor `gorm:"foreignKey:VendorID;constraint:OnDelete:CASCADE"`
+
+	// CourtTypeID is the foreign key of the court type.
+	CourtTypeID uint      `gorm:"primaryKey;autoIncrement:false"`
+	CourtType   CourtType `gorm:"foreignKey:CourtTypeID;constraint:OnDelete:CASCADE"`
+
+	// ReviewCount is the number of the published reviews.
+	ReviewCount int64 `gorm:"not null;default:0"`
+
+	// RatingSum is the sum of the ratings of the published reviews.
+	RatingSum int64 `gorm:"not null;default:0"`
+
+	// OneStar is the number of the published reviews rated 1.
+	OneStar int64 `gorm:"not null;default:0"`
+
+	// TwoStar is the number of the published reviews rated 2.
+	TwoStar int64 `gorm:"not null;default:0"`
+
+	// ThreeStar is the number of the published reviews rated 3.
+	ThreeStar int64 `gorm:"not null;default:0"`
+
+	// FourStar is the number of the published reviews rated 4.
+	FourStar int64 `gorm:"not null;default:0"`
+
+	// FiveStar is the number of the published reviews rated 5.
+	FiveStar int64 `gorm:"not null;default:0"`
+
+	// UpdatedAt is the time when the summary was last updated.
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
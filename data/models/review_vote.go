@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// ReviewVote is the model for the review vote table.
+// A user can mark each review of other users as helpful once.
+type ReviewVote struct {
+	// ID is the primary key of the review vote.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// ReviewID is the id of the voted review.
+	ReviewID uint `gorm:"not null;uniqueIndex:idx_review_vote_review_user"`
+
+	// UserID is the foreign key of the user.
+	UserID uint `gorm:"not null;uniqueIndex:idx_review_vote_review_user;index"`
+	User   User `gorm:"foreignKey:UserID;constraint:OnDelete:CASCADE"`
+
+	// CreatedAt is the time when the review was marked as helpful.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
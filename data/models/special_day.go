@@ -0,0 +1,38 @@
+package models
+
+import (
+	"main/core/shared"
+	"time"
+)
+
+// SpecialDay is the model for the special day table.
+// A special day overrides the opening hours of the vendor on a date, like a public holiday.
+type SpecialDay struct {
+	// ID is the primary key of the special day.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// VendorID is the foreign key of the vendor.
+	VendorID uint   `gorm:"not null;uniqueIndex:idx_special_day_vendor_date"`
+	Vendor   Vendor `gorm:"foreignKey:VendorID;constraint:OnDelete:CASCADE"`
+
+	// Date is the date of the special day.
+	Date shared.DateOnly `gorm:"not null;uniqueIndex:idx_special_day_vendor_date"`
+
+	// IsClosed is the flag whether the vendor is closed on the date.
+	IsClosed bool `gorm:"not null;default:false"`
+
+	// OpenTime is the opening time of the date, empty when the vendor is closed.
+	OpenTime shared.TimeOnly
+
+	// CloseTime is the closing time of the date, empty when the vendor is closed.
+	CloseTime shared.TimeOnly
+
+	// Note is the note of the special day, like the holiday name.
+	Note string `gorm:"type:varchar(255)"`
+
+	// CreatedAt is the time when the special day was created.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// UpdatedAt is the time when the special day was updated.
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
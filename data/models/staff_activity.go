@@ -0,0 +1,33 @@
+package models
+
+import "time"
+
+// StaffActivity is the model for the staff activity table.
+// An activity is recorded for every change made through the vendor endpoints.
+type StaffActivity struct {
+	// ID is the primary key of the staff activity.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// VendorID is the foreign key of the vendor.
+	VendorID uint   `gorm:"not null;index"`
+	Vendor   Vendor `gorm:"foreignKey:VendorID;constraint:OnDelete:CASCADE"`
+
+	// StaffID is the id of the staff who made the change, nil when the
+	// change is made with the vendor account. It's kept after the staff is deleted.
+	StaffID *uint `gorm:"index"`
+
+	// StaffName is the name of the staff when the change was made.
+	StaffName *string `gorm:"type:varchar(255)"`
+
+	// Method is the HTTP method of the request.
+	Method string `gorm:"not null;type:varchar(10)"`
+
+	// Path is the path of the request.
+	Path string `gorm:"not null;type:varchar(255)"`
+
+	// Status is the HTTP status code of the response.
+	Status int `gorm:"not null"`
+
+	// CreatedAt is the time when the activity was recorded.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
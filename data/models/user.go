@@ -21,6 +21,10 @@ type User struct {
 	// Password is the password of the user.
 	Password string `gorm:"not null;type:varchar(255)"`
 
+	// DisabledAt is the time when the user was disabled by an admin,
+	// nil when the user is active.
+	DisabledAt *time.Time
+
 	// CreatedAt is the time when the user was created.
 	CreatedAt time.Time `gorm:"autoCreateTime"`
 
@@ -16,18 +16,40 @@ type Vendor struct {
 	// Address is the address of the vendor.
 	Address string `gorm:"not null;type:varchar(255)"`
 
+	// Latitude is the latitude of the vendor location.
+	Latitude *float64 `gorm:"type:decimal(10,7);index:idx_vendor_location"`
+
+	// Longitude is the longitude of the vendor location.
+	Longitude *float64 `gorm:"type:decimal(10,7);index:idx_vendor_location"`
+
 	// Email is the email of the vendor.
 	Email string `gorm:"not null;unique;type:varchar(255);index"`
 
-	// PhoneNumber is the phone number of the vendor.
+	// PhoneNumber is the contact phone number of the vendor.
+	PhoneNumber *string `gorm:"type:varchar(20)"`
+
+	// Password is the hashed password of the vendor.
 	Password string `gorm:"not null;type:varchar(255)"`
 
-	// OpenTime is the opening time of the vendor.
+	// OpenTime is the opening time of the vendor, used on the days
+	// without opening hours.
 	OpenTime shared.TimeOnly `gorm:"not null"`
 
-	// CloseTime is the closing time of the vendor.
+	// CloseTime is the closing time of the vendor, used on the days
+	// without opening hours.
 	CloseTime shared.TimeOnly `gorm:"not null"`
 
+	// Status is the approval status of the vendor, only the approved vendors
+	// can log in and are listed.
+	Status string `gorm:"not null;type:varchar(20);default:Approved;index"`
+
+	// RejectionReason is the reason the vendor registration was rejected
+	// or the vendor was suspended.
+	RejectionReason string `gorm:"type:varchar(500)"`
+
+	// ReviewedAt is the time when the vendor registration was reviewed.
+	ReviewedAt *time.Time
+
 	// CreatedAt is the time when the user was created.
 	CreatedAt time.Time `gorm:"autoCreateTime"`
 
@@ -42,4 +64,24 @@ type Vendor struct {
 
 	// Reviews is the list of reviews that have the vendor.
 	Reviews []Review `gorm:"foreignKey:VendorID"`
+
+	// OpeningHours is the list of opening hours of the vendor week.
+	OpeningHours []OpeningHour `gorm:"foreignKey:VendorID"`
+
+	// SpecialDays is the list of special days of the vendor.
+	SpecialDays []SpecialDay `gorm:"foreignKey:VendorID"`
+
+	// Documents is the list of business documents of the vendor registration.
+	Documents []VendorDocument `gorm:"foreignKey:VendorID"`
+
+	// BankAccount is the payout bank account of the vendor.
+	BankAccount *VendorBankAccount `gorm:"foreignKey:VendorID"`
+
+	// EmailChange is the pending email change of the vendor, it should be
+	// preloaded with the unexpired email change only.
+	EmailChange *VendorEmailChange `gorm:"foreignKey:VendorID"`
+
+	// GalleryImages is the list of gallery images of the vendor, it should
+	// be preloaded with the venue images only.
+	GalleryImages []GalleryImage `gorm:"foreignKey:VendorID"`
 }
@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// VendorBankAccount is the model for the vendor bank account table.
+// The bank account is where the vendor payouts are transferred to.
+type VendorBankAccount struct {
+	// ID is the primary key of the vendor bank account.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// VendorID is the foreign key of the vendor.
+	VendorID uint   `gorm:"not null;uniqueIndex"`
+	Vendor   Vendor `gorm:"foreignKey:VendorID;constraint:OnDelete:CASCADE"`
+
+	// BankName is the name of the bank.
+	BankName string `gorm:"not null;type:varchar(100)"`
+
+	// AccountHolder is the name of the account holder.
+	AccountHolder string `gorm:"not null;type:varchar(255)"`
+
+	// AccountNumber is the number of the bank account.
+	AccountNumber string `gorm:"not null;type:varchar(50)"`
+
+	// CreatedAt is the time when the bank account was created.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// UpdatedAt is the time when the bank account was updated.
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
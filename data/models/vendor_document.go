@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// VendorDocument is the model for the vendor document table.
+// The documents are the business documents uploaded with the vendor registration,
+// they are private and only served to the admins.
+type VendorDocument struct {
+	// ID is the primary key of the vendor document.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// VendorID is the foreign key of the vendor.
+	VendorID uint   `gorm:"not null;uniqueIndex:idx_vendor_document_vendor_type"`
+	Vendor   Vendor `gorm:"foreignKey:VendorID;constraint:OnDelete:CASCADE"`
+
+	// Type is the type of the document, like the business license.
+	Type string `gorm:"not null;type:varchar(50);uniqueIndex:idx_vendor_document_vendor_type"`
+
+	// File is the random file name of the document in the storage.
+	File string `gorm:"not null;type:varchar(255)"`
+
+	// ContentType is the MIME type of the document.
+	ContentType string `gorm:"not null;type:varchar(100)"`
+
+	// CreatedAt is the time when the document was uploaded.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// VendorEmailChange is the model for the vendor email change table.
+// The new login email of a vendor is only applied after it's verified
+// with the token sent to it.
+type VendorEmailChange struct {
+	// ID is the primary key of the email change.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// VendorID is the foreign key of the vendor, a vendor has one pending email change at most.
+	VendorID uint   `gorm:"not null;uniqueIndex"`
+	Vendor   Vendor `gorm:"foreignKey:VendorID;constraint:OnDelete:CASCADE"`
+
+	// Email is the new email of the vendor.
+	Email string `gorm:"not null;type:varchar(255)"`
+
+	// TokenHash is the SHA-256 hash of the verification token.
+	TokenHash string `gorm:"not null;type:char(64);uniqueIndex"`
+
+	// ExpiresAt is the time when the verification token expires.
+	ExpiresAt time.Time `gorm:"not null"`
+
+	// CreatedAt is the time when the email change was requested.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+// VendorStaff is the model for the vendor staff table.
+// A staff account logs in to its vendor with its own email and password.
+type VendorStaff struct {
+	// ID is the primary key of the vendor staff.
+	ID uint `gorm:"primaryKey;autoIncrement"`
+
+	// VendorID is the foreign key of the vendor.
+	VendorID uint   `gorm:"not null;index"`
+	Vendor   Vendor `gorm:"foreignKey:VendorID;constraint:OnDelete:CASCADE"`
+
+	// Name is the name of the staff.
+	Name string `gorm:"not null;type:varchar(255)"`
+
+	// Email is the login email of the staff.
+	Email string `gorm:"not null;unique;type:varchar(255)"`
+
+	// Password is the hashed password of the staff.
+	Password string `gorm:"not null;type:varchar(255)"`
+
+	// Role is the role of the staff, it decides the permissions of the staff.
+	Role string `gorm:"not null;type:varchar(20)"`
+
+	// CreatedAt is the time when the staff was created.
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+
+	// UpdatedAt is the time when the staff was updated.
+	UpdatedAt time.Time `gorm:"autoUpdateTime"`
+}
@@ -0,0 +1,122 @@
+package controllers
+
+import (
+	"main/domain/usecases"
+	"main/internal/dto"
+	"main/pkg/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// AdminController is a struct that defines the AdminController
+type AdminController struct {
+	AdminUseCase      *usecases.AdminUseCase
+	PaginationUseCase *usecases.PaginationUseCase
+}
+
+// NewAdminController is a factory function that returns a new instance of the AdminController.
+//
+// a: The admin use case.
+// p: The pagination use case.
+//
+// Returns a new instance of the AdminController.
+func NewAdminController(a *usecases.AdminUseCase, p *usecases.PaginationUseCase) *AdminController {
+	return &AdminController{
+		AdminUseCase:      a,
+		PaginationUseCase: p,
+	}
+}
+
+// GetCurrentAdmin is a controller that handles the get current admin endpoint.
+// Endpoint: GET /admin/me
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdminController) GetCurrentAdmin(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve admin",
+		Data: dto.CurrentAdminResponseDTO{
+			Admin: dto.AdminDTO{}.FromModel(cc.Admin),
+		},
+	})
+}
+
+// GetAuditLogs is a controller that handles the get admin audit logs endpoint,
+// the audit logs show which admin made each change.
+// Endpoint: GET /admin/audit-logs
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdminController) GetAuditLogs(c echo.Context) error {
+	// Get the admin id filter from the query parameter
+	var adminID *uint
+
+	// Check if the admin id filter is given
+	if param := c.QueryParam("admin_id"); !utils.IsBlank(param) {
+		// Parse the admin id
+		id, err := strconv.Atoi(param)
+
+		// Return an error if the admin id is invalid
+		if err != nil || id <= 0 {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: "Invalid admin id",
+				Data:    nil,
+			})
+		}
+
+		parsedID := uint(id)
+		adminID = &parsedID
+	}
+
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := a.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the admin audit logs
+	auditLogs, next, processErr := a.AdminUseCase.GetAuditLogs(adminID, a.PaginationUseCase.GetPage(pagination))
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Create the audit logs response with the pagination
+	res := dto.AdminAuditLogsResponseDTO{}.FromModels(auditLogs)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve audit logs",
+		Data:    res,
+	})
+}
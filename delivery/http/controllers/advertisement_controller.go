@@ -1,33 +1,71 @@
 package controllers
 
 import (
+	"log"
 	"main/domain/usecases"
 	"main/internal/dto"
+	"main/pkg/utils"
 	"net/http"
+	"net/url"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 )
 
 // AdvertisementController is a struct that defines the AdvertisementController.
 type AdvertisementController struct {
-	AdvertisementUseCase *usecases.AdvertisementUseCase
+	AdvertisementUseCase      *usecases.AdvertisementUseCase
+	AdvertisementStatsUseCase *usecases.AdvertisementStatsUseCase
+	PaginationUseCase         *usecases.PaginationUseCase
 }
 
 // NewAdvertisementController is a factory function that returns a
 // new instance of the AdvertisementController.
 //
 // a: the AdvertisementUseCase instance.
+// s: the AdvertisementStatsUseCase instance.
+// p: the PaginationUseCase instance.
 //
 // Returns the AdvertisementController instance.
-func NewAdvertisementController(a *usecases.AdvertisementUseCase) *AdvertisementController {
+func NewAdvertisementController(a *usecases.AdvertisementUseCase, s *usecases.AdvertisementStatsUseCase, p *usecases.PaginationUseCase) *AdvertisementController {
 	return &AdvertisementController{
-		AdvertisementUseCase: a,
+		AdvertisementUseCase:      a,
+		AdvertisementStatsUseCase: s,
+		PaginationUseCase:         p,
 	}
 }
 
+// GetAdvertisements is a controller that handles the get advertisements endpoint,
+// only the running advertisements targeted to the caller are returned.
+// Endpoint: GET /advertisements
+//
+// c: The echo context.
+//
+// Returns an error if any.
 func (a *AdvertisementController) GetAdvertisements(c echo.Context) error {
-	// Get all advertisements
-	ads, err := a.AdvertisementUseCase.GetAdvertisements()
+	// Create a new AdvertisementsQueryDTO object
+	query := new(dto.AdvertisementsQueryDTO)
+
+	// Bind the query parameters to the AdvertisementsQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, query); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the advertisements query parameters
+	if errMsg := a.AdvertisementUseCase.ValidateAdvertisementsQuery(query); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the advertisements
+	ads, err := a.AdvertisementUseCase.GetAdvertisements(query)
 
 	// Check if there is an error
 	if err != nil {
@@ -38,9 +76,553 @@ func (a *AdvertisementController) GetAdvertisements(c echo.Context) error {
 		})
 	}
 
+	// Record the impressions of the served advertisements
+	a.AdvertisementStatsUseCase.RecordImpressions(ads)
+
 	return c.JSON(http.StatusOK, &dto.ResponseDTO{
 		Success: true,
 		Message: "Successfully get advertisements",
 		Data:    dto.AdvertisementsResponseDTO{}.FromModels(ads),
 	})
 }
+
+// ClickAdvertisement is a controller that handles the advertisement click endpoint,
+// the click is recorded and the caller is redirected to the advertised vendor court type.
+// Endpoint: GET /advertisements/:id/click
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdvertisementController) ClickAdvertisement(c echo.Context) error {
+	// Get the advertisement id from the URL
+	adID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the advertisement id is invalid
+	if err != nil || adID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid advertisement id",
+			Data:    nil,
+		})
+	}
+
+	// Record the advertisement click
+	ad, processErr := a.AdvertisementStatsUseCase.ClickAdvertisement(uint(adID))
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Redirect to the advertised vendor court type
+	return c.Redirect(http.StatusFound, "/api/v1/vendors/"+strconv.Itoa(int(ad.VendorID))+"/courts/"+url.PathEscape(ad.CourtType.Type))
+}
+
+// GetCurrentVendorAdvertisementsStats is a controller that handles the current vendor
+// advertisements stats endpoint.
+// Endpoint: GET /vendors/me/advertisements/stats
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdvertisementController) GetCurrentVendorAdvertisementsStats(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Create a new AdvertisementStatsQueryDTO object
+	query := new(dto.AdvertisementStatsQueryDTO)
+
+	// Bind the query parameters to the AdvertisementStatsQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, query); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the advertisement stats query parameters
+	if errMsg := a.AdvertisementStatsUseCase.ValidateStatsQuery(query); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the current vendor advertisements stats
+	ads, stats, processErr := a.AdvertisementStatsUseCase.GetCurrentVendorAdvertisementsStats(cc.Token, query)
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Successfully get advertisements stats",
+		Data:    dto.AdvertisementsStatsResponseDTO{}.FromModels(ads, stats),
+	})
+}
+
+// CreateAdvertisement is a controller that handles the admin create advertisement endpoint.
+// Endpoint: POST /admin/advertisements
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdvertisementController) CreateAdvertisement(c echo.Context) error {
+	// Create a new CreateAdvertisementFormDTO object
+	form := new(dto.CreateAdvertisementFormDTO)
+
+	// Bind the request body to the CreateAdvertisementFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Get the uploaded image file of a multipart request if any
+	form.ImageFile, _ = c.FormFile("image")
+
+	// Validate the create advertisement form
+	if errs := a.AdvertisementUseCase.ValidateCreateAdvertisementForm(form); errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Create the advertisement
+	ad, processErr := a.AdvertisementUseCase.CreateAdvertisement(form)
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Record the created advertisement in the audit trail
+	c.(*dto.CustomContext).AuditTargetID = &ad.ID
+
+	return c.JSON(http.StatusCreated, dto.ResponseDTO{
+		Success: true,
+		Message: "Successfully create advertisement",
+		Data: dto.AdminAdvertisementResponseDTO{
+			Advertisement: dto.AdminAdvertisementDTO{}.FromModel(ad),
+		},
+	})
+}
+
+// GetAllAdvertisements is a controller that handles the admin get advertisements endpoint.
+// Endpoint: GET /admin/advertisements
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdvertisementController) GetAllAdvertisements(c echo.Context) error {
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := a.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the advertisements
+	ads, next, processErr := a.AdvertisementUseCase.GetAllAdvertisements(a.PaginationUseCase.GetPage(pagination))
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Create the advertisements response with the pagination
+	res := dto.AdminAdvertisementsResponseDTO{}.FromModels(ads)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Successfully get advertisements",
+		Data:    res,
+	})
+}
+
+// GetAdvertisement is a controller that handles the admin get advertisement endpoint.
+// Endpoint: GET /admin/advertisements/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdvertisementController) GetAdvertisement(c echo.Context) error {
+	// Get the advertisement id from the URL
+	adID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the advertisement id is invalid
+	if err != nil || adID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid advertisement id",
+			Data:    nil,
+		})
+	}
+
+	// Get the advertisement
+	ad, processErr := a.AdvertisementUseCase.GetAdvertisement(uint(adID))
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Successfully get advertisement",
+		Data: dto.AdminAdvertisementResponseDTO{
+			Advertisement: dto.AdminAdvertisementDTO{}.FromModel(ad),
+		},
+	})
+}
+
+// GetAdvertisementStats is a controller that handles the admin advertisement stats endpoint.
+// Endpoint: GET /admin/advertisements/:id/stats
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdvertisementController) GetAdvertisementStats(c echo.Context) error {
+	// Get the advertisement id from the URL
+	adID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the advertisement id is invalid
+	if err != nil || adID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid advertisement id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new AdvertisementStatsQueryDTO object
+	query := new(dto.AdvertisementStatsQueryDTO)
+
+	// Bind the query parameters to the AdvertisementStatsQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, query); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the advertisement stats query parameters
+	if errMsg := a.AdvertisementStatsUseCase.ValidateStatsQuery(query); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the advertisement stats
+	ad, stats, processErr := a.AdvertisementStatsUseCase.GetAdvertisementStats(uint(adID), query)
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Successfully get advertisement stats",
+		Data: dto.AdvertisementStatsResponseDTO{
+			Advertisement: dto.AdvertisementStatsDTO{}.FromModel(ad, stats),
+		},
+	})
+}
+
+// UpdateAdvertisement is a controller that handles the admin update advertisement endpoint.
+// Endpoint: PATCH /admin/advertisements/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdvertisementController) UpdateAdvertisement(c echo.Context) error {
+	// Get the advertisement id from the URL
+	adID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the advertisement id is invalid
+	if err != nil || adID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid advertisement id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new UpdateAdvertisementFormDTO object
+	form := new(dto.UpdateAdvertisementFormDTO)
+
+	// Bind the request body to the UpdateAdvertisementFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the update advertisement form
+	if errs := a.AdvertisementUseCase.ValidateUpdateAdvertisementForm(form); errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Update the advertisement
+	ad, processErr := a.AdvertisementUseCase.UpdateAdvertisement(uint(adID), form)
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Successfully update advertisement",
+		Data: dto.AdminAdvertisementResponseDTO{
+			Advertisement: dto.AdminAdvertisementDTO{}.FromModel(ad),
+		},
+	})
+}
+
+// PauseAdvertisement is a controller that handles the admin pause advertisement endpoint.
+// Endpoint: POST /admin/advertisements/:id/pause
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdvertisementController) PauseAdvertisement(c echo.Context) error {
+	// Get the advertisement id from the URL
+	adID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the advertisement id is invalid
+	if err != nil || adID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid advertisement id",
+			Data:    nil,
+		})
+	}
+
+	// Pause the advertisement
+	ad, processErr := a.AdvertisementUseCase.PauseAdvertisement(uint(adID))
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Successfully pause advertisement",
+		Data: dto.AdminAdvertisementResponseDTO{
+			Advertisement: dto.AdminAdvertisementDTO{}.FromModel(ad),
+		},
+	})
+}
+
+// ResumeAdvertisement is a controller that handles the admin resume advertisement endpoint.
+// Endpoint: POST /admin/advertisements/:id/resume
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdvertisementController) ResumeAdvertisement(c echo.Context) error {
+	// Get the advertisement id from the URL
+	adID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the advertisement id is invalid
+	if err != nil || adID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid advertisement id",
+			Data:    nil,
+		})
+	}
+
+	// Resume the advertisement
+	ad, processErr := a.AdvertisementUseCase.ResumeAdvertisement(uint(adID))
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Successfully resume advertisement",
+		Data: dto.AdminAdvertisementResponseDTO{
+			Advertisement: dto.AdminAdvertisementDTO{}.FromModel(ad),
+		},
+	})
+}
+
+// DeleteAdvertisement is a controller that handles the admin delete advertisement endpoint.
+// Endpoint: DELETE /admin/advertisements/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (a *AdvertisementController) DeleteAdvertisement(c echo.Context) error {
+	// Get the advertisement id from the URL
+	adID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the advertisement id is invalid
+	if err != nil || adID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid advertisement id",
+			Data:    nil,
+		})
+	}
+
+	// Delete the advertisement
+	processErr := a.AdvertisementUseCase.DeleteAdvertisement(uint(adID))
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Successfully delete advertisement",
+		Data:    nil,
+	})
+}
@@ -2,7 +2,6 @@ package controllers
 
 import (
 	"log"
-	"main/core/enums"
 	"main/domain/usecases"
 	"main/internal/dto"
 	"main/pkg/utils"
@@ -15,20 +14,26 @@ import (
 
 // CourtController is a struct that defines the CourtController
 type CourtController struct {
-	CourtUseCase   *usecases.CourtUseCase
-	BookingUseCase *usecases.BookingUseCase
+	CourtUseCase     *usecases.CourtUseCase
+	BookingUseCase   *usecases.BookingUseCase
+	CourtTypeUseCase *usecases.CourtTypeUseCase
+	ScheduleUseCase  *usecases.ScheduleUseCase
 }
 
 // NewCourtController is a factory function that returns a new instance of the CourtController.
 //
 // c: The court use case.
 // b: The booking use case.
+// t: The court type use case.
+// s: The schedule use case.
 //
 // Returns a new instance of the CourtController.
-func NewCourtController(c *usecases.CourtUseCase, b *usecases.BookingUseCase) *CourtController {
+func NewCourtController(c *usecases.CourtUseCase, b *usecases.BookingUseCase, t *usecases.CourtTypeUseCase, s *usecases.ScheduleUseCase) *CourtController {
 	return &CourtController{
-		CourtUseCase:   c,
-		BookingUseCase: b,
+		CourtUseCase:     c,
+		BookingUseCase:   b,
+		CourtTypeUseCase: t,
+		ScheduleUseCase:  s,
 	}
 }
 
@@ -39,23 +44,41 @@ func NewCourtController(c *usecases.CourtUseCase, b *usecases.BookingUseCase) *C
 //
 // Returns an error if any.
 func (co *CourtController) GetCourts(c echo.Context) error {
-	// Get the court type from the query parameter
-	courtType := c.QueryParam("type")
+	// Create a new CourtsQueryDTO object
+	query := new(dto.CourtsQueryDTO)
 
-	// Return an error if the court type is invalid
-	if !utils.IsBlank(courtType) && !enums.InCourtType(courtType) {
+	// Bind the query parameters to the CourtsQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, query); err != nil {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
-			Message: "Invalid court type",
+			Message: "Invalid query parameters",
 			Data:    nil,
 		})
 	}
 
-	// Get the vendor name from the query parameter
-	vendorName := c.QueryParam("search")
+	// Loop through the court types to filter
+	for _, courtType := range query.GetCourtTypes() {
+		// Return an error if the court type is invalid
+		if !co.CourtTypeUseCase.IsValidCourtType(courtType) {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: "Invalid court type",
+				Data:    nil,
+			})
+		}
+	}
+
+	// Validate the query parameters
+	if errMsg := co.CourtUseCase.ValidateCourtsQuery(query); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
 
 	// Get the courts
-	courtMaps, err := co.CourtUseCase.GetCourts(&courtType, &vendorName)
+	courtMaps, next, err := co.CourtUseCase.GetCourts(query)
 
 	// Return an error if any
 	if err != nil {
@@ -66,10 +89,14 @@ func (co *CourtController) GetCourts(c echo.Context) error {
 		})
 	}
 
+	// Create the courts response with the pagination
+	res := dto.UserCourtsResponseDTO{}.FromCourtMaps(courtMaps)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
 	return c.JSON(http.StatusOK, dto.ResponseDTO{
 		Success: true,
 		Message: "Success retrieve courts",
-		Data:    dto.UserCourtsResponseDTO{}.FromCourtMaps(courtMaps),
+		Data:    res,
 	})
 }
 
@@ -96,7 +123,7 @@ func (co *CourtController) GetVendorCourtsUsingCourtType(c echo.Context) error {
 	courtType := c.Param("type")
 
 	// Return an error if the court type is invalid
-	if !enums.InCourtType(courtType) {
+	if !co.CourtTypeUseCase.IsValidCourtType(courtType) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -135,7 +162,7 @@ func (co *CourtController) GetCurrentVendorCourtsUsingCourtType(c echo.Context)
 	courtType := c.Param("type")
 
 	// Return an error if the court type is invalid
-	if !enums.InCourtType(courtType) {
+	if !co.CourtTypeUseCase.IsValidCourtType(courtType) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -176,7 +203,7 @@ func (co *CourtController) CreateNewCourt(c echo.Context) error {
 	courtType := c.Param("type")
 
 	// Return an error if the court type is invalid
-	if !enums.InCourtType(courtType) {
+	if !co.CourtTypeUseCase.IsValidCourtType(courtType) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -194,6 +221,9 @@ func (co *CourtController) CreateNewCourt(c echo.Context) error {
 		return err
 	}
 
+	// Get the uploaded court image file of a multipart request if any
+	form.CourtImageFile, _ = c.FormFile("court_image")
+
 	// Validate the login form
 	errs := co.CourtUseCase.ValidateCreateNewCourtForm(form)
 
@@ -250,7 +280,7 @@ func (co *CourtController) AddCourt(c echo.Context) error {
 	courtType := c.Param("type")
 
 	// Return an error if the court type is invalid
-	if !enums.InCourtType(courtType) {
+	if !co.CourtTypeUseCase.IsValidCourtType(courtType) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -353,7 +383,7 @@ func (co *CourtController) GetCourtBookings(c echo.Context) error {
 	}
 
 	// Check if court type is valid
-	if !enums.InCourtType(courtType) {
+	if !co.CourtTypeUseCase.IsValidCourtType(courtType) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -374,7 +404,7 @@ func (co *CourtController) GetCourtBookings(c echo.Context) error {
 	}
 
 	// Try parse the date
-	_, err = time.Parse("2006-01-02", date)
+	parsedDate, err := time.Parse("2006-01-02", date)
 
 	// Return an error if any
 	if err != nil {
@@ -397,10 +427,27 @@ func (co *CourtController) GetCourtBookings(c echo.Context) error {
 		})
 	}
 
+	// Get the opening hours of the date
+	hours, err := co.ScheduleUseCase.GetOpeningHoursUsingVendorIDDate(uint(vendorID), parsedDate)
+
+	// Return an error if any
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Failed to get opening hours",
+			Data:    nil,
+		})
+	}
+
+	// Create the response
+	res := dto.CurrentUserCourtBookingsResponseDTO{}.FromModels(bookings)
+
+	res.OpeningHours = dto.OpeningHoursDTO{}.FromType(hours)
+
 	return c.JSON(http.StatusOK, dto.ResponseDTO{
 		Success: true,
 		Message: "Success retrieve court bookings",
-		Data:    dto.CurrentUserCourtBookingsResponseDTO{}.FromModels(bookings),
+		Data:    res,
 	})
 }
 
@@ -425,7 +472,7 @@ func (co *CourtController) GetCurrentVendorCourtBookings(c echo.Context) error {
 	}
 
 	// Check if court type is valid
-	if !enums.InCourtType(courtType) {
+	if !co.CourtTypeUseCase.IsValidCourtType(courtType) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -446,7 +493,7 @@ func (co *CourtController) GetCurrentVendorCourtBookings(c echo.Context) error {
 	}
 
 	// Try parse the date
-	_, err := time.Parse("2006-01-02", date)
+	parsedDate, err := time.Parse("2006-01-02", date)
 
 	// Return an error if any
 	if err != nil {
@@ -473,10 +520,27 @@ func (co *CourtController) GetCurrentVendorCourtBookings(c echo.Context) error {
 		})
 	}
 
+	// Get the opening hours of the date
+	hours, err := co.ScheduleUseCase.GetCurrentVendorOpeningHours(cc.Token, parsedDate)
+
+	// Return an error if any
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Failed to get opening hours",
+			Data:    nil,
+		})
+	}
+
+	// Create the response
+	res := dto.CurrentUserCourtBookingsResponseDTO{}.FromModels(bookings)
+
+	res.OpeningHours = dto.OpeningHoursDTO{}.FromType(hours)
+
 	return c.JSON(http.StatusOK, dto.ResponseDTO{
 		Success: true,
 		Message: "Success retrieve current vendor court bookings",
-		Data:    dto.CurrentUserCourtBookingsResponseDTO{}.FromModels(bookings),
+		Data:    res,
 	})
 }
 
@@ -500,7 +564,7 @@ func (co *CourtController) UpdateCourtUsingCourtType(c echo.Context) error {
 	}
 
 	// Check if court type is valid
-	if !enums.InCourtType(courtType) {
+	if !co.CourtTypeUseCase.IsValidCourtType(courtType) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -603,3 +667,257 @@ func (co *CourtController) DeleteCourts(c echo.Context) error {
 		Data:    nil,
 	})
 }
+
+// LinkCourts is a controller that handles the link courts endpoint.
+// Endpoint: POST /vendors/me/courts/:type/links
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtController) LinkCourts(c echo.Context) error {
+	// Get the court type from the URL
+	courtType := c.Param("type")
+
+	// Return an error if the court type is invalid
+	if !co.CourtTypeUseCase.IsValidCourtType(courtType) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid court type",
+			Data:    nil,
+		})
+	}
+
+	// Create a new CourtLinksDTO object
+	data := new(dto.CourtLinksDTO)
+
+	// Bind the request body to the CourtLinksDTO object
+	if err := c.Bind(data); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the court links data
+	errMsg := co.CourtUseCase.ValidateCourtLinks(data)
+
+	// Return an error if any
+	if !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Link the courts
+	processErr := co.CourtUseCase.LinkCourts(cc.Token, courtType, data)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusForbidden, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success link courts",
+		Data:    nil,
+	})
+}
+
+// UnlinkCourts is a controller that handles the unlink courts endpoint.
+// Endpoint: DELETE /vendors/me/courts/:type/links
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtController) UnlinkCourts(c echo.Context) error {
+	// Get the court type from the URL
+	courtType := c.Param("type")
+
+	// Return an error if the court type is invalid
+	if !co.CourtTypeUseCase.IsValidCourtType(courtType) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid court type",
+			Data:    nil,
+		})
+	}
+
+	// Create a new CourtLinksDTO object
+	data := new(dto.CourtLinksDTO)
+
+	// Bind the request body to the CourtLinksDTO object
+	if err := c.Bind(data); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the court links data
+	errMsg := co.CourtUseCase.ValidateCourtLinks(data)
+
+	// Return an error if any
+	if !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Unlink the courts
+	processErr := co.CourtUseCase.UnlinkCourts(cc.Token, courtType, data)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusForbidden, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success unlink courts",
+		Data:    nil,
+	})
+}
+
+// GetVendorCourts is a controller that handles the admin get vendor courts endpoint.
+// Endpoint: GET /admin/vendors/:id/courts
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtController) GetVendorCourts(c echo.Context) error {
+	// Get the vendor id from the URL
+	vendorID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the vendor id is invalid
+	if err != nil || vendorID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid vendor id",
+			Data:    nil,
+		})
+	}
+
+	// Get the vendor courts
+	courts, err := co.CourtUseCase.GetVendorCourts(uint(vendorID))
+
+	// Return an error if any
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Failed to get vendor courts",
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve vendor courts",
+		Data:    dto.CurrentVendorCourtsResponseDTO{}.FromModels(courts),
+	})
+}
+
+// DeleteVendorCourts is a controller that handles the admin delete vendor courts endpoint.
+// Endpoint: DELETE /admin/vendors/:id/courts
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtController) DeleteVendorCourts(c echo.Context) error {
+	// Get the vendor id from the URL
+	vendorID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the vendor id is invalid
+	if err != nil || vendorID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid vendor id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new DeleteCourtsDTO object
+	data := new(dto.DeleteCourtsDTO)
+
+	// Bind the request body to the DeleteCourtsDTO object
+	if err := c.Bind(data); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the delete courts form
+	errs := co.CourtUseCase.ValidateDeleteCourts(data)
+
+	// Returns error if any
+	if !utils.IsBlank(errs) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Delete the vendor courts
+	err = co.CourtUseCase.DeleteVendorCourts(uint(vendorID), data)
+
+	// Return error if any
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Failed to delete courts",
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success delete courts",
+		Data:    nil,
+	})
+}
@@ -0,0 +1,400 @@
+package controllers
+
+import (
+	"log"
+	"main/domain/usecases"
+	"main/internal/dto"
+	"main/pkg/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// CourtTypeController is a struct that defines the CourtTypeController
+type CourtTypeController struct {
+	CourtTypeUseCase *usecases.CourtTypeUseCase
+}
+
+// NewCourtTypeController is a factory function that returns a new instance of the CourtTypeController.
+//
+// c: The court type use case.
+//
+// Returns a new instance of the CourtTypeController.
+func NewCourtTypeController(c *usecases.CourtTypeUseCase) *CourtTypeController {
+	return &CourtTypeController{
+		CourtTypeUseCase: c,
+	}
+}
+
+// GetCourtTypes is a controller that handles the get court types endpoint.
+// Endpoint: GET /court-types
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtTypeController) GetCourtTypes(c echo.Context) error {
+	// Get the active court types
+	courtTypes, err := co.CourtTypeUseCase.GetActiveCourtTypes()
+
+	// Return an error if any
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Failed to get court types",
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve court types",
+		Data:    dto.CourtTypesResponseDTO{}.FromModels(courtTypes),
+	})
+}
+
+// GetAllCourtTypes is a controller that handles the admin get court types endpoint.
+// Endpoint: GET /admin/court-types
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtTypeController) GetAllCourtTypes(c echo.Context) error {
+	// Get all the court types
+	courtTypes, err := co.CourtTypeUseCase.GetAllCourtTypes()
+
+	// Return an error if any
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Failed to get court types",
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve court types",
+		Data:    dto.CourtTypesResponseDTO{}.FromModels(courtTypes),
+	})
+}
+
+// CreateCourtType is a controller that handles the create court type endpoint.
+// Endpoint: POST /admin/court-types
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtTypeController) CreateCourtType(c echo.Context) error {
+	// Create a new CourtTypeFormDTO object
+	form := new(dto.CourtTypeFormDTO)
+
+	// Bind the request body to the CourtTypeFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Sanitize the court type form
+	co.CourtTypeUseCase.SanitizeCourtTypeForm(form)
+
+	// Validate the court type form
+	errs := co.CourtTypeUseCase.ValidateCourtTypeForm(form)
+
+	// Return an error if any
+	if errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Create the court type
+	courtType, processErr := co.CourtTypeUseCase.CreateCourtType(form)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Record the created court type in the audit trail
+	c.(*dto.CustomContext).AuditTargetID = &courtType.ID
+
+	return c.JSON(http.StatusCreated, dto.ResponseDTO{
+		Success: true,
+		Message: "Success create court type",
+		Data: dto.CourtTypeResponseDTO{
+			CourtType: dto.CourtTypeDTO{}.FromModel(courtType),
+		},
+	})
+}
+
+// RenameCourtType is a controller that handles the rename court type endpoint.
+// Endpoint: PATCH /admin/court-types/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtTypeController) RenameCourtType(c echo.Context) error {
+	// Get the court type id from the URL
+	courtTypeID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the court type id is invalid
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid court type id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new CourtTypeFormDTO object
+	form := new(dto.CourtTypeFormDTO)
+
+	// Bind the request body to the CourtTypeFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Sanitize the court type form
+	co.CourtTypeUseCase.SanitizeCourtTypeForm(form)
+
+	// Validate the court type form
+	errs := co.CourtTypeUseCase.ValidateCourtTypeForm(form)
+
+	// Return an error if any
+	if errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Rename the court type
+	courtType, processErr := co.CourtTypeUseCase.RenameCourtType(uint(courtTypeID), form)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success rename court type",
+		Data: dto.CourtTypeResponseDTO{
+			CourtType: dto.CourtTypeDTO{}.FromModel(courtType),
+		},
+	})
+}
+
+// UpdateCourtTypeIcon is a controller that handles the update court type icon endpoint.
+// Endpoint: PATCH /admin/court-types/:id/icon
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtTypeController) UpdateCourtTypeIcon(c echo.Context) error {
+	// Get the court type id from the URL
+	courtTypeID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the court type id is invalid
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid court type id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new dto
+	data := new(dto.ChangeCourtTypeIconDTO)
+
+	// Bind the request body to the ChangeCourtTypeIconDTO object
+	if err := c.Bind(data); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the icon data
+	errMsg := co.CourtTypeUseCase.ValidateChangeIconData(data)
+
+	// Return an error if any
+	if !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Change the court type icon
+	courtType, processErr := co.CourtTypeUseCase.ChangeCourtTypeIcon(uint(courtTypeID), data)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success update court type icon",
+		Data: dto.CourtTypeResponseDTO{
+			CourtType: dto.CourtTypeDTO{}.FromModel(courtType),
+		},
+	})
+}
+
+// RetireCourtType is a controller that handles the retire court type endpoint.
+// Endpoint: POST /admin/court-types/:id/retire
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtTypeController) RetireCourtType(c echo.Context) error {
+	// Get the court type id from the URL
+	courtTypeID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the court type id is invalid
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid court type id",
+			Data:    nil,
+		})
+	}
+
+	// Retire the court type
+	courtType, processErr := co.CourtTypeUseCase.RetireCourtType(uint(courtTypeID))
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retire court type",
+		Data: dto.CourtTypeResponseDTO{
+			CourtType: dto.CourtTypeDTO{}.FromModel(courtType),
+		},
+	})
+}
+
+// RestoreCourtType is a controller that handles the restore court type endpoint.
+// Endpoint: POST /admin/court-types/:id/restore
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (co *CourtTypeController) RestoreCourtType(c echo.Context) error {
+	// Get the court type id from the URL
+	courtTypeID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the court type id is invalid
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid court type id",
+			Data:    nil,
+		})
+	}
+
+	// Restore the court type
+	courtType, processErr := co.CourtTypeUseCase.RestoreCourtType(uint(courtTypeID))
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success restore court type",
+		Data: dto.CourtTypeResponseDTO{
+			CourtType: dto.CourtTypeDTO{}.FromModel(courtType),
+		},
+	})
+}
@@ -0,0 +1,349 @@
+package controllers
+
+import (
+	"log"
+	"main/domain/usecases"
+	"main/internal/dto"
+	"main/pkg/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// GalleryController is a struct that defines the GalleryController
+type GalleryController struct {
+	GalleryUseCase *usecases.GalleryUseCase
+}
+
+// NewGalleryController is a factory function that returns a new instance of the GalleryController.
+//
+// g: The gallery use case.
+//
+// Returns a new instance of the GalleryController.
+func NewGalleryController(g *usecases.GalleryUseCase) *GalleryController {
+	return &GalleryController{
+		GalleryUseCase: g,
+	}
+}
+
+// GetCurrentVendorGalleryImages is a controller that handles the get current vendor
+// gallery images endpoint.
+// Endpoint: GET /vendors/me/images
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (g *GalleryController) GetCurrentVendorGalleryImages(c echo.Context) error {
+	// Create the court id, nil for the venue gallery
+	var courtID *uint
+
+	// Get the court id from the query parameter
+	if courtIDParam := c.QueryParam("court_id"); !utils.IsBlank(courtIDParam) {
+		id, err := strconv.Atoi(courtIDParam)
+
+		// Return an error if the court id is invalid
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: "Invalid court id",
+				Data:    nil,
+			})
+		}
+
+		// Set the court id
+		parsedCourtID := uint(id)
+		courtID = &parsedCourtID
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Get the gallery images
+	images, processErr := g.GalleryUseCase.GetCurrentVendorGalleryImages(cc.Token, courtID)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve gallery images",
+		Data:    dto.GalleryImagesResponseDTO{}.FromModels(images),
+	})
+}
+
+// CreateGalleryImage is a controller that handles the create gallery image endpoint.
+// Endpoint: POST /vendors/me/images
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (g *GalleryController) CreateGalleryImage(c echo.Context) error {
+	// Create a new CreateGalleryImageFormDTO object
+	form := new(dto.CreateGalleryImageFormDTO)
+
+	// Bind the request body to the CreateGalleryImageFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Get the uploaded image file of a multipart request if any
+	form.ImageFile, _ = c.FormFile("image")
+
+	// Validate the create gallery image form
+	errs := g.GalleryUseCase.ValidateCreateGalleryImageForm(form)
+
+	// Return an error if any
+	if errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Create the gallery image
+	image, processErr := g.GalleryUseCase.CreateGalleryImage(cc.Token, form)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ResponseDTO{
+		Success: true,
+		Message: "Success create gallery image",
+		Data: dto.GalleryImageResponseDTO{
+			Image: dto.GalleryImageDTO{}.FromModel(image),
+		},
+	})
+}
+
+// UpdateGalleryImage is a controller that handles the update gallery image endpoint.
+// Endpoint: PATCH /vendors/me/images/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (g *GalleryController) UpdateGalleryImage(c echo.Context) error {
+	// Get the gallery image id from the URL
+	imageID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the gallery image id is invalid
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid image id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new UpdateGalleryImageFormDTO object
+	form := new(dto.UpdateGalleryImageFormDTO)
+
+	// Bind the request body to the UpdateGalleryImageFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the update gallery image form
+	errMsg := g.GalleryUseCase.ValidateUpdateGalleryImageForm(form)
+
+	// Return an error if any
+	if !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Update the gallery image
+	image, processErr := g.GalleryUseCase.UpdateGalleryImage(cc.Token, uint(imageID), form)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success update gallery image",
+		Data: dto.GalleryImageResponseDTO{
+			Image: dto.GalleryImageDTO{}.FromModel(image),
+		},
+	})
+}
+
+// ReorderGalleryImages is a controller that handles the reorder gallery images endpoint.
+// Endpoint: PUT /vendors/me/images/order
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (g *GalleryController) ReorderGalleryImages(c echo.Context) error {
+	// Create a new ReorderGalleryImagesDTO object
+	data := new(dto.ReorderGalleryImagesDTO)
+
+	// Bind the request body to the ReorderGalleryImagesDTO object
+	if err := c.Bind(data); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the reorder gallery images data
+	errMsg := g.GalleryUseCase.ValidateReorderGalleryImages(data)
+
+	// Return an error if any
+	if !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Reorder the gallery images
+	images, processErr := g.GalleryUseCase.ReorderGalleryImages(cc.Token, data)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success reorder gallery images",
+		Data:    dto.GalleryImagesResponseDTO{}.FromModels(images),
+	})
+}
+
+// DeleteGalleryImage is a controller that handles the delete gallery image endpoint.
+// Endpoint: DELETE /vendors/me/images/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (g *GalleryController) DeleteGalleryImage(c echo.Context) error {
+	// Get the gallery image id from the URL
+	imageID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the gallery image id is invalid
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid image id",
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Delete the gallery image
+	processErr := g.GalleryUseCase.DeleteGalleryImage(cc.Token, uint(imageID))
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success delete gallery image",
+		Data:    nil,
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"main/core/enums"
 	"main/domain/usecases"
 	"main/internal/dto"
+	"main/pkg/utils"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -12,18 +13,18 @@ import (
 
 // LoginController is a struct that defines the login controller.
 type LoginController struct {
-	LoginUseCase *usecases.LoginUseCase
-	AuthUseCase  *usecases.AuthUseCase
+	LoginUseCase        *usecases.LoginUseCase
+	RefreshTokenUseCase *usecases.RefreshTokenUseCase
 }
 
 // NewLoginController is a factory function that returns a new instance of the LoginController.
 //
 // l: The login use case.
-// a: The auth use case.
+// r: The refresh token use case.
 //
 // Returns a new instance of the LoginController.
-func NewLoginController(l *usecases.LoginUseCase, a *usecases.AuthUseCase) *LoginController {
-	return &LoginController{LoginUseCase: l, AuthUseCase: a}
+func NewLoginController(l *usecases.LoginUseCase, r *usecases.RefreshTokenUseCase) *LoginController {
+	return &LoginController{LoginUseCase: l, RefreshTokenUseCase: r}
 
 }
 
@@ -77,8 +78,8 @@ func (l *LoginController) UserLogin(c echo.Context) error {
 		})
 	}
 
-	// Generate a token
-	token, err := l.AuthUseCase.GenerateToken(user.ID, enums.User)
+	// Generate the tokens
+	token, refreshToken, err := l.RefreshTokenUseCase.GenerateTokens(user.ID, enums.User, 0)
 
 	// Check if there is an error generating the token
 	if err != nil {
@@ -93,8 +94,9 @@ func (l *LoginController) UserLogin(c echo.Context) error {
 		Success: true,
 		Message: "User Login Success",
 		Data: dto.UserLoginResponseDTO{
-			User:  dto.CurrentUserDTO{}.FromModel(user),
-			Token: token,
+			User:         dto.CurrentUserDTO{}.FromModel(user),
+			Token:        token,
+			RefreshToken: refreshToken,
 		},
 	})
 }
@@ -148,8 +150,8 @@ func (l *LoginController) VendorLogin(c echo.Context) error {
 		})
 	}
 
-	// Generate a token
-	token, err := l.AuthUseCase.GenerateToken(vendor.ID, enums.Vendor)
+	// Generate the tokens
+	token, refreshToken, err := l.RefreshTokenUseCase.GenerateTokens(vendor.ID, enums.Vendor, 0)
 
 	// Check if there is an error generating the token
 	if err != nil {
@@ -164,8 +166,225 @@ func (l *LoginController) VendorLogin(c echo.Context) error {
 		Success: true,
 		Message: "Vendor Login Success",
 		Data: dto.VendorLoginResponseDTO{
-			Vendor: dto.CurrentVendorDTO{}.FromModel(vendor),
-			Token:  token,
+			Vendor:       dto.CurrentVendorDTO{}.FromModel(vendor),
+			Token:        token,
+			RefreshToken: refreshToken,
+		},
+	})
+}
+
+// VendorStaffLogin is a function that handles the vendor staff login request,
+// the staff is signed in to its vendor with its own account.
+// Endpoint: POST /auth/vendor/staff/login
+//
+// c: The echo context.
+//
+// Returns an error response if there is an error, otherwise a success response.
+func (l *LoginController) VendorStaffLogin(c echo.Context) error {
+	// Create a new VendorLoginForm dto object
+	form := new(dto.VendorLoginFormDTO)
+
+	// Bind the request body to the VendorLoginForm object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the login form
+	errs := l.LoginUseCase.ValidateVendorForm(form)
+
+	// Check if there are any errors
+	if errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Process the login form
+	staff, processErr := l.LoginUseCase.ProcessVendorStaff(form)
+
+	if processErr != nil {
+		// Check if the error is a client error
+		if processErr.ClientError {
+			return c.JSON(http.StatusUnauthorized, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Generate the tokens
+	token, refreshToken, err := l.RefreshTokenUseCase.GenerateTokens(staff.VendorID, enums.Vendor, staff.ID)
+
+	// Check if there is an error generating the token
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Error generating token",
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Vendor Staff Login Success",
+		Data: dto.VendorLoginResponseDTO{
+			Vendor:       dto.CurrentVendorDTO{}.FromModel(&staff.Vendor),
+			Staff:        dto.VendorStaffDTO{}.FromModel(staff),
+			Token:        token,
+			RefreshToken: refreshToken,
+		},
+	})
+}
+
+// AdminLogin is a function that handles the admin login request.
+// Endpoint: POST /auth/admin/login
+//
+// c: The echo context.
+//
+// Returns an error response if there is an error, otherwise a success response.
+func (l *LoginController) AdminLogin(c echo.Context) error {
+	// Create a new AdminLoginFormDTO object
+	form := new(dto.AdminLoginFormDTO)
+
+	// Bind the request body to the AdminLoginFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the login form
+	errs := l.LoginUseCase.ValidateAdminForm(form)
+
+	// Check if there are any errors
+	if errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Process the login form
+	admin, processErr := l.LoginUseCase.ProcessAdmin(form)
+
+	if processErr != nil {
+		// Check if the error is a client error
+		if processErr.ClientError {
+			return c.JSON(http.StatusUnauthorized, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Generate the tokens
+	token, refreshToken, err := l.RefreshTokenUseCase.GenerateTokens(admin.ID, enums.Admin, 0)
+
+	// Check if there is an error generating the token
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Error generating token",
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Admin Login Success",
+		Data: dto.AdminLoginResponseDTO{
+			Admin:        dto.AdminDTO{}.FromModel(admin),
+			Token:        token,
+			RefreshToken: refreshToken,
+		},
+	})
+}
+
+// RefreshToken is a function that handles the token refresh request, the refresh token
+// is rotated and a new JWT access token is issued for the client of the token.
+// Endpoint: POST /auth/refresh
+//
+// c: The echo context.
+//
+// Returns an error response if there is an error, otherwise a success response.
+func (l *LoginController) RefreshToken(c echo.Context) error {
+	// Create a new RefreshTokenFormDTO object
+	form := new(dto.RefreshTokenFormDTO)
+
+	// Bind the request body to the RefreshTokenFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the refresh token form
+	if errMsg := l.RefreshTokenUseCase.ValidateRefreshTokenForm(form); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Process the refresh token
+	token, refreshToken, processErr := l.RefreshTokenUseCase.ProcessRefreshToken(form)
+
+	// Check if there is an error processing the refresh token
+	if processErr != nil {
+		// Check if the error is a client error
+		if processErr.ClientError {
+			return c.JSON(http.StatusUnauthorized, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Token refreshed successfully",
+		Data: dto.RefreshTokenResponseDTO{
+			Token:        token,
+			RefreshToken: refreshToken,
 		},
 	})
 }
@@ -10,20 +10,23 @@ import (
 
 // LogoutController is a struct that defines the logout controller.
 type LogoutController struct {
-	LogoutUseCase *usecases.LogoutUseCase
+	LogoutUseCase       *usecases.LogoutUseCase
+	RefreshTokenUseCase *usecases.RefreshTokenUseCase
 }
 
 // NewLogoutController is a factory function that returns a new instance of the LogoutController.
 //
 // l: The logout use case.
+// r: The refresh token use case.
 //
 // Returns a new instance of the LogoutController.
-func NewLogoutController(l *usecases.LogoutUseCase) *LogoutController {
-	return &LogoutController{LogoutUseCase: l}
+func NewLogoutController(l *usecases.LogoutUseCase, r *usecases.RefreshTokenUseCase) *LogoutController {
+	return &LogoutController{LogoutUseCase: l, RefreshTokenUseCase: r}
 }
 
 // UserLogout is a handler that logs out a user
-// by blacklisting the token used to authenticate the user.
+// by blacklisting the token used to authenticate the user and
+// revoking the refresh tokens of the token.
 // Endpoint: POST /auth/user/logout
 //
 // c: echo.Context
@@ -44,6 +47,18 @@ func (l *LogoutController) UserLogout(c echo.Context) error {
 		})
 	}
 
+	// Revoke the refresh token family of the token
+	err = l.RefreshTokenUseCase.RevokeTokenFamily(cc.Token)
+
+	// Check if there was an error revoking the refresh tokens
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Could not revoke refresh tokens",
+			Data:    nil,
+		})
+	}
+
 	return c.JSON(http.StatusOK, dto.ResponseDTO{
 		Success: true,
 		Message: "Successfully logged out",
@@ -52,7 +67,8 @@ func (l *LogoutController) UserLogout(c echo.Context) error {
 }
 
 // VendorLogout is a handler that logs out a vendor
-// by blacklisting the token used to authenticate the vendor.
+// by blacklisting the token used to authenticate the vendor and
+// revoking the refresh tokens of the token.
 // Endpoint: POST /auth/vendor/logout
 //
 // c: echo.Context
@@ -73,6 +89,60 @@ func (l *LogoutController) VendorLogout(c echo.Context) error {
 		})
 	}
 
+	// Revoke the refresh token family of the token
+	err = l.RefreshTokenUseCase.RevokeTokenFamily(cc.Token)
+
+	// Check if there was an error revoking the refresh tokens
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Could not revoke refresh tokens",
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Successfully logged out",
+		Data:    nil,
+	})
+}
+
+// AdminLogout is a handler that logs out an admin
+// by blacklisting the token used to authenticate the admin and
+// revoking the refresh tokens of the token.
+// Endpoint: POST /auth/admin/logout
+//
+// c: echo.Context
+//
+// Returns an error response if there is an error, otherwise a success response.
+func (l *LogoutController) AdminLogout(c echo.Context) error {
+	cc := c.(*dto.CustomContext)
+
+	// Blacklist token
+	err := l.LogoutUseCase.BlacklistToken(cc.Token)
+
+	// Check if there was an error blacklisting the token
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Could not blacklist token",
+			Data:    nil,
+		})
+	}
+
+	// Revoke the refresh token family of the token
+	err = l.RefreshTokenUseCase.RevokeTokenFamily(cc.Token)
+
+	// Check if there was an error revoking the refresh tokens
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: "Could not revoke refresh tokens",
+			Data:    nil,
+		})
+	}
+
 	return c.JSON(http.StatusOK, dto.ResponseDTO{
 		Success: true,
 		Message: "Successfully logged out",
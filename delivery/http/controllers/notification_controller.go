@@ -0,0 +1,163 @@
+package controllers
+
+import (
+	"main/domain/usecases"
+	"main/internal/dto"
+	"main/pkg/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// NotificationController is a struct that defines the NotificationController
+type NotificationController struct {
+	NotificationUseCase *usecases.NotificationUseCase
+	PaginationUseCase   *usecases.PaginationUseCase
+}
+
+// NewNotificationController is a factory function that returns a new instance of the NotificationController.
+//
+// n: The notification use case.
+// p: The pagination use case.
+//
+// Returns a new instance of the NotificationController.
+func NewNotificationController(n *usecases.NotificationUseCase, p *usecases.PaginationUseCase) *NotificationController {
+	return &NotificationController{
+		NotificationUseCase: n,
+		PaginationUseCase:   p,
+	}
+}
+
+// GetCurrentUserNotifications is a controller that handles the request to get the
+// notifications of the current user.
+// Endpoint: GET /users/me/notifications
+//
+// c: The echo context.
+//
+// Returns a response containing the notifications.
+func (n *NotificationController) GetCurrentUserNotifications(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := n.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the notifications
+	unread, notifications, next, processErr :=
+		n.NotificationUseCase.GetCurrentUserNotifications(cc.Token, n.PaginationUseCase.GetPage(pagination))
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Create the notifications response with the pagination
+	res := dto.NotificationsResponseDTO{}.FromModels(unread, notifications)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Notifications retrieved successfully",
+		Data:    res,
+	})
+}
+
+// ReadNotification is a controller that handles the request to mark a notification
+// of the current user as read.
+// Endpoint: POST /users/me/notifications/:id/read
+//
+// c: The echo context.
+//
+// Returns a response indicating the notification is read.
+func (n *NotificationController) ReadNotification(c echo.Context) error {
+	// Get the notification id from the URL
+	notificationID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the notification id is invalid
+	if err != nil || notificationID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid notification id",
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Mark the notification as read
+	processErr := n.NotificationUseCase.ReadNotification(cc.Token, uint(notificationID))
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Notification read successfully",
+		Data:    nil,
+	})
+}
+
+// ReadAllNotifications is a controller that handles the request to mark every
+// notification of the current user as read.
+// Endpoint: POST /users/me/notifications/read
+//
+// c: The echo context.
+//
+// Returns a response indicating the notifications are read.
+func (n *NotificationController) ReadAllNotifications(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Mark the notifications as read
+	if processErr := n.NotificationUseCase.ReadAllNotifications(cc.Token); processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Notifications read successfully",
+		Data:    nil,
+	})
+}
@@ -3,6 +3,7 @@ package controllers
 import (
 	"log"
 	"main/core/enums"
+	"main/data/models"
 	"main/domain/usecases"
 	"main/internal/dto"
 	"main/pkg/utils"
@@ -14,23 +15,62 @@ import (
 
 // OrderController is a struct that defines the OrderController
 type OrderController struct {
-	OrderUseCase  *usecases.OrderUseCase
-	ReviewUseCase *usecases.ReviewUseCase
+	OrderUseCase      *usecases.OrderUseCase
+	ReviewUseCase     *usecases.ReviewUseCase
+	CourtTypeUseCase  *usecases.CourtTypeUseCase
+	StaffUseCase      *usecases.StaffUseCase
+	PaginationUseCase *usecases.PaginationUseCase
 }
 
 // NewOrderController is a function that returns a new OrderController
 //
 // o: The OrderUseCase
 // r: The ReviewUseCase
+// t: The CourtTypeUseCase
+// s: The StaffUseCase
+// p: The PaginationUseCase
 //
 // Returns a pointer to the OrderController struct
-func NewOrderController(o *usecases.OrderUseCase, r *usecases.ReviewUseCase) *OrderController {
+func NewOrderController(o *usecases.OrderUseCase, r *usecases.ReviewUseCase, t *usecases.CourtTypeUseCase, s *usecases.StaffUseCase, p *usecases.PaginationUseCase) *OrderController {
 	return &OrderController{
-		OrderUseCase:  o,
-		ReviewUseCase: r,
+		OrderUseCase:      o,
+		ReviewUseCase:     r,
+		CourtTypeUseCase:  t,
+		StaffUseCase:      s,
+		PaginationUseCase: p,
 	}
 }
 
+// canViewRevenue is a helper function that checks if the signed in staff
+// is granted to view the revenue, like the order prices.
+//
+// cc: The custom context.
+//
+// Returns true if the staff can view the revenue.
+func (o *OrderController) canViewRevenue(cc *dto.CustomContext) bool {
+	return o.StaffUseCase.GetStaffRole(cc.Staff).HasPermission(enums.PermissionViewRevenue)
+}
+
+// currentVendorOrderDetailDTO is a helper function that converts the order to the
+// current vendor order detail DTO, the price is hidden from the staff who can't
+// view the revenue.
+//
+// cc: The custom context.
+// order: The order model.
+//
+// Returns the current vendor order detail DTO.
+func (o *OrderController) currentVendorOrderDetailDTO(cc *dto.CustomContext, order *models.Order) *dto.CurrentVendorOrderDetailDTO {
+	// Convert the order
+	orderDetail := dto.CurrentVendorOrderDetailDTO{}.FromModel(order)
+
+	// Hide the price if the staff can't view the revenue
+	if !o.canViewRevenue(cc) {
+		orderDetail.HidePrice()
+	}
+
+	return orderDetail
+}
+
 // GetCurrentUserBooking is a controller that gets the current user booking
 // from the database.
 // Endpoint: GET /vendors/me/orders
@@ -43,7 +83,7 @@ func (o *OrderController) GetCurrentVendorOrders(c echo.Context) error {
 	courtTypeParam := c.QueryParam("type")
 
 	// Check if the court type is not empty
-	if !utils.IsBlank(courtTypeParam) && !enums.InCourtType(courtTypeParam) {
+	if !utils.IsBlank(courtTypeParam) && !o.CourtTypeUseCase.IsValidCourtType(courtTypeParam) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -51,12 +91,33 @@ func (o *OrderController) GetCurrentVendorOrders(c echo.Context) error {
 		})
 	}
 
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := o.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
 	// Get custom context
 	cc := c.(*dto.CustomContext)
 
 	// Get the current vendor orders
-	orders, err :=
-		o.OrderUseCase.GetCurrentVendorOrders(cc.Token, &courtTypeParam)
+	orders, next, err :=
+		o.OrderUseCase.GetCurrentVendorOrders(cc.Token, &courtTypeParam, o.PaginationUseCase.GetPage(pagination))
 
 	// Return an error if any
 	if err != nil {
@@ -67,10 +128,19 @@ func (o *OrderController) GetCurrentVendorOrders(c echo.Context) error {
 		})
 	}
 
+	// Create the orders response with the pagination
+	res := dto.CurrentVendorOrdersResponseDTO{}.FromModels(orders)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	// Hide the prices if the staff can't view the revenue
+	if !o.canViewRevenue(cc) {
+		res.HidePrices()
+	}
+
 	return c.JSON(http.StatusOK, dto.ResponseDTO{
 		Success: true,
 		Message: "Vendor orders retrieved successfully",
-		Data:    dto.CurrentVendorOrdersResponseDTO{}.FromModels(orders),
+		Data:    res,
 	})
 }
 
@@ -166,6 +236,72 @@ func (o *OrderController) CreateOrder(c echo.Context) error {
 	})
 }
 
+// CreateWalkInOrder is a controller that records the bookings of a walk-in customer.
+// Endpoint: POST /vendors/me/orders/walk-in
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (o *OrderController) CreateWalkInOrder(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Create a new CreateWalkInOrderDTO object
+	data := new(dto.CreateWalkInOrderDTO)
+
+	// Bind the request body to the CreateWalkInOrderDTO object
+	if err := c.Bind(data); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the CreateWalkInOrderDTO object
+	errorMsg := o.OrderUseCase.ValidateCreateWalkInOrder(cc.Token, data)
+
+	// Return an error if any
+	if !utils.IsBlank(errorMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errorMsg,
+			Data:    nil,
+		})
+	}
+
+	// Create the walk-in order
+	order, processErr := o.OrderUseCase.CreateWalkInOrder(cc.Token, data)
+
+	// Return an error if any
+	if processErr != nil {
+		// Check if the error is a client error
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ResponseDTO{
+		Success: true,
+		Message: "Walk-in order created successfully",
+		Data: dto.CurrentVendorOrderDetailResponseDTO{
+			OrderDetail: o.currentVendorOrderDetailDTO(cc, order),
+		},
+	})
+}
+
 // GetCurrentUserOrders is a controller that gets the current user orders
 // from the database.
 // Endpoint: GET /users/me/orders
@@ -178,7 +314,7 @@ func (o *OrderController) GetCurrentUserOrders(c echo.Context) error {
 	courtType := c.QueryParam("type")
 
 	// Check if the court type is not empty
-	if !utils.IsBlank(courtType) && !enums.InCourtType(courtType) {
+	if !utils.IsBlank(courtType) && !o.CourtTypeUseCase.IsValidCourtType(courtType) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -186,11 +322,32 @@ func (o *OrderController) GetCurrentUserOrders(c echo.Context) error {
 		})
 	}
 
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := o.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
 	// Get custom context
 	cc := c.(*dto.CustomContext)
 
 	// Get the current user orders
-	orders, err := o.OrderUseCase.GetCurrentUserOrders(cc.Token, &courtType)
+	orders, next, err := o.OrderUseCase.GetCurrentUserOrders(cc.Token, &courtType, o.PaginationUseCase.GetPage(pagination))
 
 	// Return an error if any
 	if err != nil {
@@ -203,10 +360,14 @@ func (o *OrderController) GetCurrentUserOrders(c echo.Context) error {
 
 	// Check if the orders is empty
 	if len(*orders) == 0 {
+		// Create the orders response with the pagination
+		res := dto.CurrentUserOrdersResponseDTO{}.FromModels(orders)
+		res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
 		return c.JSON(http.StatusOK, dto.ResponseDTO{
 			Success: true,
 			Message: "User orders retrieved successfully",
-			Data:    dto.CurrentUserOrdersResponseDTO{}.FromModels(orders),
+			Data:    res,
 		})
 	}
 
@@ -217,7 +378,7 @@ func (o *OrderController) GetCurrentUserOrders(c echo.Context) error {
 	// Convert the orders to order DTOs
 	for _, order := range *orders {
 		// Check if user has reviewed for court type
-		reviewed, err := o.ReviewUseCase.CheckCurrentUserHasReviewedUsingVendorIDCourtType(cc.Token, order.Bookings[0].VendorID, order.Bookings[0].Court.CourtType.Type)
+		reviewed, err := o.ReviewUseCase.CheckCurrentUserHasReviewedUsingVendorIDCourtType(cc.Token, order.Bookings[0].VendorID, order.Bookings[0].CourtType.Type)
 
 		// Return an error if any
 		if err != nil {
@@ -236,7 +397,8 @@ func (o *OrderController) GetCurrentUserOrders(c echo.Context) error {
 		Success: true,
 		Message: "User orders retrieved successfully",
 		Data: dto.CurrentUserOrdersResponseDTO{
-			Orders: &dtos,
+			Orders:     &dtos,
+			Pagination: dto.PaginationDTO{}.FromCursor(next),
 		},
 	})
 }
@@ -367,7 +529,126 @@ func (o *OrderController) GetCurrentVendorOrderDetail(c echo.Context) error {
 		Success: true,
 		Message: "User order detail retrieved successfully",
 		Data: dto.CurrentVendorOrderDetailResponseDTO{
-			OrderDetail: dto.CurrentVendorOrderDetailDTO{}.FromModel(order),
+			OrderDetail: o.currentVendorOrderDetailDTO(cc, order),
+		},
+	})
+}
+
+// GetOrders is a controller that gets the orders of every user for the admin.
+// Endpoint: GET /admin/orders
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (o *OrderController) GetOrders(c echo.Context) error {
+	// Get the payment status filter from the query parameter
+	status := c.QueryParam("status")
+
+	// Check if the payment status filter is given
+	if !utils.IsBlank(status) {
+		// Get the payment status label
+		label, ok := o.OrderUseCase.GetPaymentStatus(status)
+
+		// Return an error if the payment status is invalid
+		if !ok {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: "Invalid status query parameter",
+				Data:    nil,
+			})
+		}
+
+		status = label
+	}
+
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := o.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the orders
+	orders, next, processErr := o.OrderUseCase.GetOrders(status, o.PaginationUseCase.GetPage(pagination))
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Create the orders response with the pagination
+	res := dto.AdminOrdersResponseDTO{}.FromModels(orders)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Orders retrieved successfully",
+		Data:    res,
+	})
+}
+
+// GetOrderDetail is a controller that gets an order detail for the admin.
+// Endpoint: GET /admin/orders/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (o *OrderController) GetOrderDetail(c echo.Context) error {
+	// Get the order ID from the path parameter
+	orderID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the order ID is invalid
+	if err != nil || orderID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid order ID",
+			Data:    nil,
+		})
+	}
+
+	// Get the order detail
+	order, processErr := o.OrderUseCase.GetOrderDetail(uint(orderID))
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Order detail retrieved successfully",
+		Data: dto.AdminOrderDetailResponseDTO{
+			Order: dto.AdminOrderDetailDTO{}.FromModel(order),
 		},
 	})
 }
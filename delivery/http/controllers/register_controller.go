@@ -2,8 +2,10 @@ package controllers
 
 import (
 	"log"
+	"main/core/constants"
 	"main/domain/usecases"
 	"main/internal/dto"
+	"mime/multipart"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
@@ -90,3 +92,76 @@ func (r RegisterController) UserRegister(c echo.Context) error {
 		},
 	})
 }
+
+// VendorRegister is a function that handles the vendor register request, the vendor
+// is registered waiting for the admin approval.
+// Endpoint: POST /auth/vendor/register
+//
+// c: The echo context.
+//
+// Returns an error response if there is an error, otherwise a success response.
+func (r RegisterController) VendorRegister(c echo.Context) error {
+	// Create a new VendorRegisterForm dto object
+	form := new(dto.VendorRegisterFormDTO)
+
+	// Bind the request body to the VendorRegisterForm object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding the request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Get the uploaded document files of a multipart request if any
+	form.DocumentFiles = make(map[string]*multipart.FileHeader)
+
+	for documentType := range constants.VENDOR_DOCUMENT_TYPES {
+		if file, err := c.FormFile(documentType); err == nil {
+			form.DocumentFiles[documentType] = file
+		}
+	}
+
+	// Sanitize the form
+	r.RegisterUseCase.SanitizeVendorRegisterForm(form)
+
+	// Validate the form
+	if errs := r.RegisterUseCase.ValidateVendorRegisterForm(form); errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Register the vendor
+	vendor, processErr := r.RegisterUseCase.ProcessVendorRegister(form)
+
+	// Return an error if any
+	if processErr != nil {
+		// Check if the error is a client error
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ResponseDTO{
+		Success: true,
+		Message: "Vendor registered successfully, waiting for approval",
+		Data: dto.VendorApplicationResponseDTO{
+			Vendor: dto.VendorApplicationDTO{}.FromModel(vendor),
+		},
+	})
+}
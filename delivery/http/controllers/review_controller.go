@@ -14,17 +14,23 @@ import (
 
 // ReviewController is a struct that defines the ReviewController
 type ReviewController struct {
-	ReviewUseCase *usecases.ReviewUseCase
+	ReviewUseCase     *usecases.ReviewUseCase
+	CourtTypeUseCase  *usecases.CourtTypeUseCase
+	PaginationUseCase *usecases.PaginationUseCase
 }
 
 // NewReviewController is a factory function that returns a new instance of the ReviewController.
 //
 // r: The review use case.
+// t: The court type use case.
+// p: The pagination use case.
 //
 // Returns a new instance of the ReviewController.
-func NewReviewController(r *usecases.ReviewUseCase) *ReviewController {
+func NewReviewController(r *usecases.ReviewUseCase, t *usecases.CourtTypeUseCase, p *usecases.PaginationUseCase) *ReviewController {
 	return &ReviewController{
-		ReviewUseCase: r,
+		ReviewUseCase:     r,
+		CourtTypeUseCase:  t,
+		PaginationUseCase: p,
 	}
 }
 
@@ -55,7 +61,7 @@ func (r *ReviewController) GetCourtTypeReviews(c echo.Context) error {
 	courtType := c.Param("type")
 
 	// Validate the court type
-	if !enums.InCourtType(courtType) {
+	if !r.CourtTypeUseCase.IsValidCourtType(courtType) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -93,8 +99,65 @@ func (r *ReviewController) GetCourtTypeReviews(c echo.Context) error {
 		}
 	}
 
+	// Get the with photos query parameter
+	withPhotosParam := c.QueryParam("with_photos")
+
+	// Create a new with photos variable
+	var withPhotos bool
+
+	// Check if the with photos query parameter is empty
+	if !utils.IsBlank(withPhotosParam) {
+		// Convert the with photos query parameter to a boolean
+		withPhotos, err = strconv.ParseBool(withPhotosParam)
+
+		// Check if the with photos query parameter is invalid
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: "Invalid with_photos query parameter",
+				Data:    nil,
+			})
+		}
+	}
+
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := r.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the page of the reviews
+	page := r.PaginationUseCase.GetPage(pagination)
+
+	// Get the sort query parameter
+	sort := c.QueryParam("sort")
+
+	// Validate the sort order of the reviews
+	if errMsg := r.ReviewUseCase.ValidateReviewsSort(sort, page); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
 	// Get the reviews from the database
-	reviewsMap, err := r.ReviewUseCase.GetCourtTypeReviews(uint(vendorID), courtType, &rating)
+	reviewsMap, err := r.ReviewUseCase.GetCourtTypeReviews(uint(vendorID), courtType, &rating, withPhotos, sort, page)
 
 	// Check if there is an error
 	if err != nil {
@@ -156,9 +219,66 @@ func (r *ReviewController) GetCurrentVendorReviews(c echo.Context) error {
 		}
 	}
 
+	// Get the with photos query parameter
+	withPhotosParam := c.QueryParam("with_photos")
+
+	// Create a new with photos variable
+	var withPhotos bool
+
+	// Check if the with photos query parameter is empty
+	if !utils.IsBlank(withPhotosParam) {
+		// Convert the with photos query parameter to a boolean
+		withPhotos, err = strconv.ParseBool(withPhotosParam)
+
+		// Check if the with photos query parameter is invalid
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: "Invalid with_photos query parameter",
+				Data:    nil,
+			})
+		}
+	}
+
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := r.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the page of the reviews
+	page := r.PaginationUseCase.GetPage(pagination)
+
+	// Get the sort query parameter
+	sort := c.QueryParam("sort")
+
+	// Validate the sort order of the reviews
+	if errMsg := r.ReviewUseCase.ValidateReviewsSort(sort, page); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
 	// Get the reviews from the database
 	reviewsMap, err := 
-		r.ReviewUseCase.GetCurrentVendorReviews(cc.Token, &rating)
+		r.ReviewUseCase.GetCurrentVendorReviews(cc.Token, &rating, withPhotos, sort, page)
 
 	// Check if there is an error
 	if err != nil {
@@ -220,7 +340,7 @@ func (r *ReviewController) CreateReview(c echo.Context) error {
 	}
 
 	// Validate the court type
-	if !enums.InCourtType(courtType) {
+	if !r.CourtTypeUseCase.IsValidCourtType(courtType) {
 		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
 			Success: false,
 			Message: "Invalid court type",
@@ -238,6 +358,11 @@ func (r *ReviewController) CreateReview(c echo.Context) error {
 		return err
 	}
 
+	// Get the uploaded image files of a multipart request if any
+	if multipartForm, err := c.MultipartForm(); err == nil {
+		form.ImageFiles = multipartForm.File["images"]
+	}
+
 	// Sanitize the review form
 	r.ReviewUseCase.SanitizeCreateReviewForm(form)
 
@@ -261,6 +386,15 @@ func (r *ReviewController) CreateReview(c echo.Context) error {
 
 	// Check if there is an error
 	if processErr != nil {
+		// Check if the user has already reviewed the court
+		if processErr.Conflict {
+			return c.JSON(http.StatusConflict, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
 		// Check if the error is a client error
 		if processErr.ClientError {
 			return c.JSON(http.StatusForbidden, dto.ResponseDTO{
@@ -285,3 +419,792 @@ func (r *ReviewController) CreateReview(c echo.Context) error {
 		},
 	})
 }
+
+// GetCurrentUserBookingsAwaitingReview is a controller that handles the request to get the completed
+// bookings of the current user waiting for a review.
+// Endpoint: GET /users/me/reviews/pending
+//
+// c: The echo context.
+//
+// Returns a response containing the bookings waiting for a review.
+func (r *ReviewController) GetCurrentUserBookingsAwaitingReview(c echo.Context) error {
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := r.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Get the bookings waiting for a review
+	bookings, next, processErr := r.ReviewUseCase.GetCurrentUserBookingsAwaitingReview(cc.Token, r.PaginationUseCase.GetPage(pagination))
+
+	// Check if there is an error
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Create the bookings response with the pagination
+	res := dto.BookingsAwaitingReviewResponseDTO{}.FromModels(bookings)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Bookings awaiting review retrieved successfully",
+		Data:    res,
+	})
+}
+
+// UpdateCurrentUserReview is a controller that handles the request to update the current user review.
+// Endpoint: PATCH /users/me/reviews/:id
+//
+// c: The echo context.
+//
+// Returns a response containing the updated review.
+func (r *ReviewController) UpdateCurrentUserReview(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new UpdateReviewFormDTO object
+	form := new(dto.UpdateReviewFormDTO)
+
+	// Bind the request body to the UpdateReviewFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the update review form
+	if errs := r.ReviewUseCase.ValidateUpdateReviewForm(form); errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Process the update of the review
+	review, processErr := r.ReviewUseCase.ProcessUpdateReview(cc.Token, uint(reviewID), form)
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Review updated successfully",
+		Data: dto.ReviewResponseDTO{
+			Review: dto.ReviewDTO{}.FromModel(review),
+		},
+	})
+}
+
+// DeleteCurrentUserReview is a controller that handles the request to delete the current user review.
+// Endpoint: DELETE /users/me/reviews/:id
+//
+// c: The echo context.
+//
+// Returns a response indicating the review is deleted.
+func (r *ReviewController) DeleteCurrentUserReview(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Process the deletion of the review
+	processErr := r.ReviewUseCase.ProcessDeleteReview(cc.Token, uint(reviewID))
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Review deleted successfully",
+		Data:    nil,
+	})
+}
+
+// GetReviews is a controller that handles the request to get the reviews for the admin.
+// Endpoint: GET /admin/reviews
+//
+// c: The echo context.
+//
+// Returns a response containing the reviews.
+func (r *ReviewController) GetReviews(c echo.Context) error {
+	// Get the vendor id filter from the query parameter
+	var vendorID *uint
+
+	// Check if the vendor id filter is given
+	if param := c.QueryParam("vendor_id"); !utils.IsBlank(param) {
+		// Parse the vendor id
+		id, err := strconv.Atoi(param)
+
+		// Return an error if the vendor id is invalid
+		if err != nil || id <= 0 {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: "Invalid vendor id",
+				Data:    nil,
+			})
+		}
+
+		parsedID := uint(id)
+		vendorID = &parsedID
+	}
+
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := r.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the reviews
+	reviews, next, processErr := r.ReviewUseCase.GetReviews(vendorID, r.PaginationUseCase.GetPage(pagination))
+
+	// Check if there is an error
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Create the reviews response with the pagination
+	res := dto.AdminReviewsResponseDTO{}.FromModels(reviews)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Reviews retrieved successfully",
+		Data:    res,
+	})
+}
+
+// DeleteReview is a controller that handles the request to delete a review.
+// Endpoint: DELETE /admin/reviews/:id
+//
+// c: The echo context.
+//
+// Returns a response indicating the review is deleted.
+func (r *ReviewController) DeleteReview(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Delete the review
+	processErr := r.ReviewUseCase.DeleteReview(uint(reviewID))
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Review deleted successfully",
+		Data:    nil,
+	})
+}
+
+// GetReviewHistory is a controller that handles the request to get a review with its
+// previous versions for the admin, including the deleted review.
+// Endpoint: GET /admin/reviews/:id/history
+//
+// c: The echo context.
+//
+// Returns a response containing the review history.
+func (r *ReviewController) GetReviewHistory(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Get the review history
+	review, revisions, processErr := r.ReviewUseCase.GetReviewHistory(uint(reviewID))
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Review history retrieved successfully",
+		Data:    dto.AdminReviewHistoryResponseDTO{}.FromModels(review, revisions),
+	})
+}
+
+// ReplyReview is a controller that handles the request to reply to a review of the current vendor.
+// Endpoint: PUT /vendors/me/reviews/:id/reply
+//
+// c: The echo context.
+//
+// Returns a response containing the replied review.
+func (r *ReviewController) ReplyReview(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new ReviewReplyFormDTO object
+	form := new(dto.ReviewReplyFormDTO)
+
+	// Bind the request body to the ReviewReplyFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the review reply form
+	if errs := r.ReviewUseCase.ValidateReviewReplyForm(form); errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Process the reply of the review
+	review, processErr := r.ReviewUseCase.ProcessReplyReview(cc.Token, uint(reviewID), form)
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Reply saved successfully",
+		Data: dto.ReviewResponseDTO{
+			Review: dto.ReviewDTO{}.FromModel(review),
+		},
+	})
+}
+
+// DeleteReviewReply is a controller that handles the request to delete the reply of the
+// current vendor to a review.
+// Endpoint: DELETE /vendors/me/reviews/:id/reply
+//
+// c: The echo context.
+//
+// Returns a response indicating the reply is deleted.
+func (r *ReviewController) DeleteReviewReply(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Process the deletion of the reply
+	processErr := r.ReviewUseCase.ProcessDeleteReviewReply(cc.Token, uint(reviewID))
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Reply deleted successfully",
+		Data:    nil,
+	})
+}
+
+// ReportReview is a controller that handles the request to report a review,
+// the review is reported by the current user or the current vendor.
+// Endpoint: POST /reviews/:id/report
+// Endpoint: POST /vendors/me/reviews/:id/report
+//
+// c: The echo context.
+//
+// Returns a response indicating the review is reported.
+func (r *ReviewController) ReportReview(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new ReviewReportFormDTO object
+	form := new(dto.ReviewReportFormDTO)
+
+	// Bind the request body to the ReviewReportFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the review report form
+	if errs := r.ReviewUseCase.ValidateReviewReportForm(form); errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Process the report of the review
+	processErr := r.ReviewUseCase.ProcessReportReview(cc.Token, uint(reviewID), form)
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ResponseDTO{
+		Success: true,
+		Message: "Review reported successfully",
+		Data:    nil,
+	})
+}
+
+// MarkReviewHelpful is a controller that handles the request of the current user to mark a review as helpful.
+// Endpoint: POST /reviews/:id/helpful
+//
+// c: The echo context.
+//
+// Returns a response containing the review.
+func (r *ReviewController) MarkReviewHelpful(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Process the helpful mark of the review
+	review, processErr := r.ReviewUseCase.ProcessMarkReviewHelpful(cc.Token, uint(reviewID))
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Review marked as helpful successfully",
+		Data: dto.ReviewResponseDTO{
+			Review: dto.ReviewDTO{}.FromModel(review),
+		},
+	})
+}
+
+// UnmarkReviewHelpful is a controller that handles the request of the current user to remove the helpful mark of a review.
+// Endpoint: DELETE /reviews/:id/helpful
+//
+// c: The echo context.
+//
+// Returns a response containing the review.
+func (r *ReviewController) UnmarkReviewHelpful(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Process the removal of the helpful mark
+	review, processErr := r.ReviewUseCase.ProcessUnmarkReviewHelpful(cc.Token, uint(reviewID))
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Helpful mark removed successfully",
+		Data: dto.ReviewResponseDTO{
+			Review: dto.ReviewDTO{}.FromModel(review),
+		},
+	})
+}
+
+// GetModerationQueue is a controller that handles the request to get the reviews waiting
+// for moderation for the admin.
+// Endpoint: GET /admin/reviews/queue
+//
+// c: The echo context.
+//
+// Returns a response containing the reviews waiting for moderation.
+func (r *ReviewController) GetModerationQueue(c echo.Context) error {
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := r.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the reviews waiting for moderation
+	reviews, next, processErr := r.ReviewUseCase.GetModerationQueue(r.PaginationUseCase.GetPage(pagination))
+
+	// Check if there is an error
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Create the moderation queue response with the pagination
+	res := dto.AdminModerationQueueResponseDTO{}.FromModels(reviews)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Moderation queue retrieved successfully",
+		Data:    res,
+	})
+}
+
+// ApproveReview is a controller that handles the request to approve a review waiting for moderation,
+// the reports of the review are resolved.
+// Endpoint: POST /admin/reviews/:id/approve
+//
+// c: The echo context.
+//
+// Returns a response indicating the review is approved.
+func (r *ReviewController) ApproveReview(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Approve the review
+	processErr := r.ReviewUseCase.ModerateReview(uint(reviewID), enums.ReviewPublished)
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Review approved successfully",
+		Data:    nil,
+	})
+}
+
+// HideReview is a controller that handles the request to hide a review waiting for moderation,
+// the reports of the review are resolved.
+// Endpoint: POST /admin/reviews/:id/hide
+//
+// c: The echo context.
+//
+// Returns a response indicating the review is hidden.
+func (r *ReviewController) HideReview(c echo.Context) error {
+	// Get the review id from the URL
+	reviewID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the review id is invalid
+	if err != nil || reviewID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid review id",
+			Data:    nil,
+		})
+	}
+
+	// Hide the review
+	processErr := r.ReviewUseCase.ModerateReview(uint(reviewID), enums.ReviewHidden)
+
+	// Check if there is an error
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Review hidden successfully",
+		Data:    nil,
+	})
+}
@@ -0,0 +1,220 @@
+package controllers
+
+import (
+	"log"
+	"main/domain/usecases"
+	"main/internal/dto"
+	"main/pkg/utils"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ScheduleController is a struct that defines the ScheduleController
+type ScheduleController struct {
+	ScheduleUseCase *usecases.ScheduleUseCase
+}
+
+// NewScheduleController is a factory function that returns a new instance of the ScheduleController.
+//
+// s: The schedule use case.
+//
+// Returns a new instance of the ScheduleController.
+func NewScheduleController(s *usecases.ScheduleUseCase) *ScheduleController {
+	return &ScheduleController{
+		ScheduleUseCase: s,
+	}
+}
+
+// GetCurrentVendorSchedule is a controller that handles the get current vendor
+// schedule endpoint.
+// Endpoint: GET /vendors/me/schedule
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (s *ScheduleController) GetCurrentVendorSchedule(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Get the schedule
+	hours, specialDays, processErr := s.ScheduleUseCase.GetCurrentVendorSchedule(cc.Token)
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve schedule",
+		Data:    dto.VendorScheduleResponseDTO{}.FromModels(hours, specialDays),
+	})
+}
+
+// UpdateCurrentVendorOpeningHours is a controller that handles the update current
+// vendor opening hours endpoint.
+// Endpoint: PUT /vendors/me/schedule/opening-hours
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (s *ScheduleController) UpdateCurrentVendorOpeningHours(c echo.Context) error {
+	// Create a new OpeningHoursFormDTO object
+	form := new(dto.OpeningHoursFormDTO)
+
+	// Bind the request body to the OpeningHoursFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the opening hours form
+	errMsg := s.ScheduleUseCase.ValidateOpeningHoursForm(form)
+
+	// Return an error if any
+	if !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Update the opening hours
+	hours, specialDays, processErr := s.ScheduleUseCase.ProcessUpdateOpeningHours(cc.Token, form)
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success update opening hours",
+		Data:    dto.VendorScheduleResponseDTO{}.FromModels(hours, specialDays),
+	})
+}
+
+// SaveCurrentVendorSpecialDay is a controller that handles the save current vendor
+// special day endpoint.
+// Endpoint: PUT /vendors/me/schedule/special-days
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (s *ScheduleController) SaveCurrentVendorSpecialDay(c echo.Context) error {
+	// Create a new SpecialDayFormDTO object
+	form := new(dto.SpecialDayFormDTO)
+
+	// Bind the request body to the SpecialDayFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the special day form
+	if errs := s.ScheduleUseCase.ValidateSpecialDayForm(form); errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Save the special day
+	specialDay, processErr := s.ScheduleUseCase.ProcessSaveSpecialDay(cc.Token, form)
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success save special day",
+		Data: dto.SpecialDayResponseDTO{
+			SpecialDay: dto.SpecialDayDTO{}.FromModel(specialDay),
+		},
+	})
+}
+
+// DeleteCurrentVendorSpecialDay is a controller that handles the delete current vendor
+// special day endpoint.
+// Endpoint: DELETE /vendors/me/schedule/special-days/:date
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (s *ScheduleController) DeleteCurrentVendorSpecialDay(c echo.Context) error {
+	// Get the date from the URL
+	date := c.Param("date")
+
+	// Return an error if the date is invalid
+	if _, err := time.Parse("2006-01-02", date); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid date",
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Delete the special day
+	processErr := s.ScheduleUseCase.DeleteSpecialDay(cc.Token, date)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success delete special day",
+		Data:    nil,
+	})
+}
@@ -0,0 +1,308 @@
+package controllers
+
+import (
+	"log"
+	"main/domain/usecases"
+	"main/internal/dto"
+	"main/pkg/utils"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// StaffController is a struct that defines the StaffController
+type StaffController struct {
+	StaffUseCase      *usecases.StaffUseCase
+	PaginationUseCase *usecases.PaginationUseCase
+}
+
+// NewStaffController is a factory function that returns a new instance of the StaffController.
+//
+// s: The staff use case.
+// p: The pagination use case.
+//
+// Returns a new instance of the StaffController.
+func NewStaffController(s *usecases.StaffUseCase, p *usecases.PaginationUseCase) *StaffController {
+	return &StaffController{
+		StaffUseCase:      s,
+		PaginationUseCase: p,
+	}
+}
+
+// GetStaffMembers is a controller that handles the get current vendor staff endpoint.
+// Endpoint: GET /vendors/me/staff
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (s *StaffController) GetStaffMembers(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Get the vendor staff
+	staff, processErr := s.StaffUseCase.GetStaffMembers(cc.Token)
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve staff",
+		Data: dto.VendorStaffMembersResponseDTO{
+			Staff: dto.VendorStaffDTO{}.FromModels(staff),
+		},
+	})
+}
+
+// CreateStaff is a controller that handles the create current vendor staff endpoint.
+// Endpoint: POST /vendors/me/staff
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (s *StaffController) CreateStaff(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Create a new CreateVendorStaffFormDTO object
+	form := new(dto.CreateVendorStaffFormDTO)
+
+	// Bind the request body to the CreateVendorStaffFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Sanitize the create staff form
+	s.StaffUseCase.SanitizeCreateStaffForm(form)
+
+	// Validate the create staff form
+	if errs := s.StaffUseCase.ValidateCreateStaffForm(form); errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Create the staff
+	staff, processErr := s.StaffUseCase.ProcessCreateStaff(cc.Token, form)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusCreated, dto.ResponseDTO{
+		Success: true,
+		Message: "Success create staff",
+		Data: dto.VendorStaffResponseDTO{
+			Staff: dto.VendorStaffDTO{}.FromModel(staff),
+		},
+	})
+}
+
+// UpdateStaff is a controller that handles the update current vendor staff endpoint.
+// Endpoint: PATCH /vendors/me/staff/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (s *StaffController) UpdateStaff(c echo.Context) error {
+	// Get the staff id from the URL
+	staffID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the staff id is invalid
+	if err != nil || staffID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid staff id",
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Create a new UpdateVendorStaffFormDTO object
+	form := new(dto.UpdateVendorStaffFormDTO)
+
+	// Bind the request body to the UpdateVendorStaffFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the update staff form
+	if errs := s.StaffUseCase.ValidateUpdateStaffForm(form); errs != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errs,
+			Data:    nil,
+		})
+	}
+
+	// Update the staff
+	staff, processErr := s.StaffUseCase.ProcessUpdateStaff(cc.Token, uint(staffID), form)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success update staff",
+		Data: dto.VendorStaffResponseDTO{
+			Staff: dto.VendorStaffDTO{}.FromModel(staff),
+		},
+	})
+}
+
+// DeleteStaff is a controller that handles the delete current vendor staff endpoint.
+// Endpoint: DELETE /vendors/me/staff/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (s *StaffController) DeleteStaff(c echo.Context) error {
+	// Get the staff id from the URL
+	staffID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the staff id is invalid
+	if err != nil || staffID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid staff id",
+			Data:    nil,
+		})
+	}
+
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Delete the staff
+	processErr := s.StaffUseCase.ProcessDeleteStaff(cc.Token, uint(staffID))
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success delete staff",
+		Data:    nil,
+	})
+}
+
+// GetStaffActivities is a controller that handles the get current vendor staff activities endpoint,
+// the activities show which account made each change.
+// Endpoint: GET /vendors/me/staff/activities
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (s *StaffController) GetStaffActivities(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := s.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the staff activities
+	activities, next, processErr := s.StaffUseCase.GetActivities(cc.Token, s.PaginationUseCase.GetPage(pagination))
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Create the staff activities response with the pagination
+	res := dto.StaffActivitiesResponseDTO{}.FromModels(activities)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve staff activities",
+		Data:    res,
+	})
+}
@@ -6,26 +6,30 @@ import (
 	"main/internal/dto"
 	"main/pkg/utils"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 )
 
 // UserController is a struct that defines the user controller.
 type UserController struct {
-	UserUseCase *usecases.UserUseCase
-	AuthUseCase *usecases.AuthUseCase
+	UserUseCase       *usecases.UserUseCase
+	AuthUseCase       *usecases.AuthUseCase
+	PaginationUseCase *usecases.PaginationUseCase
 }
 
 // NewUserController is a factory function that returns a new instance of the UserController.
 //
 // u: The user use case.
 // a: The auth use case.
+// p: The pagination use case.
 //
 // Returns a new instance of the UserController.
-func NewUserController(u *usecases.UserUseCase, a *usecases.AuthUseCase) *UserController {
+func NewUserController(u *usecases.UserUseCase, a *usecases.AuthUseCase, p *usecases.PaginationUseCase) *UserController {
 	return &UserController{
-		UserUseCase: u,
-		AuthUseCase: a,
+		UserUseCase:       u,
+		AuthUseCase:       a,
+		PaginationUseCase: p,
 	}
 }
 
@@ -200,6 +204,9 @@ func (u *UserController) UpdateCurrentUserProfilePicture(c echo.Context) error {
 		})
 	}
 
+	// Get the uploaded image file of a multipart request if any
+	data.ImageFile, _ = c.FormFile("image")
+
 	// Get custom context
 	cc := c.(*dto.CustomContext)
 
@@ -216,7 +223,7 @@ func (u *UserController) UpdateCurrentUserProfilePicture(c echo.Context) error {
 	}
 
 	// Update the profile picture
-	processErr := u.UserUseCase.ProcessChangeProfilePicture(cc.Token, data)
+	user, processErr := u.UserUseCase.ProcessChangeProfilePicture(cc.Token, data)
 
 	// Return an error if any
 	if processErr != nil {
@@ -239,6 +246,203 @@ func (u *UserController) UpdateCurrentUserProfilePicture(c echo.Context) error {
 	return c.JSON(http.StatusOK, dto.ResponseDTO{
 		Success: true,
 		Message: "Profile picture updated successfully",
-		Data:    nil,
+		Data: dto.CurrentUserResponseDTO{
+			User: dto.CurrentUserDTO{}.FromModel(user),
+		},
+	})
+}
+
+// GetUsers is a handler function that returns the registered users for the admin.
+// Endpoint: GET /admin/users
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (u *UserController) GetUsers(c echo.Context) error {
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := u.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the users filtered by the search query parameter
+	users, next, processErr := u.UserUseCase.GetUsers(c.QueryParam("search"), u.PaginationUseCase.GetPage(pagination))
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Create the users response with the pagination
+	res := dto.AdminUsersResponseDTO{}.FromModels(users)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Users retrieved successfully",
+		Data:    res,
+	})
+}
+
+// GetUser is a handler function that returns a user for the admin.
+// Endpoint: GET /admin/users/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (u *UserController) GetUser(c echo.Context) error {
+	// Get the user id from the URL
+	userID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the user id is invalid
+	if err != nil || userID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid user id",
+			Data:    nil,
+		})
+	}
+
+	// Get the user
+	user, processErr := u.UserUseCase.GetUser(uint(userID))
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "User retrieved successfully",
+		Data: dto.AdminUserResponseDTO{
+			User: dto.AdminUserDTO{}.FromModel(user),
+		},
+	})
+}
+
+// DisableUser is a handler function that disables a user account.
+// Endpoint: POST /admin/users/:id/disable
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (u *UserController) DisableUser(c echo.Context) error {
+	// Get the user id from the URL
+	userID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the user id is invalid
+	if err != nil || userID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid user id",
+			Data:    nil,
+		})
+	}
+
+	// Disable the user
+	user, processErr := u.UserUseCase.DisableUser(uint(userID))
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "User disabled successfully",
+		Data: dto.AdminUserResponseDTO{
+			User: dto.AdminUserDTO{}.FromModel(user),
+		},
+	})
+}
+
+// EnableUser is a handler function that enables a disabled user account.
+// Endpoint: POST /admin/users/:id/enable
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (u *UserController) EnableUser(c echo.Context) error {
+	// Get the user id from the URL
+	userID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the user id is invalid
+	if err != nil || userID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid user id",
+			Data:    nil,
+		})
+	}
+
+	// Enable the user
+	user, processErr := u.UserUseCase.EnableUser(uint(userID))
+
+	// Return an error if any
+	if processErr != nil {
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "User enabled successfully",
+		Data: dto.AdminUserResponseDTO{
+			User: dto.AdminUserDTO{}.FromModel(user),
+		},
 	})
 }
@@ -0,0 +1,459 @@
+package controllers
+
+import (
+	"fmt"
+	"log"
+	"main/domain/usecases"
+	"main/internal/dto"
+	"main/pkg/utils"
+	"net/http"
+	"path"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+// VendorApprovalController is a struct that defines the VendorApprovalController
+type VendorApprovalController struct {
+	VendorApprovalUseCase *usecases.VendorApprovalUseCase
+	PaginationUseCase     *usecases.PaginationUseCase
+}
+
+// NewVendorApprovalController is a factory function that returns a new instance of the VendorApprovalController.
+//
+// v: The vendor approval use case.
+// p: The pagination use case.
+//
+// Returns a new instance of the VendorApprovalController.
+func NewVendorApprovalController(v *usecases.VendorApprovalUseCase, p *usecases.PaginationUseCase) *VendorApprovalController {
+	return &VendorApprovalController{
+		VendorApprovalUseCase: v,
+		PaginationUseCase:     p,
+	}
+}
+
+// GetVendors is a controller that handles the admin get vendor registrations endpoint,
+// the vendors waiting for approval are listed by default.
+// Endpoint: GET /admin/vendors
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorApprovalController) GetVendors(c echo.Context) error {
+	// Get the status from the query parameter
+	status := c.QueryParam("status")
+
+	// List the vendors waiting for approval by default
+	if utils.IsBlank(status) {
+		status = "pending"
+	}
+
+	// Get the vendor status label
+	status, ok := v.VendorApprovalUseCase.GetVendorStatus(status)
+
+	// Return an error if the status is invalid
+	if !ok {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid vendor status",
+			Data:    nil,
+		})
+	}
+
+	// Create a new PaginationQueryDTO object
+	pagination := new(dto.PaginationQueryDTO)
+
+	// Bind the query parameters to the PaginationQueryDTO object
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, pagination); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid pagination query parameters",
+			Data:    nil,
+		})
+	}
+
+	// Validate the pagination query parameters
+	if errMsg := v.PaginationUseCase.ValidatePaginationQuery(pagination); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Get the vendors
+	vendors, next, processErr := v.VendorApprovalUseCase.GetVendorApplications(status, v.PaginationUseCase.GetPage(pagination))
+
+	// Return an error if any
+	if processErr != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Create the vendors response with the pagination
+	res := dto.VendorApplicationsResponseDTO{}.FromModels(vendors)
+	res.Pagination = dto.PaginationDTO{}.FromCursor(next)
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve vendors",
+		Data:    res,
+	})
+}
+
+// GetVendor is a controller that handles the admin get vendor registration endpoint.
+// Endpoint: GET /admin/vendors/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorApprovalController) GetVendor(c echo.Context) error {
+	// Get the vendor id from the URL
+	vendorID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the vendor id is invalid
+	if err != nil || vendorID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid vendor id",
+			Data:    nil,
+		})
+	}
+
+	// Get the vendor
+	vendor, processErr := v.VendorApprovalUseCase.GetVendorApplication(uint(vendorID))
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success retrieve vendor",
+		Data: dto.VendorApplicationResponseDTO{
+			Vendor: dto.VendorApplicationDTO{}.FromModel(vendor),
+		},
+	})
+}
+
+// GetVendorDocument is a controller that handles the admin download vendor registration
+// document endpoint, the documents are private and only served through this endpoint.
+// Endpoint: GET /admin/vendors/:id/documents/:documentId
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorApprovalController) GetVendorDocument(c echo.Context) error {
+	// Get the vendor id from the URL
+	vendorID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the vendor id is invalid
+	if err != nil || vendorID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid vendor id",
+			Data:    nil,
+		})
+	}
+
+	// Get the document id from the URL
+	documentID, err := strconv.Atoi(c.Param("documentId"))
+
+	// Return an error if the document id is invalid
+	if err != nil || documentID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid document id",
+			Data:    nil,
+		})
+	}
+
+	// Get the document
+	document, data, processErr := v.VendorApprovalUseCase.GetVendorDocument(uint(vendorID), uint(documentID))
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	// Name the document file after its type
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("inline; filename=%q", document.Type+path.Ext(document.File)))
+
+	// Never cache the private document
+	c.Response().Header().Set("Cache-Control", "no-store")
+
+	return c.Blob(http.StatusOK, document.ContentType, data)
+}
+
+// ApproveVendor is a controller that handles the admin approve vendor registration endpoint.
+// Endpoint: POST /admin/vendors/:id/approve
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorApprovalController) ApproveVendor(c echo.Context) error {
+	// Get the vendor id from the URL
+	vendorID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the vendor id is invalid
+	if err != nil || vendorID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid vendor id",
+			Data:    nil,
+		})
+	}
+
+	// Approve the vendor
+	vendor, processErr := v.VendorApprovalUseCase.ApproveVendor(uint(vendorID))
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success approve vendor",
+		Data: dto.VendorApplicationResponseDTO{
+			Vendor: dto.VendorApplicationDTO{}.FromModel(vendor),
+		},
+	})
+}
+
+// RejectVendor is a controller that handles the admin reject vendor registration endpoint.
+// Endpoint: POST /admin/vendors/:id/reject
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorApprovalController) RejectVendor(c echo.Context) error {
+	// Get the vendor id from the URL
+	vendorID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the vendor id is invalid
+	if err != nil || vendorID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid vendor id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new RejectVendorFormDTO object
+	form := new(dto.RejectVendorFormDTO)
+
+	// Bind the request body to the RejectVendorFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the reject vendor form
+	if errMsg := v.VendorApprovalUseCase.ValidateRejectVendorForm(form); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Reject the vendor
+	vendor, processErr := v.VendorApprovalUseCase.RejectVendor(uint(vendorID), form)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success reject vendor",
+		Data: dto.VendorApplicationResponseDTO{
+			Vendor: dto.VendorApplicationDTO{}.FromModel(vendor),
+		},
+	})
+}
+
+// SuspendVendor is a controller that handles the admin suspend vendor endpoint,
+// the suspended vendor and its staff are signed out of the vendor endpoints.
+// Endpoint: POST /admin/vendors/:id/suspend
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorApprovalController) SuspendVendor(c echo.Context) error {
+	// Get the vendor id from the URL
+	vendorID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the vendor id is invalid
+	if err != nil || vendorID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid vendor id",
+			Data:    nil,
+		})
+	}
+
+	// Create a new SuspendVendorFormDTO object
+	form := new(dto.SuspendVendorFormDTO)
+
+	// Bind the request body to the SuspendVendorFormDTO object
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding request body: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid request body",
+			Data:    nil,
+		})
+	}
+
+	// Validate the suspend vendor form
+	if errMsg := v.VendorApprovalUseCase.ValidateSuspendVendorForm(form); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Suspend the vendor
+	vendor, processErr := v.VendorApprovalUseCase.SuspendVendor(uint(vendorID), form)
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success suspend vendor",
+		Data: dto.VendorApplicationResponseDTO{
+			Vendor: dto.VendorApplicationDTO{}.FromModel(vendor),
+		},
+	})
+}
+
+// ReactivateVendor is a controller that handles the admin reactivate suspended vendor endpoint.
+// Endpoint: POST /admin/vendors/:id/reactivate
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorApprovalController) ReactivateVendor(c echo.Context) error {
+	// Get the vendor id from the URL
+	vendorID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the vendor id is invalid
+	if err != nil || vendorID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid vendor id",
+			Data:    nil,
+		})
+	}
+
+	// Reactivate the vendor
+	vendor, processErr := v.VendorApprovalUseCase.ReactivateVendor(uint(vendorID))
+
+	// Return an error if any
+	if processErr != nil {
+		// Return an error if the client error is true
+		if processErr.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Success reactivate vendor",
+		Data: dto.VendorApplicationResponseDTO{
+			Vendor: dto.VendorApplicationDTO{}.FromModel(vendor),
+		},
+	})
+}
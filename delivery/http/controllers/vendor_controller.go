@@ -4,7 +4,9 @@ import (
 	"log"
 	"main/domain/usecases"
 	"main/internal/dto"
+	"main/pkg/utils"
 	"net/http"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 )
@@ -57,9 +59,241 @@ func (v *VendorController) GetCurrentVendor(c echo.Context) error {
 		})
 	}
 
+	// Get the signed in staff if any
+	var staff *dto.VendorStaffDTO
+
+	if cc.Staff != nil {
+		staff = dto.VendorStaffDTO{}.FromModel(cc.Staff)
+	}
+
 	return c.JSON(http.StatusOK, dto.ResponseDTO{
 		Success: true,
 		Message: "Vendor retrieved successfully",
+		Data: dto.CurrentVendorResponseDTO{
+			Vendor: dto.CurrentVendorDTO{}.FromModel(vendor),
+			Staff:  staff,
+		},
+	})
+}
+
+// GetVendor is a handler function that returns the public profile of a vendor.
+// Endpoint: GET /vendors/:id
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorController) GetVendor(c echo.Context) error {
+	// Get the vendor id from the URL
+	vendorID, err := strconv.Atoi(c.Param("id"))
+
+	// Return an error if the vendor id is invalid
+	if err != nil || vendorID <= 0 {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid vendor id",
+			Data:    nil,
+		})
+	}
+
+	// Get the vendor profile
+	profile, processErr := v.VendorUseCase.GetVendorProfile(uint(vendorID))
+
+	// Return an error if any
+	if processErr != nil {
+		// Check if the error is a client error
+		if processErr.ClientError {
+			return c.JSON(http.StatusNotFound, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: processErr.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Vendor retrieved successfully",
+		Data:    dto.VendorProfileResponseDTO{}.FromMap(profile),
+	})
+}
+
+// UpdateCurrentVendorProfile is a controller that handles the update current vendor profile endpoint.
+// Endpoint: PATCH /vendors/me
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorController) UpdateCurrentVendorProfile(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Bind the form dto
+	form := new(dto.UpdateVendorProfileFormDTO)
+
+	// Return an error if the form data is invalid
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding form data: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid form data",
+			Data:    nil,
+		})
+	}
+
+	// Sanitize the form data
+	v.VendorUseCase.SanitizeUpdateProfileForm(form)
+
+	// Validate the form data
+	if err := v.VendorUseCase.ValidateUpdateProfileForm(form); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: err,
+			Data:    nil,
+		})
+	}
+
+	// Update the profile
+	vendor, err := v.VendorUseCase.ProcessUpdateProfile(cc.Token, form)
+
+	// Return an error if any
+	if err != nil {
+		// Return an error if the client error is true
+		if err.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: err.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: err.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Profile updated successfully",
+		Data: dto.CurrentVendorResponseDTO{
+			Vendor: dto.CurrentVendorDTO{}.FromModel(vendor),
+		},
+	})
+}
+
+// VerifyVendorEmail is a controller that handles the verify vendor email endpoint,
+// the token is the one mailed to the new email of the vendor.
+// Endpoint: POST /auth/vendor/verify-email
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorController) VerifyVendorEmail(c echo.Context) error {
+	// Bind the form dto
+	form := new(dto.VerifyEmailFormDTO)
+
+	// Return an error if the form data is invalid
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding form data: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid form data",
+			Data:    nil,
+		})
+	}
+
+	// Validate the form data
+	if errMsg := v.VendorUseCase.ValidateVerifyEmailForm(form); !utils.IsBlank(errMsg) {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: errMsg,
+			Data:    nil,
+		})
+	}
+
+	// Verify the email
+	if err := v.VendorUseCase.ProcessVerifyEmail(form); err != nil {
+		// Return an error if the client error is true
+		if err.ClientError {
+			return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+				Success: false,
+				Message: err.Message,
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: err.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Email verified successfully",
+		Data:    nil,
+	})
+}
+
+// UpdateCurrentVendorLocation is a handler function that updates the location of
+// the current vendor.
+// Endpoint: PATCH /vendors/me/location
+//
+// c: The echo context.
+//
+// Returns an error if any.
+func (v *VendorController) UpdateCurrentVendorLocation(c echo.Context) error {
+	// Get custom context
+	cc := c.(*dto.CustomContext)
+
+	// Bind the form dto
+	form := new(dto.UpdateVendorLocationFormDTO)
+
+	// Return an error if the form data is invalid
+	if err := c.Bind(form); err != nil {
+		log.Println("Error binding form data: ", err)
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid form data",
+			Data:    nil,
+		})
+	}
+
+	// Validate the form data
+	if err := v.VendorUseCase.ValidateUpdateLocationForm(form); err != nil {
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: err,
+			Data:    nil,
+		})
+	}
+
+	// Update the location
+	vendor, err := v.VendorUseCase.ProcessUpdateLocation(cc.Token, form)
+
+	// Return an error if any
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+			Success: false,
+			Message: err.Message,
+			Data:    nil,
+		})
+	}
+
+	return c.JSON(http.StatusOK, dto.ResponseDTO{
+		Success: true,
+		Message: "Location updated successfully",
 		Data: dto.CurrentVendorResponseDTO{
 			Vendor: dto.CurrentVendorDTO{}.FromModel(vendor),
 		},
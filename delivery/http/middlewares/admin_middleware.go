@@ -0,0 +1,165 @@
+package middlewares
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"main/core/enums"
+	"main/domain/usecases"
+	"main/internal/dto"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// auditBodyReader is a request body that keeps a copy of the body read by the handler,
+// so the request body can be recorded in the audit trail.
+type auditBodyReader struct {
+	io.Reader
+	io.Closer
+}
+
+// AdminMiddleware is a middleware that checks if the token is admins, not other client type
+// and records the changes made by the admins.
+type AdminMiddleware struct {
+	authUseCase  *usecases.AuthUseCase
+	adminUseCase *usecases.AdminUseCase
+}
+
+// NewAdminMiddleware is a factory function that returns a new instance of the AdminMiddleware
+//
+// a: The auth use case
+// ad: The admin use case
+//
+// Returns a new instance of the AdminMiddleware
+func NewAdminMiddleware(a *usecases.AuthUseCase, ad *usecases.AdminUseCase) *AdminMiddleware {
+	return &AdminMiddleware{authUseCase: a, adminUseCase: ad}
+}
+
+// Shield is a middleware that loads the signed in admin and records the
+// successful changes made with the admin token in the audit trail
+//
+// next: The next handler function
+//
+// Returns an error if any
+func (a *AdminMiddleware) Shield(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		// Get custom context
+		cc := c.(*dto.CustomContext)
+
+		// Check if token is admins, not other client type
+		if a.authUseCase.DecodeToken(cc.Token).ClientType != enums.Admin {
+			return c.JSON(http.StatusUnauthorized, dto.ResponseDTO{
+				Success: false,
+				Message: "Invalid client type for this endpoint",
+				Data:    nil,
+			})
+		}
+
+		// Get the signed in admin, the admin is loaded on every request
+		// so deleted admins are rejected right away
+		admin, processErr := a.adminUseCase.GetCurrentAdmin(cc.Token)
+
+		// Return an error if any
+		if processErr != nil {
+			// Return an error if the client error is true
+			if processErr.ClientError {
+				return c.JSON(http.StatusUnauthorized, dto.ResponseDTO{
+					Success: false,
+					Message: processErr.Message,
+					Data:    nil,
+				})
+			}
+
+			return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		cc.Admin = admin
+
+		// Keep a copy of the JSON request body read by the handler
+		body := new(bytes.Buffer)
+
+		if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+			c.Request().Body = auditBodyReader{io.TeeReader(c.Request().Body, body), c.Request().Body}
+		}
+
+		// Call the next handler
+		err := next(cc)
+
+		// Record the successful changes
+		if method := c.Request().Method; method != http.MethodGet && method != http.MethodHead && c.Response().Status < http.StatusBadRequest {
+			// Get the changed record
+			targetType, targetID := a.auditTarget(cc)
+
+			a.adminUseCase.RecordAuditLog(admin, method, c.Request().URL.Path, c.Response().Status, targetType, targetID, a.auditBody(cc, body.Bytes()))
+		}
+
+		return err
+	}
+}
+
+// auditTarget is a helper function that returns the record changed by the request,
+// the type is taken from the admin route, like "vendors" for /admin/vendors/:id/approve,
+// and the id from the path or from the record created by the handler
+//
+// cc: The custom context
+//
+// Returns the target type and the target id, empty and nil when the change has no target
+func (a *AdminMiddleware) auditTarget(cc *dto.CustomContext) (string, *uint) {
+	// Get the route under the admin endpoints
+	_, route, found := strings.Cut(cc.Path(), "/api/v1/admin/")
+
+	// Return no target outside the admin endpoints, like the admin logout
+	if !found {
+		return "", nil
+	}
+
+	// Get the target type from the first segment of the route
+	targetType, _, _ := strings.Cut(route, "/")
+
+	// Get the target id from the path
+	if id, err := strconv.ParseUint(cc.Param("id"), 10, 0); err == nil {
+		targetID := uint(id)
+
+		return targetType, &targetID
+	}
+
+	return targetType, cc.AuditTargetID
+}
+
+// auditBody is a helper function that returns the request body to record in
+// the audit trail, the form values are recorded for the multipart forms
+//
+// cc: The custom context
+// body: The JSON request body read by the handler
+//
+// Returns the request body as JSON
+func (a *AdminMiddleware) auditBody(cc *dto.CustomContext, body []byte) []byte {
+	// Return the JSON request body if any
+	if len(body) > 0 {
+		return body
+	}
+
+	// Return nothing if the request is not a multipart form
+	form := cc.Request().MultipartForm
+
+	if form == nil {
+		return nil
+	}
+
+	// Encode the form values, the uploaded files are left out
+	encoded, err := json.Marshal(form.Value)
+
+	// Return nothing if any error
+	if err != nil {
+		return nil
+	}
+
+	return encoded
+}
@@ -0,0 +1,100 @@
+package middlewares
+
+import (
+	"main/core/enums"
+	"main/domain/usecases"
+	"main/internal/dto"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// StaffMiddleware is a middleware that checks the vendor staff permissions
+// and records the changes made by the vendor staff.
+type StaffMiddleware struct {
+	staffUseCase *usecases.StaffUseCase
+}
+
+// NewStaffMiddleware is a factory function that returns a new instance of the StaffMiddleware
+//
+// s: The staff use case
+//
+// Returns a new instance of the StaffMiddleware
+func NewStaffMiddleware(s *usecases.StaffUseCase) *StaffMiddleware {
+	return &StaffMiddleware{staffUseCase: s}
+}
+
+// Shield is a middleware that loads the signed in staff and records the
+// successful changes made with the vendor token, so every change is
+// attributed to the account that made it
+//
+// next: The next handler function
+//
+// Returns an error if any
+func (s *StaffMiddleware) Shield(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		// Get custom context
+		cc := c.(*dto.CustomContext)
+
+		// Get the signed in staff, the staff is loaded on every request
+		// so role changes and deleted staff take effect right away
+		staff, processErr := s.staffUseCase.GetCurrentStaff(cc.Token)
+
+		// Return an error if any
+		if processErr != nil {
+			// Return an error if the client error is true
+			if processErr.ClientError {
+				return c.JSON(http.StatusUnauthorized, dto.ResponseDTO{
+					Success: false,
+					Message: processErr.Message,
+					Data:    nil,
+				})
+			}
+
+			return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
+		cc.Staff = staff
+
+		// Call the next handler
+		err := next(cc)
+
+		// Record the successful changes
+		if method := c.Request().Method; method != http.MethodGet && method != http.MethodHead && c.Response().Status < http.StatusBadRequest {
+			s.staffUseCase.RecordActivity(cc.Token, staff, method, c.Request().URL.Path, c.Response().Status)
+		}
+
+		return err
+	}
+}
+
+// Require is a function that returns a middleware that checks if the signed in
+// staff role is granted the permission, the vendor account has every permission
+//
+// permission: The required staff permission
+//
+// Returns the middleware function
+func (s *StaffMiddleware) Require(permission enums.StaffPermission) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			// Get custom context
+			cc := c.(*dto.CustomContext)
+
+			// Check if the staff role is granted the permission
+			if !s.staffUseCase.GetStaffRole(cc.Staff).HasPermission(permission) {
+				return c.JSON(http.StatusForbidden, dto.ResponseDTO{
+					Success: false,
+					Message: "You don't have permission to access this endpoint",
+					Data:    nil,
+				})
+			}
+
+			// Call the next handler
+			return next(cc)
+		}
+	}
+}
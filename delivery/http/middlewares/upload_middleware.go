@@ -0,0 +1,125 @@
+package middlewares
+
+import (
+	"errors"
+	"main/core/config"
+	"main/core/constants"
+	"main/internal/dto"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// uploadBodyOverhead is the size allowed for the other fields of an image upload request body.
+const uploadBodyOverhead = 1 << 20
+
+// multipartMemory is the maximum size of the multipart form kept in memory,
+// larger files are streamed to temporary files.
+const multipartMemory = 1 << 20
+
+// UploadMiddleware is a middleware that limits the request body of the image upload endpoints
+type UploadMiddleware struct{}
+
+// NewUploadMiddleware is a factory function that returns a new instance of the UploadMiddleware
+//
+// Returns a new instance of the UploadMiddleware
+func NewUploadMiddleware() *UploadMiddleware {
+	return &UploadMiddleware{}
+}
+
+// Limit is a middleware that limits the request body size of a single image upload
+// and parses multipart forms
+//
+// next: The next handler function
+//
+// Returns an error if any
+func (u *UploadMiddleware) Limit(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return u.limit(c, next, 1)
+	}
+}
+
+// DocumentsLimit is a middleware that limits the request body size of the vendor
+// registration documents upload and parses multipart forms
+//
+// next: The next handler function
+//
+// Returns an error if any
+func (u *UploadMiddleware) DocumentsLimit(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return u.limit(c, next, int64(len(constants.VENDOR_DOCUMENT_TYPES)))
+	}
+}
+
+// ReviewImagesLimit is a middleware that limits the request body size of the review
+// photos upload and parses multipart forms
+//
+// next: The next handler function
+//
+// Returns an error if any
+func (u *UploadMiddleware) ReviewImagesLimit(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		return u.limit(c, next, int64(constants.MAX_REVIEW_IMAGES))
+	}
+}
+
+// limit is a helper function that limits the request body size to the files
+// and parses multipart forms, the total size of the files is capped so a
+// request with several files can't multiply the memory used
+//
+// c: The echo context
+// next: The next handler function
+// files: The maximum number of uploaded files
+//
+// Returns an error if any
+func (u *UploadMiddleware) limit(c echo.Context, next echo.HandlerFunc, files int64) error {
+	// Get the total size of the files, never more than the maximum request size
+	filesSize := min(files*config.UploadConfig.MaxSize, config.UploadConfig.MaxRequestSize)
+
+	// Get the maximum body size, base64 encoded files are a third larger than the file
+	maxBodySize := filesSize*4/3 + uploadBodyOverhead
+
+	// Reject the request if the body is known to be too large
+	if c.Request().ContentLength > maxBodySize {
+		return c.JSON(http.StatusRequestEntityTooLarge, dto.ResponseDTO{
+			Success: false,
+			Message: "Request body is too large",
+			Data:    nil,
+		})
+	}
+
+	// Limit the request body
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, maxBodySize)
+
+	// Return if the request is not a multipart form
+	if !strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEMultipartForm) {
+		return next(c)
+	}
+
+	// Parse the multipart form
+	err := c.Request().ParseMultipartForm(multipartMemory)
+
+	// Return an error if any
+	if err != nil {
+		// Check if the request body is too large
+		var maxBytesErr *http.MaxBytesError
+
+		if errors.As(err, &maxBytesErr) {
+			return c.JSON(http.StatusRequestEntityTooLarge, dto.ResponseDTO{
+				Success: false,
+				Message: "Request body is too large",
+				Data:    nil,
+			})
+		}
+
+		return c.JSON(http.StatusBadRequest, dto.ResponseDTO{
+			Success: false,
+			Message: "Invalid multipart form",
+			Data:    nil,
+		})
+	}
+
+	// Call the next handler
+	return next(c)
+}
@@ -12,15 +12,17 @@ import (
 // UserMiddleware is a middleware that checks if the token is users, not other client type
 type UserMiddleware struct {
 	authUseCase *usecases.AuthUseCase
+	userUseCase *usecases.UserUseCase
 }
 
 // NewUserMiddleware is a factory function that returns a new instance of the UserMiddleware
 //
 // a: The auth use case
+// u: The user use case
 //
 // Returns a new instance of the UserMiddleware
-func NewUserMiddleware(a *usecases.AuthUseCase) *UserMiddleware {
-	return &UserMiddleware{authUseCase: a}
+func NewUserMiddleware(a *usecases.AuthUseCase, u *usecases.UserUseCase) *UserMiddleware {
+	return &UserMiddleware{authUseCase: a, userUseCase: u}
 }
 
 // Shield is a middleware that checks if the user is authenticated
@@ -42,6 +44,25 @@ func (u *UserMiddleware) Shield(next echo.HandlerFunc) echo.HandlerFunc {
 			})
 		}
 
+		// Check if the user can still use the token, the user may have
+		// been disabled by an admin after the token was issued
+		if processErr := u.userUseCase.CheckCurrentUserActive(cc.Token); processErr != nil {
+			// Return an error if the client error is true
+			if processErr.ClientError {
+				return c.JSON(http.StatusForbidden, dto.ResponseDTO{
+					Success: false,
+					Message: processErr.Message,
+					Data:    nil,
+				})
+			}
+
+			return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
 		// Call the next handler
 		return next(cc)
 	}
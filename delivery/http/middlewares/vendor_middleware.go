@@ -11,16 +11,18 @@ import (
 
 // VendorMiddleware is a middleware that checks if the token is users, not other client type
 type VendorMiddleware struct {
-	authUseCase *usecases.AuthUseCase
+	authUseCase   *usecases.AuthUseCase
+	vendorUseCase *usecases.VendorUseCase
 }
 
 // NewVendorMiddleware is a factory function that returns a new instance of the VendorMiddleware
 //
 // a: The auth use case
+// v: The vendor use case
 //
 // Returns a new instance of the VendorMiddleware
-func NewVendorMiddleware(a *usecases.AuthUseCase) *VendorMiddleware {
-	return &VendorMiddleware{authUseCase: a}
+func NewVendorMiddleware(a *usecases.AuthUseCase, v *usecases.VendorUseCase) *VendorMiddleware {
+	return &VendorMiddleware{authUseCase: a, vendorUseCase: v}
 }
 
 // Shield is a middleware that checks if the user is authenticated
@@ -42,6 +44,25 @@ func (v *VendorMiddleware) Shield(next echo.HandlerFunc) echo.HandlerFunc {
 			})
 		}
 
+		// Check if the vendor can still use the token, the vendor may have
+		// been suspended by an admin after the token was issued
+		if processErr := v.vendorUseCase.CheckCurrentVendorActive(cc.Token); processErr != nil {
+			// Return an error if the client error is true
+			if processErr.ClientError {
+				return c.JSON(http.StatusForbidden, dto.ResponseDTO{
+					Success: false,
+					Message: processErr.Message,
+					Data:    nil,
+				})
+			}
+
+			return c.JSON(http.StatusInternalServerError, dto.ResponseDTO{
+				Success: false,
+				Message: processErr.Message,
+				Data:    nil,
+			})
+		}
+
 		// Call the next handler
 		return next(cc)
 	}
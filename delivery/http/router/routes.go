@@ -1,12 +1,6 @@
 package router
 
 var (
-	// UserProfiles is the path to the user profiles.
-	UserProfiles = "static/user_profiles"
-
-	// CourtImages is the path to the court images.
-	CourtImages = "static/court_images"
-
-	// Fees is the path to the service fees.
-	Advertisements = "static/ads"
+	// Static is the path the local storage assets are served from.
+	Static = "static"
 )
@@ -14,6 +14,14 @@ type JWTClaims struct {
 	// ClientType is the client type of the user.
 	ClientType enums.ClientType `json:"client_type"`
 
+	// StaffID is the id of the vendor staff, it's only set when
+	// the vendor is signed in with a staff account.
+	StaffID uint `json:"staff_id,omitempty"`
+
+	// FamilyID is the id of the refresh token family the token is issued with,
+	// the family is revoked when the client logs out.
+	FamilyID string `json:"family_id,omitempty"`
+
 	// RegisteredClaims is the registered claims of the JWT.
 	jwt.RegisteredClaims
 }
@@ -7,4 +7,7 @@ type ProcessError struct {
 
 	// IsClientError is a flag that indicates if the error is a client error.
 	ClientError bool
+
+	// Conflict is a flag that indicates if the client error conflicts with the existing data.
+	Conflict bool
 }
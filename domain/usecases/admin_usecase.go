@@ -0,0 +1,197 @@
+package usecases
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"main/core/constants"
+	"main/core/types"
+	"main/data/models"
+	"main/domain/entities"
+	"main/internal/repository"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+// AdminUseCase is a struct that defines the use case for the admin accounts.
+type AdminUseCase struct {
+	AuthUseCase             *AuthUseCase
+	AdminRepository         *repository.AdminRepository
+	AdminAuditLogRepository *repository.AdminAuditLogRepository
+}
+
+// NewAdminUseCase is a factory function that returns a new instance of the AdminUseCase.
+//
+// a: The auth use case.
+// ad: The admin repository.
+// al: The admin audit log repository.
+//
+// Returns a new instance of the AdminUseCase.
+func NewAdminUseCase(a *AuthUseCase, ad *repository.AdminRepository, al *repository.AdminAuditLogRepository) *AdminUseCase {
+	return &AdminUseCase{
+		AuthUseCase:             a,
+		AdminRepository:         ad,
+		AdminAuditLogRepository: al,
+	}
+}
+
+// GetCurrentAdmin is a function that returns the admin signed in with the token.
+//
+// token: The admin token.
+//
+// Returns the admin and an error if any.
+func (a *AdminUseCase) GetCurrentAdmin(token *jwt.Token) (*models.Admin, *entities.ProcessError) {
+	// Get the token claims
+	claims := a.AuthUseCase.DecodeToken(token)
+
+	// Get the admin by ID
+	admin, err := a.AdminRepository.GetUsingID(claims.Id)
+
+	// Return an error if the admin is deleted
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Admin account not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the admin",
+		}
+	}
+
+	return admin, nil
+}
+
+// RecordAuditLog is a function that records a change made by the admin.
+//
+// admin: The admin who made the change.
+// method: The HTTP method of the request.
+// path: The path of the request.
+// status: The HTTP status code of the response.
+// targetType: The type of the changed record, empty when the change has no target.
+// targetID: The id of the changed record, nil when the change has no target.
+// body: The JSON request body, nil when there is no body.
+//
+// Returns an error if any.
+func (a *AdminUseCase) RecordAuditLog(admin *models.Admin, method string, path string, status int, targetType string, targetID *uint, body []byte) *entities.ProcessError {
+	// Create a new admin audit log
+	auditLog := models.AdminAuditLog{
+		AdminID:       admin.ID,
+		AdminUsername: admin.Username,
+		Method:        method,
+		Path:          path,
+		Status:        status,
+		TargetType:    targetType,
+		TargetID:      targetID,
+		RequestBody:   auditRequestBody(body),
+	}
+
+	// Save the admin audit log
+	if err := a.AdminAuditLogRepository.Create(&auditLog); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while recording the audit log",
+		}
+	}
+
+	return nil
+}
+
+// auditRequestBody is a helper function that prepares the JSON request body
+// to be recorded in the audit trail, the long values and the passwords are omitted.
+//
+// body: The JSON request body.
+//
+// Returns the recorded request body, nil when there is no body or it's not JSON.
+func auditRequestBody(body []byte) *string {
+	// Return nil if there is no body
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	// Decode the request body
+	var value any
+
+	if err := json.Unmarshal(body, &value); err != nil {
+		log.Println("Failed to decode the audit log request body: ", err)
+
+		return nil
+	}
+
+	// Encode the request body without the omitted values
+	encoded, err := json.Marshal(omitAuditValues(value))
+
+	// Return nil if any error
+	if err != nil {
+		log.Println("Failed to encode the audit log request body: ", err)
+
+		return nil
+	}
+
+	recorded := string(encoded)
+
+	return &recorded
+}
+
+// omitAuditValues is a helper function that replaces the values that shouldn't be
+// recorded in the audit trail, like the encoded images and the passwords.
+//
+// value: The decoded JSON value.
+//
+// Returns the value to record.
+func omitAuditValues(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		// Loop through the object fields
+		for key, field := range v {
+			// Omit the passwords
+			if strings.Contains(strings.ToLower(key), "password") {
+				v[key] = "<omitted>"
+
+				continue
+			}
+
+			v[key] = omitAuditValues(field)
+		}
+	case []any:
+		// Loop through the array items
+		for i, item := range v {
+			v[i] = omitAuditValues(item)
+		}
+	case string:
+		// Omit the long values, like the encoded images
+		if len(v) > constants.MAX_AUDIT_LOG_VALUE_LENGTH {
+			return fmt.Sprintf("<%d characters omitted>", len(v))
+		}
+	}
+
+	return value
+}
+
+// GetAuditLogs is a function that returns a page of the admin audit logs.
+//
+// adminID: The admin ID to filter the audit logs, nil for every admin.
+// page: The page of the admin audit logs.
+//
+// Returns the admin audit logs, the next cursor or nil on the last page, and an error if any.
+func (a *AdminUseCase) GetAuditLogs(adminID *uint, page *types.Page) (*[]models.AdminAuditLog, *types.Cursor, *entities.ProcessError) {
+	// Get the admin audit logs
+	auditLogs, next, err := a.AdminAuditLogRepository.Get(adminID, page)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the audit logs",
+		}
+	}
+
+	return auditLogs, next, nil
+}
@@ -0,0 +1,384 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"main/core/constants"
+	"main/core/shared"
+	"main/data/models"
+	"main/domain/entities"
+	"main/internal/dto"
+	"main/internal/repository"
+	"main/pkg/utils"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+// advertisementStatKey is a struct that identifies the buffered stat of an advertisement on a date.
+type advertisementStatKey struct {
+	// adID is the advertisement ID.
+	adID uint
+
+	// date is the date of the stat, formatted as YYYY-MM-DD.
+	date string
+}
+
+// AdvertisementStatsUseCase is a struct that defines the advertisement stats use case.
+// The impressions and clicks are buffered in memory and written at an interval,
+// so the tracking doesn't slow down the advertisements endpoints.
+type AdvertisementStatsUseCase struct {
+	AuthUseCase                 *AuthUseCase
+	AdvertisementStatRepository *repository.AdvertisementStatRepository
+	AdvertisementRepository     *repository.AdvertisementRepository
+
+	// mu guards the buffered stats.
+	mu sync.Mutex
+
+	// buffer is the impressions and clicks that aren't written yet.
+	buffer map[advertisementStatKey]*models.AdvertisementStat
+}
+
+// NewAdvertisementStatsUseCase is a factory function that returns a new instance of the AdvertisementStatsUseCase.
+//
+// a: The auth use case.
+// s: The advertisement stat repository.
+// r: The advertisement repository.
+//
+// Returns a new instance of the AdvertisementStatsUseCase.
+func NewAdvertisementStatsUseCase(a *AuthUseCase, s *repository.AdvertisementStatRepository, r *repository.AdvertisementRepository) *AdvertisementStatsUseCase {
+	return &AdvertisementStatsUseCase{
+		AuthUseCase:                 a,
+		AdvertisementStatRepository: s,
+		AdvertisementRepository:     r,
+		buffer:                      make(map[advertisementStatKey]*models.AdvertisementStat),
+	}
+}
+
+// RecordImpressions is a use case function to record an impression of every served advertisement.
+//
+// ads: The served advertisements.
+//
+// Returns nothing.
+func (a *AdvertisementStatsUseCase) RecordImpressions(ads *[]models.Advertisement) {
+	// Lock the buffer
+	a.mu.Lock()
+
+	defer a.mu.Unlock()
+
+	// Add an impression to every advertisement
+	for _, ad := range *ads {
+		a.bufferedStat(ad.ID).Impressions++
+	}
+}
+
+// ClickAdvertisement is a use case function to record a click of the advertisement.
+//
+// adID: The advertisement ID.
+//
+// Returns the clicked advertisement and an error if any.
+func (a *AdvertisementStatsUseCase) ClickAdvertisement(adID uint) (*models.Advertisement, *entities.ProcessError) {
+	// Get the advertisement
+	ad, err := a.AdvertisementRepository.GetUsingID(adID)
+
+	// Return an error if the advertisement is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Advertisement not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the advertisement",
+		}
+	}
+
+	// Lock the buffer
+	a.mu.Lock()
+
+	defer a.mu.Unlock()
+
+	// Add a click to the advertisement
+	a.bufferedStat(ad.ID).Clicks++
+
+	return ad, nil
+}
+
+// FlushInterval is a use case function that writes the buffered stats at every interval
+// until the context is done, it's meant to be run in its own goroutine.
+//
+// ctx: The context that stops the writes.
+// interval: The interval the buffered stats are written.
+//
+// Returns nothing.
+func (a *AdvertisementStatsUseCase) FlushInterval(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			a.Flush()
+		}
+	}
+}
+
+// Flush is a use case function that writes the buffered stats to the database.
+//
+// Returns nothing.
+func (a *AdvertisementStatsUseCase) Flush() {
+	// Lock the buffer
+	a.mu.Lock()
+
+	// Take the buffered stats and reset the buffer
+	buffer := a.buffer
+	a.buffer = make(map[advertisementStatKey]*models.AdvertisementStat)
+
+	a.mu.Unlock()
+
+	// Skip the write if there is nothing buffered
+	if len(buffer) == 0 {
+		return
+	}
+
+	// Create the stats to write
+	stats := make([]models.AdvertisementStat, 0, len(buffer))
+
+	for _, stat := range buffer {
+		stats = append(stats, *stat)
+	}
+
+	// Write the stats at once
+	if err := a.AdvertisementStatRepository.Increment(&stats); err == nil {
+		return
+	}
+
+	// Write the stats one by one, the stats of the deleted advertisements are dropped
+	for _, stat := range stats {
+		if err := a.AdvertisementStatRepository.Increment(&[]models.AdvertisementStat{stat}); err != nil {
+			log.Println("Dropping advertisement stat: " + err.Error())
+		}
+	}
+}
+
+// bufferedStat is a helper function that returns the buffered stat of the advertisement
+// today, the buffer must be locked.
+//
+// adID: The advertisement ID.
+//
+// Returns the buffered stat.
+func (a *AdvertisementStatsUseCase) bufferedStat(adID uint) *models.AdvertisementStat {
+	// Get the stat key of today
+	key := advertisementStatKey{adID: adID, date: today().Format("2006-01-02")}
+
+	// Create the stat if it's not buffered yet
+	if _, ok := a.buffer[key]; !ok {
+		a.buffer[key] = &models.AdvertisementStat{
+			AdvertisementID: adID,
+			Date:            shared.DateOnly{Time: today()},
+		}
+	}
+
+	return a.buffer[key]
+}
+
+// ValidateStatsQuery is a use case function to validate the advertisement stats query.
+//
+// query: The advertisement stats query dto.
+//
+// Returns an error message if any.
+func (a *AdvertisementStatsUseCase) ValidateStatsQuery(query *dto.AdvertisementStatsQueryDTO) string {
+	// Check if the start date is valid
+	if _, err := time.Parse("2006-01-02", query.StartDate); !utils.IsBlank(query.StartDate) && err != nil {
+		return "Start date must be formatted as YYYY-MM-DD"
+	}
+
+	// Check if the end date is valid
+	if _, err := time.Parse("2006-01-02", query.EndDate); !utils.IsBlank(query.EndDate) && err != nil {
+		return "End date must be formatted as YYYY-MM-DD"
+	}
+
+	// Get the date range
+	from, to := statsDateRange(query)
+
+	// Check if the end date is before the start date
+	if to.Before(from) {
+		return "End date must not be before start date"
+	}
+
+	// Check if the date range is too long
+	if int(to.Sub(from).Hours()/24)+1 > constants.MAX_ADVERTISEMENT_STATS_DAYS {
+		return fmt.Sprintf("Date range must be at most %d days", constants.MAX_ADVERTISEMENT_STATS_DAYS)
+	}
+
+	return ""
+}
+
+// GetCurrentVendorAdvertisementsStats is a use case function to get the daily stats of
+// every advertisement of the current vendor.
+//
+// token: The current vendor token.
+// query: The advertisement stats query dto.
+//
+// Returns the advertisements, their daily stats and an error if any.
+func (a *AdvertisementStatsUseCase) GetCurrentVendorAdvertisementsStats(token *jwt.Token, query *dto.AdvertisementStatsQueryDTO) (*[]models.Advertisement, *[]models.AdvertisementStat, *entities.ProcessError) {
+	// Get the token claims
+	claims := a.AuthUseCase.DecodeToken(token)
+
+	// Get the vendor advertisements
+	ads, err := a.AdvertisementRepository.GetUsingVendorID(claims.Id)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the advertisements",
+		}
+	}
+
+	// Get the daily stats of the advertisements
+	stats, processErr := a.dailyStats(ads, query)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, nil, processErr
+	}
+
+	return ads, stats, nil
+}
+
+// GetAdvertisementStats is a use case function to get the daily stats of the advertisement.
+//
+// adID: The advertisement ID.
+// query: The advertisement stats query dto.
+//
+// Returns the advertisement, its daily stats and an error if any.
+func (a *AdvertisementStatsUseCase) GetAdvertisementStats(adID uint, query *dto.AdvertisementStatsQueryDTO) (*models.Advertisement, *[]models.AdvertisementStat, *entities.ProcessError) {
+	// Get the advertisement
+	ad, err := a.AdvertisementRepository.GetUsingID(adID)
+
+	// Return an error if the advertisement is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Advertisement not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the advertisement",
+		}
+	}
+
+	// Get the daily stats of the advertisement
+	stats, processErr := a.dailyStats(&[]models.Advertisement{*ad}, query)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, nil, processErr
+	}
+
+	return ad, stats, nil
+}
+
+// dailyStats is a helper function that returns the stats of the advertisements on
+// every date of the query range, a date without any stat has zero impressions and clicks.
+//
+// ads: The advertisements.
+// query: The advertisement stats query dto.
+//
+// Returns the daily stats ordered by the date and an error if any.
+func (a *AdvertisementStatsUseCase) dailyStats(ads *[]models.Advertisement, query *dto.AdvertisementStatsQueryDTO) (*[]models.AdvertisementStat, *entities.ProcessError) {
+	// Get the date range
+	from, to := statsDateRange(query)
+
+	// Create the daily stats array
+	days := []models.AdvertisementStat{}
+
+	// Return early if there is no advertisement
+	if len(*ads) == 0 {
+		return &days, nil
+	}
+
+	// Get the advertisement IDs
+	adIDs := make([]uint, 0, len(*ads))
+
+	for _, ad := range *ads {
+		adIDs = append(adIDs, ad.ID)
+	}
+
+	// Get the written stats of the advertisements
+	stats, err := a.AdvertisementStatRepository.GetUsingAdvertisementIDsDateRange(adIDs, from.Format("2006-01-02"), to.Format("2006-01-02"))
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the advertisement stats",
+		}
+	}
+
+	// Map the written stats by the advertisement and the date
+	written := make(map[advertisementStatKey]models.AdvertisementStat, len(*stats))
+
+	for _, stat := range *stats {
+		written[advertisementStatKey{adID: stat.AdvertisementID, date: stat.Date.Format("2006-01-02")}] = stat
+	}
+
+	// Fill every date of the range
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		for _, adID := range adIDs {
+			// Get the written stat of the date if any
+			stat, ok := written[advertisementStatKey{adID: adID, date: date.Format("2006-01-02")}]
+
+			// Create an empty stat of the date
+			if !ok {
+				stat = models.AdvertisementStat{
+					AdvertisementID: adID,
+					Date:            shared.DateOnly{Time: date},
+				}
+			}
+
+			days = append(days, stat)
+		}
+	}
+
+	return &days, nil
+}
+
+// statsDateRange is a helper function that returns the date range of the advertisement
+// stats query, the range ends today and covers the default number of days when not given.
+//
+// query: The advertisement stats query dto.
+//
+// Returns the first and the last date of the range.
+func statsDateRange(query *dto.AdvertisementStatsQueryDTO) (time.Time, time.Time) {
+	// Get the last date, today by default
+	to, err := time.Parse("2006-01-02", query.EndDate)
+
+	if err != nil {
+		to = today()
+	}
+
+	// Get the first date, the default number of days before the last date by default
+	from, err := time.Parse("2006-01-02", query.StartDate)
+
+	if err != nil {
+		from = to.AddDate(0, 0, 1-constants.ADVERTISEMENT_STATS_DAYS)
+	}
+
+	return from, to
+}
@@ -1,29 +1,784 @@
 package usecases
 
 import (
+	"fmt"
+	"main/core/constants"
+	"main/core/shared"
+	"main/core/types"
 	"main/data/models"
+	"main/domain/entities"
+	"main/internal/dto"
 	"main/internal/repository"
+	"main/pkg/utils"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
 )
 
 // AdvertisementUseCase is the use case for the advertisement.
 type AdvertisementUseCase struct {
 	AdvertisementsRepository *repository.AdvertisementRepository
+	VendorRepository         *repository.VendorRepository
+	CourtTypeRepository      *repository.CourtTypeRepository
+	UploadUseCase            *UploadUseCase
 }
 
 // NewAdvertisementUseCase is a constructor for the AdvertisementUseCase.
 //
 // a: The advertisement repository.
+// v: The vendor repository.
+// t: The court type repository.
+// up: The upload use case.
 //
-// Returns the AdvertisementUseCase instance.F
-func NewAdvertisementUseCase(a *repository.AdvertisementRepository) *AdvertisementUseCase {
+// Returns the AdvertisementUseCase instance.
+func NewAdvertisementUseCase(a *repository.AdvertisementRepository, v *repository.VendorRepository, t *repository.CourtTypeRepository, up *UploadUseCase) *AdvertisementUseCase {
 	return &AdvertisementUseCase{
 		AdvertisementsRepository: a,
+		VendorRepository:         v,
+		CourtTypeRepository:      t,
+		UploadUseCase:            up,
+	}
+}
+
+// ValidateAdvertisementsQuery is a use case function to validate the advertisements query.
+//
+// query: The advertisements query dto.
+//
+// Returns an error message if any.
+func (a *AdvertisementUseCase) ValidateAdvertisementsQuery(query *dto.AdvertisementsQueryDTO) string {
+	// Check if the location is not given
+	if query.Latitude == nil && query.Longitude == nil {
+		return ""
+	}
+
+	// Check if the latitude and longitude are given together
+	if query.Latitude == nil || query.Longitude == nil {
+		return "Latitude and longitude must be given together"
 	}
+
+	// Check if the latitude is valid
+	if *query.Latitude < -90 || *query.Latitude > 90 {
+		return "Latitude must be between -90 and 90"
+	}
+
+	// Check if the longitude is valid
+	if *query.Longitude < -180 || *query.Longitude > 180 {
+		return "Longitude must be between -180 and 180"
+	}
+
+	return ""
 }
 
-// GetAdvertisements is a use case function to get all advertisements.
+// GetAdvertisements is a use case function to get the advertisements shown to the caller,
+// the running advertisements targeted to the caller are chosen by a weighted rotation.
+//
+// query: The advertisements query dto.
 //
 // Returns the advertisements and an error if any.
-func (a *AdvertisementUseCase) GetAdvertisements() (*[]models.Advertisement, error) {
-	return a.AdvertisementsRepository.GetAll()
+func (a *AdvertisementUseCase) GetAdvertisements(query *dto.AdvertisementsQueryDTO) (*[]models.Advertisement, error) {
+	// Get the running advertisements of the court type
+	ads, err := a.AdvertisementsRepository.GetActive(strings.TrimSpace(query.CourtType), today())
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a slice of the advertisements targeted to the caller area
+	targeted := make([]models.Advertisement, 0, len(*ads))
+
+	for _, ad := range *ads {
+		// Keep the advertisements targeted to every area
+		if ad.Latitude == nil || ad.Longitude == nil || ad.RadiusKm == nil {
+			targeted = append(targeted, ad)
+
+			continue
+		}
+
+		// Skip the area targeted advertisements if the caller location is unknown
+		if query.Latitude == nil || query.Longitude == nil {
+			continue
+		}
+
+		// Keep the advertisements targeted to the caller area
+		if distanceKm(*query.Latitude, *query.Longitude, *ad.Latitude, *ad.Longitude) <= *ad.RadiusKm {
+			targeted = append(targeted, ad)
+		}
+	}
+
+	return pickAdvertisements(targeted, constants.ADVERTISEMENTS_LIMIT), nil
+}
+
+// GetAllAdvertisements is a use case function to get a page of every advertisement
+// campaign, including the paused, scheduled and ended ones.
+//
+// page: The page of the advertisements.
+//
+// Returns the advertisements, the next cursor or nil on the last page, and an error if any.
+func (a *AdvertisementUseCase) GetAllAdvertisements(page *types.Page) (*[]models.Advertisement, *types.Cursor, *entities.ProcessError) {
+	// Get the advertisements
+	ads, next, err := a.AdvertisementsRepository.Get(page)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the advertisements",
+		}
+	}
+
+	return ads, next, nil
+}
+
+// pickAdvertisements is a helper function that picks the advertisements by a weighted
+// random rotation, an advertisement with a higher weight is more likely to be picked first.
+//
+// ads: The advertisements to pick from.
+// limit: The maximum number of advertisements to pick.
+//
+// Returns the picked advertisements.
+func pickAdvertisements(ads []models.Advertisement, limit int) *[]models.Advertisement {
+	// Create the random keys of the advertisements
+	keys := make(map[uint]float64, len(ads))
+
+	for _, ad := range ads {
+		// Get the advertisement weight, at least 1
+		weight := math.Max(float64(ad.Weight), 1)
+
+		// Draw an exponential random key, the lowest keys are picked first
+		keys[ad.ID] = -math.Log(1-rand.Float64()) / weight
+	}
+
+	// Sort the advertisements by their random keys
+	sort.Slice(ads, func(i, j int) bool {
+		return keys[ads[i].ID] < keys[ads[j].ID]
+	})
+
+	// Keep the advertisements within the limit
+	if len(ads) > limit {
+		ads = ads[:limit]
+	}
+
+	return &ads
+}
+
+// distanceKm is a helper function that returns the great-circle distance in
+// kilometers between two locations, using the haversine formula.
+//
+// latitude1: The latitude of the first location.
+// longitude1: The longitude of the first location.
+// latitude2: The latitude of the second location.
+// longitude2: The longitude of the second location.
+//
+// Returns the distance in kilometers.
+func distanceKm(latitude1 float64, longitude1 float64, latitude2 float64, longitude2 float64) float64 {
+	// Convert the degrees to radians
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+
+	// Get the latitude and longitude deltas
+	deltaLatitude := toRadians(latitude2 - latitude1)
+	deltaLongitude := toRadians(longitude2 - longitude1)
+
+	// Calculate the haversine of the central angle
+	h := math.Pow(math.Sin(deltaLatitude/2), 2) +
+		math.Cos(toRadians(latitude1))*math.Cos(toRadians(latitude2))*math.Pow(math.Sin(deltaLongitude/2), 2)
+
+	return 2 * constants.EARTH_RADIUS_KM * math.Asin(math.Min(math.Sqrt(h), 1))
+}
+
+// ValidateCreateAdvertisementForm is a use case function to validate the create advertisement form.
+//
+// form: The create advertisement form dto.
+//
+// Returns a map of errors.
+func (a *AdvertisementUseCase) ValidateCreateAdvertisementForm(form *dto.CreateAdvertisementFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Remove the leading and trailing spaces
+	form.Name = strings.TrimSpace(form.Name)
+	form.CourtType = strings.TrimSpace(form.CourtType)
+
+	// Check if the name is blank
+	if utils.IsBlank(form.Name) {
+		errs["name"] = append(errs["name"], "Name is required")
+	}
+
+	// Check if the vendor id is blank
+	if form.VendorID == 0 {
+		errs["vendor_id"] = append(errs["vendor_id"], "Vendor ID is required")
+	}
+
+	// Check if the court type is blank
+	if utils.IsBlank(form.CourtType) {
+		errs["court_type"] = append(errs["court_type"], "Court type is required")
+	}
+
+	// Check if the image is blank
+	if utils.IsBlank(form.Image) && form.ImageFile == nil {
+		errs["image"] = append(errs["image"], "Image is required")
+	}
+
+	// Check if the schedule is valid
+	if startDate, endDate, ok := parseAdvertisementDates(form.StartDate, form.EndDate, errs); ok {
+		validateAdvertisementSchedule(startDate, endDate, errs)
+	}
+
+	// Check if the weight is valid
+	if form.Weight != nil {
+		validateAdvertisementWeight(*form.Weight, errs)
+	}
+
+	// Check if the targeted area is valid
+	if form.Latitude != nil || form.Longitude != nil || form.RadiusKm != nil {
+		validateAdvertisementArea(form.Latitude, form.Longitude, form.RadiusKm, errs)
+	}
+
+	// Check if theres any error
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// CreateAdvertisement is a use case function to create an advertisement of the vendor court type.
+//
+// form: The create advertisement form dto.
+//
+// Returns the advertisement and an error if any.
+func (a *AdvertisementUseCase) CreateAdvertisement(form *dto.CreateAdvertisementFormDTO) (*models.Advertisement, *entities.ProcessError) {
+	// Get the vendor
+	_, err := a.VendorRepository.GetUsingID(form.VendorID)
+
+	// Return an error if the vendor is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message: types.FormErrorResponseMsg{
+				"vendor_id": []string{"Vendor not found"},
+			},
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the vendor",
+		}
+	}
+
+	// Get the court type
+	courtType, err := a.CourtTypeRepository.GetUsingType(form.CourtType)
+
+	// Return an error if the court type is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message: types.FormErrorResponseMsg{
+				"court_type": []string{"Invalid court type"},
+			},
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the court type",
+		}
+	}
+
+	// Save the advertisement image
+	imageName, processErr := a.UploadUseCase.SaveFormImage(form.Image, form.ImageFile, constants.PATH_TO_ADVERTISEMENTS)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Create a new advertisement object
+	ad := &models.Advertisement{
+		Name:        form.Name,
+		VendorID:    form.VendorID,
+		CourtTypeID: courtType.ID,
+		Image:       imageName,
+		StartDate:   parseOptionalDate(form.StartDate),
+		EndDate:     parseOptionalDate(form.EndDate),
+		Weight:      1,
+		Latitude:    form.Latitude,
+		Longitude:   form.Longitude,
+		RadiusKm:    form.RadiusKm,
+	}
+
+	// Set the weight if it's given
+	if form.Weight != nil {
+		ad.Weight = *form.Weight
+	}
+
+	// Create the advertisement
+	err = a.AdvertisementsRepository.Create(ad)
+
+	// Return an error if any
+	if err != nil {
+		// Remove the advertisement image file
+		a.removeImage(imageName)
+
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while creating the advertisement",
+		}
+	}
+
+	// Get the created advertisement with its vendor and court type
+	return a.GetAdvertisement(ad.ID)
+}
+
+// ValidateUpdateAdvertisementForm is a use case function to validate the update advertisement form.
+//
+// form: The update advertisement form dto.
+//
+// Returns a map of errors.
+func (a *AdvertisementUseCase) ValidateUpdateAdvertisementForm(form *dto.UpdateAdvertisementFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if the area is changed
+	areaChanged := form.Latitude != nil || form.Longitude != nil || form.RadiusKm != nil
+
+	// Check if there is nothing to update
+	if form.Name == nil && form.CourtType == nil && form.StartDate == nil && form.EndDate == nil &&
+		form.Weight == nil && !areaChanged && !form.RemoveArea {
+		errs["ad"] = append(errs["ad"], "Nothing to update")
+
+		return errs
+	}
+
+	// Check if the name is blank
+	if form.Name != nil {
+		*form.Name = strings.TrimSpace(*form.Name)
+
+		if utils.IsBlank(*form.Name) {
+			errs["name"] = append(errs["name"], "Name is required")
+		}
+	}
+
+	// Check if the court type is blank
+	if form.CourtType != nil {
+		*form.CourtType = strings.TrimSpace(*form.CourtType)
+
+		if utils.IsBlank(*form.CourtType) {
+			errs["court_type"] = append(errs["court_type"], "Court type is required")
+		}
+	}
+
+	// Check if the dates are valid, an empty date removes it
+	var startDate, endDate string
+
+	if form.StartDate != nil {
+		startDate = *form.StartDate
+	}
+
+	if form.EndDate != nil {
+		endDate = *form.EndDate
+	}
+
+	parseAdvertisementDates(startDate, endDate, errs)
+
+	// Check if the weight is valid
+	if form.Weight != nil {
+		validateAdvertisementWeight(*form.Weight, errs)
+	}
+
+	// Check if the targeted area is valid
+	if form.RemoveArea && areaChanged {
+		errs["area"] = append(errs["area"], "Area can't be changed and removed together")
+	} else if areaChanged {
+		validateAdvertisementArea(form.Latitude, form.Longitude, form.RadiusKm, errs)
+	}
+
+	// Check if theres any error
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// UpdateAdvertisement is a use case function to update the advertisement campaign.
+//
+// adID: The advertisement ID.
+// form: The update advertisement form dto.
+//
+// Returns the updated advertisement and an error if any.
+func (a *AdvertisementUseCase) UpdateAdvertisement(adID uint, form *dto.UpdateAdvertisementFormDTO) (*models.Advertisement, *entities.ProcessError) {
+	// Get the advertisement
+	ad, processErr := a.GetAdvertisement(adID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Create the updated fields
+	fields := make(map[string]any)
+
+	// Update the name
+	if form.Name != nil {
+		fields["name"] = *form.Name
+	}
+
+	// Update the court type
+	if form.CourtType != nil {
+		// Get the court type
+		courtType, err := a.CourtTypeRepository.GetUsingType(*form.CourtType)
+
+		// Return an error if the court type is not found
+		if err == gorm.ErrRecordNotFound {
+			return nil, &entities.ProcessError{
+				ClientError: true,
+				Message: types.FormErrorResponseMsg{
+					"court_type": []string{"Invalid court type"},
+				},
+			}
+		}
+
+		// Return an error if any
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while getting the court type",
+			}
+		}
+
+		fields["court_type_id"] = courtType.ID
+	}
+
+	// Update the dates, the dates are validated with the form
+	if form.StartDate != nil {
+		ad.StartDate = parseOptionalDate(*form.StartDate)
+		fields["start_date"] = ad.StartDate
+	}
+
+	if form.EndDate != nil {
+		ad.EndDate = parseOptionalDate(*form.EndDate)
+		fields["end_date"] = ad.EndDate
+	}
+
+	// Check if the updated schedule is valid
+	if form.StartDate != nil || form.EndDate != nil {
+		// Create an empty error map
+		errs := make(types.FormErrorResponseMsg)
+
+		// Validate the updated schedule
+		validateAdvertisementSchedule(ad.StartDate, ad.EndDate, errs)
+
+		// Return an error if any
+		if len(errs) > 0 {
+			return nil, &entities.ProcessError{
+				ClientError: true,
+				Message:     errs,
+			}
+		}
+	}
+
+	// Update the weight
+	if form.Weight != nil {
+		fields["weight"] = *form.Weight
+	}
+
+	// Update the targeted area, the area is validated with the form
+	if form.Latitude != nil {
+		fields["latitude"] = *form.Latitude
+		fields["longitude"] = *form.Longitude
+		fields["radius_km"] = *form.RadiusKm
+	}
+
+	// Remove the targeted area
+	if form.RemoveArea {
+		fields["latitude"] = nil
+		fields["longitude"] = nil
+		fields["radius_km"] = nil
+	}
+
+	// Update the advertisement
+	if err := a.AdvertisementsRepository.Update(adID, fields); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while updating the advertisement",
+		}
+	}
+
+	return a.GetAdvertisement(adID)
+}
+
+// PauseAdvertisement is a use case function to pause the advertisement campaign,
+// the paused advertisement isn't shown until it's resumed.
+//
+// adID: The advertisement ID.
+//
+// Returns the paused advertisement and an error if any.
+func (a *AdvertisementUseCase) PauseAdvertisement(adID uint) (*models.Advertisement, *entities.ProcessError) {
+	// Get the advertisement
+	ad, processErr := a.GetAdvertisement(adID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Return an error if the advertisement is already paused
+	if ad.PausedAt != nil {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Advertisement is already paused",
+		}
+	}
+
+	// Get the paused time
+	pausedAt := time.Now()
+
+	// Pause the advertisement
+	if err := a.AdvertisementsRepository.UpdatePausedAt(adID, &pausedAt); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while pausing the advertisement",
+		}
+	}
+
+	ad.PausedAt = &pausedAt
+
+	return ad, nil
+}
+
+// ResumeAdvertisement is a use case function to resume the paused advertisement campaign.
+//
+// adID: The advertisement ID.
+//
+// Returns the resumed advertisement and an error if any.
+func (a *AdvertisementUseCase) ResumeAdvertisement(adID uint) (*models.Advertisement, *entities.ProcessError) {
+	// Get the advertisement
+	ad, processErr := a.GetAdvertisement(adID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Return an error if the advertisement is not paused
+	if ad.PausedAt == nil {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Advertisement is not paused",
+		}
+	}
+
+	// Resume the advertisement
+	if err := a.AdvertisementsRepository.UpdatePausedAt(adID, nil); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while resuming the advertisement",
+		}
+	}
+
+	ad.PausedAt = nil
+
+	return ad, nil
+}
+
+// DeleteAdvertisement is a use case function to delete an advertisement.
+//
+// adID: The advertisement ID.
+//
+// Returns an error if any.
+func (a *AdvertisementUseCase) DeleteAdvertisement(adID uint) *entities.ProcessError {
+	// Get the advertisement
+	ad, processErr := a.GetAdvertisement(adID)
+
+	// Return an error if any
+	if processErr != nil {
+		return processErr
+	}
+
+	// Delete the advertisement
+	if err := a.AdvertisementsRepository.DeleteUsingID(ad.ID); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while deleting the advertisement",
+		}
+	}
+
+	// Remove the advertisement image file
+	a.removeImage(ad.Image)
+
+	return nil
+}
+
+// GetAdvertisement is a use case function to get the advertisement campaign.
+//
+// adID: The advertisement ID.
+//
+// Returns the advertisement and an error if any.
+func (a *AdvertisementUseCase) GetAdvertisement(adID uint) (*models.Advertisement, *entities.ProcessError) {
+	// Get the advertisement
+	ad, err := a.AdvertisementsRepository.GetUsingID(adID)
+
+	// Return an error if the advertisement is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Advertisement not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the advertisement",
+		}
+	}
+
+	return ad, nil
+}
+
+// removeImage is a helper function that removes the advertisement image files
+// when the image is not used by any other advertisement.
+//
+// imageName: The image file name.
+//
+// Returns nothing.
+func (a *AdvertisementUseCase) removeImage(imageName string) {
+	// Check if the image is still used
+	used, err := a.AdvertisementsRepository.IsImageUsed(imageName)
+
+	// Keep the file if it's still used or the usage is unknown
+	if err != nil || used {
+		return
+	}
+
+	// Remove the advertisement image files
+	a.UploadUseCase.RemoveImage(constants.PATH_TO_ADVERTISEMENTS, imageName)
+}
+
+// parseAdvertisementDates is a helper function that validates the format of the
+// advertisement dates, a blank date isn't set.
+//
+// startDate: The start date, formatted as YYYY-MM-DD.
+// endDate: The end date, formatted as YYYY-MM-DD.
+// errs: The error map.
+//
+// Returns the parsed start date, the parsed end date, and whether the dates are valid.
+func parseAdvertisementDates(startDate string, endDate string, errs types.FormErrorResponseMsg) (*shared.DateOnly, *shared.DateOnly, bool) {
+	// Check if the start date is valid
+	if _, err := time.Parse("2006-01-02", startDate); !utils.IsBlank(startDate) && err != nil {
+		errs["start_date"] = append(errs["start_date"], "Start date must be formatted as YYYY-MM-DD")
+	}
+
+	// Check if the end date is valid
+	if _, err := time.Parse("2006-01-02", endDate); !utils.IsBlank(endDate) && err != nil {
+		errs["end_date"] = append(errs["end_date"], "End date must be formatted as YYYY-MM-DD")
+	}
+
+	// Return if any date is invalid
+	if len(errs["start_date"]) > 0 || len(errs["end_date"]) > 0 {
+		return nil, nil, false
+	}
+
+	return parseOptionalDate(startDate), parseOptionalDate(endDate), true
+}
+
+// parseOptionalDate is a helper function that parses the optional date.
+//
+// date: The date formatted as YYYY-MM-DD, blank if it's not set.
+//
+// Returns the parsed date, nil if it's not set or invalid.
+func parseOptionalDate(date string) *shared.DateOnly {
+	// Parse the date
+	parsed, err := time.Parse("2006-01-02", strings.TrimSpace(date))
+
+	// Return nil if the date is not set or invalid
+	if err != nil {
+		return nil
+	}
+
+	return &shared.DateOnly{Time: parsed}
+}
+
+// validateAdvertisementSchedule is a helper function that validates the advertisement
+// schedule, the end date can't be before the start date or today.
+//
+// startDate: The start date, nil to start right away.
+// endDate: The end date, nil to never end.
+// errs: The error map.
+//
+// Returns nothing.
+func validateAdvertisementSchedule(startDate *shared.DateOnly, endDate *shared.DateOnly, errs types.FormErrorResponseMsg) {
+	// Skip the check if the advertisement never ends
+	if endDate == nil {
+		return
+	}
+
+	// Check if the end date is before the start date
+	if startDate != nil && endDate.Before(startDate.Time) {
+		errs["end_date"] = append(errs["end_date"], "End date must not be before start date")
+
+		return
+	}
+
+	// Check if the end date is in the past
+	if endDate.Before(today()) {
+		errs["end_date"] = append(errs["end_date"], "End date must not be in the past")
+	}
+}
+
+// validateAdvertisementWeight is a helper function that validates the advertisement weight.
+//
+// weight: The priority weight.
+// errs: The error map.
+//
+// Returns nothing.
+func validateAdvertisementWeight(weight uint, errs types.FormErrorResponseMsg) {
+	// Check if the weight is within the range
+	if weight < 1 || weight > uint(constants.MAX_ADVERTISEMENT_WEIGHT) {
+		errs["weight"] = append(errs["weight"], fmt.Sprintf("Weight must be between 1 and %d", constants.MAX_ADVERTISEMENT_WEIGHT))
+	}
+}
+
+// validateAdvertisementArea is a helper function that validates the targeted area
+// of the advertisement.
+//
+// latitude: The latitude of the area center.
+// longitude: The longitude of the area center.
+// radiusKm: The radius of the area in kilometers.
+// errs: The error map.
+//
+// Returns nothing.
+func validateAdvertisementArea(latitude *float64, longitude *float64, radiusKm *float64, errs types.FormErrorResponseMsg) {
+	// Check if the area fields are given together
+	if latitude == nil || longitude == nil || radiusKm == nil {
+		errs["area"] = append(errs["area"], "Latitude, longitude and radius must be provided together")
+
+		return
+	}
+
+	// Check if the latitude is valid
+	if *latitude < -90 || *latitude > 90 {
+		errs["latitude"] = append(errs["latitude"], "Latitude must be between -90 and 90")
+	}
+
+	// Check if the longitude is valid
+	if *longitude < -180 || *longitude > 180 {
+		errs["longitude"] = append(errs["longitude"], "Longitude must be between -180 and 180")
+	}
+
+	// Check if the radius is valid
+	if *radiusKm <= 0 || *radiusKm > constants.MAX_NEARBY_RADIUS_KM {
+		errs["radius_km"] = append(errs["radius_km"], fmt.Sprintf("Radius must be greater than 0 and at most %g km", constants.MAX_NEARBY_RADIUS_KM))
+	}
 }
@@ -1,6 +1,9 @@
 package usecases
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"log"
 	"main/core/config"
@@ -25,21 +28,51 @@ func NewAuthUseCase() *AuthUseCase {
 	return &AuthUseCase{}
 }
 
-// GenerateToken is a function that generates a JWT token.
+// GenerateToken is a function that generates a JWT access token.
 //
 // id: the id of the client
 // clientType: the client type
+// familyID: the id of the refresh token family
 //
 // Returns a string containing the token and an error if there is any
-func (a *AuthUseCase) GenerateToken(id uint, clientType enums.ClientType) (string, error) {
-	// Create a new token with the claims
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &entities.JWTClaims{
+func (a *AuthUseCase) GenerateToken(id uint, clientType enums.ClientType, familyID string) (string, error) {
+	return a.signToken(&entities.JWTClaims{
 		Id:         id,
 		ClientType: clientType,
-		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour * 24 * 30)),
-		},
+		FamilyID:   familyID,
+	})
+}
+
+// GenerateStaffToken is a function that generates a JWT token for a vendor staff,
+// the token is a vendor token carrying the staff id.
+//
+// vendorID: the id of the vendor
+// staffID: the id of the vendor staff
+// familyID: the id of the refresh token family
+//
+// Returns a string containing the token and an error if there is any
+func (a *AuthUseCase) GenerateStaffToken(vendorID uint, staffID uint, familyID string) (string, error) {
+	return a.signToken(&entities.JWTClaims{
+		Id:         vendorID,
+		ClientType: enums.Vendor,
+		StaffID:    staffID,
+		FamilyID:   familyID,
 	})
+}
+
+// signToken is a helper function that signs the JWT claims.
+//
+// claims: the JWT claims
+//
+// Returns a string containing the token and an error if there is any
+func (a *AuthUseCase) signToken(claims *entities.JWTClaims) (string, error) {
+	// Set the token expiration
+	claims.RegisteredClaims = jwt.RegisteredClaims{
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(config.JWTConfig.AccessTokenExpiry)),
+	}
+
+	// Create a new token with the claims
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 
 	// Sign the token with the secret
 	tokenString, err := token.SignedString([]byte(config.JWTConfig.Secret))
@@ -154,3 +187,33 @@ func (a *AuthUseCase) VerifyToken(tokenString string) (*jwt.Token, bool) {
 
 	return token, true
 }
+
+// GenerateRandomToken is a function that generates a random opaque token,
+// only the hash of the token should be stored.
+//
+// Returns the token and an error if any
+func (a *AuthUseCase) GenerateRandomToken() (string, error) {
+	// Create a new random bytes
+	random := make([]byte, 32)
+
+	// Fill the random bytes
+	if _, err := rand.Read(random); err != nil {
+		log.Println("Error generating random token: " + err.Error())
+
+		return "", err
+	}
+
+	return hex.EncodeToString(random), nil
+}
+
+// HashRandomToken is a function that hashes a random opaque token.
+//
+// token: the token to hash
+//
+// Returns the SHA-256 hash of the token as hex
+func (a *AuthUseCase) HashRandomToken(token string) string {
+	// Hash the token
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,432 @@
+package usecases
+
+import (
+	"main/core/constants"
+	"main/core/types"
+	"main/data/models"
+	"main/domain/entities"
+	"main/internal/dto"
+	"main/internal/repository"
+	"main/pkg/utils"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CourtTypeUseCase is a struct that defines the use case for the court type entity.
+type CourtTypeUseCase struct {
+	CourtTypeRepository *repository.CourtTypeRepository
+	UploadUseCase       *UploadUseCase
+}
+
+// NewCourtTypeUseCase is a factory function that returns a new instance of the CourtTypeUseCase struct.
+//
+// c: The court type repository.
+// u: The upload use case.
+//
+// Returns a new instance of the CourtTypeUseCase.
+func NewCourtTypeUseCase(c *repository.CourtTypeRepository, u *UploadUseCase) *CourtTypeUseCase {
+	return &CourtTypeUseCase{
+		CourtTypeRepository: c,
+		UploadUseCase:       u,
+	}
+}
+
+// GetActiveCourtTypes is a function that returns the court types that are not retired.
+//
+// Returns the court types and an error if any.
+func (c *CourtTypeUseCase) GetActiveCourtTypes() (*[]models.CourtType, error) {
+	return c.CourtTypeRepository.GetActive()
+}
+
+// GetAllCourtTypes is a function that returns all the court types, including the retired ones.
+//
+// Returns the court types and an error if any.
+func (c *CourtTypeUseCase) GetAllCourtTypes() (*[]models.CourtType, error) {
+	return c.CourtTypeRepository.GetAll()
+}
+
+// IsValidCourtType is a function that checks if the given court type exists in the database.
+//
+// courtType: The court type.
+//
+// Returns true if the court type exists.
+func (c *CourtTypeUseCase) IsValidCourtType(courtType string) bool {
+	// Check if the court type is blank
+	if utils.IsBlank(courtType) {
+		return false
+	}
+
+	// Check if the court type exists
+	exists, err := c.CourtTypeRepository.IsTypeExists(courtType)
+
+	// Return false if any error
+	if err != nil {
+		return false
+	}
+
+	return exists
+}
+
+// SanitizeCourtTypeForm is a function that sanitizes the court type form.
+//
+// form: The court type form dto.
+//
+// Returns nothing.
+func (c *CourtTypeUseCase) SanitizeCourtTypeForm(form *dto.CourtTypeFormDTO) {
+	// Trim the court type name
+	form.Type = strings.TrimSpace(form.Type)
+
+	// Uppercase the first letter of the court type name
+	if !utils.IsBlank(form.Type) {
+		form.Type = utils.UpperFirstLetter(form.Type)
+	}
+}
+
+// ValidateCourtTypeForm is a function that validates the court type form.
+//
+// form: The court type form dto.
+//
+// Returns the form error response message.
+func (c *CourtTypeUseCase) ValidateCourtTypeForm(form *dto.CourtTypeFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if the court type name is blank
+	if utils.IsBlank(form.Type) {
+		errs["type"] = append(errs["type"], "Court type is required")
+	}
+
+	// Check if the court type name contains a slash, since it's used as a path parameter
+	if strings.Contains(form.Type, "/") {
+		errs["type"] = append(errs["type"], "Court type must not contain a slash")
+	}
+
+	// Check if theres any error
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// saveIcon is a helper function that validates the icon, re-encodes it, and saves it
+// to the storage.
+//
+// image: The base64 encoded icon.
+//
+// Returns the content hashed icon file name and an error if any.
+func (c *CourtTypeUseCase) saveIcon(image string) (string, *entities.ProcessError) {
+	return c.UploadUseCase.SaveFormImage(image, nil, constants.PATH_TO_COURT_TYPE_ICONS)
+}
+
+// removeIconFiles is a helper function that removes the icon files from the storage,
+// the files are kept if the icon is still used by another court type.
+//
+// icon: The icon file name.
+//
+// Returns nothing.
+func (c *CourtTypeUseCase) removeIconFiles(icon string) {
+	// Get the count of the court types using the icon
+	count, err := c.CourtTypeRepository.GetCountUsingIcon(icon)
+
+	// Keep the files if the icon is still used or the count is unknown
+	if err != nil || count > 0 {
+		return
+	}
+
+	// Remove the icon files
+	c.UploadUseCase.RemoveImage(constants.PATH_TO_COURT_TYPE_ICONS, icon)
+}
+
+// CreateCourtType is a function that creates a new court type.
+//
+// form: The court type form dto.
+//
+// Returns the court type and an error if any.
+func (c *CourtTypeUseCase) CreateCourtType(form *dto.CourtTypeFormDTO) (*models.CourtType, *entities.ProcessError) {
+	// Check if the court type already exists
+	exists, err := c.CourtTypeRepository.IsTypeExists(form.Type)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while checking if the court type exists",
+		}
+	}
+
+	// Return an error if the court type already exists
+	if exists {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message: types.FormErrorResponseMsg{
+				"type": []string{"Court type already exists"},
+			},
+		}
+	}
+
+	// iconName is the icon file name, blank if there is no icon
+	iconName := ""
+
+	// Save the court type icon before the court type is created, so an invalid
+	// icon doesn't leave a court type behind
+	if !utils.IsBlank(form.Icon) {
+		var processErr *entities.ProcessError
+
+		iconName, processErr = c.saveIcon(form.Icon)
+
+		// Return an error if any
+		if processErr != nil {
+			return nil, processErr
+		}
+	}
+
+	// Create a new court type object
+	courtType := &models.CourtType{
+		Type: form.Type,
+		Icon: iconName,
+	}
+
+	// Create the court type
+	err = c.CourtTypeRepository.Create(courtType)
+
+	// Return an error if any
+	if err != nil {
+		// Remove the saved icon if it's not used
+		if !utils.IsBlank(iconName) {
+			c.removeIconFiles(iconName)
+		}
+
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while creating the court type",
+		}
+	}
+
+	return courtType, nil
+}
+
+// getCourtType is a helper function that gets the court type using the court type ID.
+//
+// courtTypeID: The court type ID.
+//
+// Returns the court type and an error if any.
+func (c *CourtTypeUseCase) getCourtType(courtTypeID uint) (*models.CourtType, *entities.ProcessError) {
+	// Get the court type
+	courtType, err := c.CourtTypeRepository.GetUsingID(courtTypeID)
+
+	// Return an error if the court type is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Court type not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the court type",
+		}
+	}
+
+	return courtType, nil
+}
+
+// RenameCourtType is a function that renames the court type.
+//
+// courtTypeID: The court type ID.
+// form: The court type form dto.
+//
+// Returns the court type and an error if any.
+func (c *CourtTypeUseCase) RenameCourtType(courtTypeID uint, form *dto.CourtTypeFormDTO) (*models.CourtType, *entities.ProcessError) {
+	// Get the court type
+	courtType, processErr := c.getCourtType(courtTypeID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Check if the new name is taken by another court type
+	if !strings.EqualFold(courtType.Type, form.Type) {
+		exists, err := c.CourtTypeRepository.IsTypeExists(form.Type)
+
+		// Return an error if any
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while checking if the court type exists",
+			}
+		}
+
+		// Return an error if the court type already exists
+		if exists {
+			return nil, &entities.ProcessError{
+				ClientError: true,
+				Message: types.FormErrorResponseMsg{
+					"type": []string{"Court type already exists"},
+				},
+			}
+		}
+	}
+
+	// Rename the court type
+	err := c.CourtTypeRepository.UpdateType(courtTypeID, form.Type)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while renaming the court type",
+		}
+	}
+
+	courtType.Type = form.Type
+
+	return courtType, nil
+}
+
+// ValidateChangeIconData is a function that validates the change court type icon data.
+//
+// data: The change court type icon dto.
+//
+// Returns a string of error.
+func (c *CourtTypeUseCase) ValidateChangeIconData(data *dto.ChangeCourtTypeIconDTO) string {
+	// Check if the icon is empty
+	if utils.IsBlank(data.Image) {
+		return "Image is required"
+	}
+
+	return ""
+}
+
+// ChangeCourtTypeIcon is a function that changes the court type icon.
+//
+// courtTypeID: The court type ID.
+// data: The change court type icon dto.
+//
+// Returns the court type and an error if any.
+func (c *CourtTypeUseCase) ChangeCourtTypeIcon(courtTypeID uint, data *dto.ChangeCourtTypeIconDTO) (*models.CourtType, *entities.ProcessError) {
+	// Get the court type
+	courtType, processErr := c.getCourtType(courtTypeID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Save the court type icon
+	iconName, processErr := c.saveIcon(data.Image)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Update the court type icon
+	err := c.CourtTypeRepository.UpdateIcon(courtType.ID, iconName)
+
+	// Return an error if any
+	if err != nil {
+		// Remove the saved icon if it's not used
+		c.removeIconFiles(iconName)
+
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while updating the court type icon",
+		}
+	}
+
+	// Remove the previous icon if it's no longer used
+	if previousIcon := courtType.Icon; !utils.IsBlank(previousIcon) && previousIcon != iconName {
+		c.removeIconFiles(previousIcon)
+	}
+
+	courtType.Icon = iconName
+
+	return courtType, nil
+}
+
+// RetireCourtType is a function that retires the court type, so it can't
+// be used for new courts anymore.
+//
+// courtTypeID: The court type ID.
+//
+// Returns the court type and an error if any.
+func (c *CourtTypeUseCase) RetireCourtType(courtTypeID uint) (*models.CourtType, *entities.ProcessError) {
+	// Get the court type
+	courtType, processErr := c.getCourtType(courtTypeID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Return an error if the court type is already retired
+	if courtType.RetiredAt != nil {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Court type is already retired",
+		}
+	}
+
+	// Get the current time
+	now := time.Now()
+
+	// Retire the court type
+	err := c.CourtTypeRepository.UpdateRetiredAt(courtTypeID, &now)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while retiring the court type",
+		}
+	}
+
+	courtType.RetiredAt = &now
+
+	return courtType, nil
+}
+
+// RestoreCourtType is a function that restores a retired court type.
+//
+// courtTypeID: The court type ID.
+//
+// Returns the court type and an error if any.
+func (c *CourtTypeUseCase) RestoreCourtType(courtTypeID uint) (*models.CourtType, *entities.ProcessError) {
+	// Get the court type
+	courtType, processErr := c.getCourtType(courtTypeID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Return an error if the court type is not retired
+	if courtType.RetiredAt == nil {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Court type is not retired",
+		}
+	}
+
+	// Restore the court type
+	err := c.CourtTypeRepository.UpdateRetiredAt(courtTypeID, nil)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while restoring the court type",
+		}
+	}
+
+	courtType.RetiredAt = nil
+
+	return courtType, nil
+}
@@ -1,29 +1,33 @@
 package usecases
 
 import (
-	"encoding/base64"
+	"errors"
 	"fmt"
 	"log"
 	"main/core/constants"
-	"main/core/enums"
 	"main/core/types"
 	"main/data/models"
 	"main/domain/entities"
 	"main/internal/dto"
 	"main/internal/repository"
 	"main/pkg/utils"
-	"os"
-	"sort"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
 // CourtUseCase is a struct that defines the use case for the court entity.
 type CourtUseCase struct {
-	AuthUseCase      *AuthUseCase
-	CourtRepository  *repository.CourtRepository
-	ReviewRepository *repository.ReviewRepository
+	AuthUseCase             *AuthUseCase
+	CourtRepository         *repository.CourtRepository
+	ReviewRepository        *repository.ReviewRepository
+	CourtTypeRepository     *repository.CourtTypeRepository
+	CourtTypeLinkRepository *repository.CourtTypeLinkRepository
+	GalleryImageRepository  *repository.GalleryImageRepository
+	UploadUseCase           *UploadUseCase
 }
 
 // NewCourtUseCase is a factory function that returns a new instance of the CourtUseCase struct.
@@ -31,71 +35,260 @@ type CourtUseCase struct {
 // a: The auth use case.
 // c: The court repository.
 // r: The review repository.
+// t: The court type repository.
+// l: The court type link repository.
+// g: The gallery image repository.
+// up: The upload use case.
 //
 // Returns a new instance of the CourtUseCase.
-func NewCourtUseCase(a *AuthUseCase, c *repository.CourtRepository, r *repository.ReviewRepository) *CourtUseCase {
+func NewCourtUseCase(a *AuthUseCase, c *repository.CourtRepository, r *repository.ReviewRepository, t *repository.CourtTypeRepository, l *repository.CourtTypeLinkRepository, g *repository.GalleryImageRepository, up *UploadUseCase) *CourtUseCase {
 	return &CourtUseCase{
-		AuthUseCase:      a,
-		CourtRepository:  c,
-		ReviewRepository: r,
+		AuthUseCase:             a,
+		CourtRepository:         c,
+		ReviewRepository:        r,
+		CourtTypeRepository:     t,
+		CourtTypeLinkRepository: l,
+		GalleryImageRepository:  g,
+		UploadUseCase:           up,
 	}
 }
 
-// GetCourts is a function that returns the courts.
+// ValidateCourtsQuery is a function that validates the query parameters to filter, sort
+// and paginate the courts catalogue.
 //
-// courtType: The court type.
-// search: The search query.
+// query: The courts query dto.
 //
-// Returns the courts and an error if any.
-func (c *CourtUseCase) GetCourts(courtType *string, search *string) (*[]types.CourtMap, error) {
-	// Create an empty courts slice and an error
-	var (
-		courts *[]models.Court
-		err    error
-	)
+// Returns a string of error.
+func (c *CourtUseCase) ValidateCourtsQuery(query *dto.CourtsQueryDTO) string {
+	// Check if the sort order is valid
+	if _, ok := constants.COURT_SORT_ORDERS[query.Sort]; query.Sort != "" && !ok {
+		return "Sort must be either rating, reviews, price, newest or distance"
+	}
 
-	// Get the courts
-	if (courtType == nil || utils.IsBlank(*courtType)) && (search == nil || utils.IsBlank(*search)) {
-		courts, err = c.CourtRepository.Get()
-	} else if (courtType != nil && !utils.IsBlank(*courtType)) && (search == nil || utils.IsBlank(*search)) {
-		courts, err = c.CourtRepository.GetUsingCourtType(*courtType)
-	} else if (courtType == nil || utils.IsBlank(*courtType)) && (search != nil && !utils.IsBlank(*search)) {
-		courts, err = c.CourtRepository.GetUsingVendorName(*search)
-	} else {
-		courts, err = c.CourtRepository.GetUsingCourtTypeVendorName(*courtType, *search)
+	// Check if the sort direction is valid
+	if query.Order != "" && query.Order != "asc" && query.Order != "desc" {
+		return "Order must be either asc or desc"
+	}
+
+	// Check if the minimum price is valid
+	if query.MinPrice != nil && *query.MinPrice < 0 {
+		return "Minimum price must not be negative"
+	}
+
+	// Check if the maximum price is valid
+	if query.MaxPrice != nil && *query.MaxPrice < 0 {
+		return "Maximum price must not be negative"
+	}
+
+	// Check if the price range is valid
+	if query.MinPrice != nil && query.MaxPrice != nil && *query.MinPrice > *query.MaxPrice {
+		return "Minimum price must not be greater than maximum price"
+	}
+
+	// Check if the minimum rating is valid
+	if query.MinRating != nil && (*query.MinRating < 0 || *query.MinRating > 5) {
+		return "Minimum rating must be between 0 and 5"
+	}
+
+	// Check if the open at time is valid
+	if _, err := time.Parse("15:04", query.OpenAt); query.OpenAt != "" && err != nil {
+		return "Open at must be formatted as HH:MM"
+	}
+
+	// Check if the limit is valid
+	if query.Limit != nil && (*query.Limit < 1 || *query.Limit > constants.MAX_PAGE_LIMIT) {
+		return fmt.Sprintf("Limit must be between 1 and %d", constants.MAX_PAGE_LIMIT)
+	}
+
+	// Check if the cursor is valid
+	if _, err := decodeCourtsCursor(query); err != nil {
+		return "Invalid cursor"
+	}
+
+	// Check if the location is not given
+	if query.Latitude == nil && query.Longitude == nil {
+		// Check if the radius is given without the location
+		if query.RadiusKm != nil {
+			return "Latitude and longitude are required to search within a radius"
+		}
+
+		// Check if the distance sort is given without the location
+		if query.Sort == "distance" {
+			return "Latitude and longitude are required to sort by distance"
+		}
+
+		return ""
+	}
+
+	// Check if the latitude and longitude are given together
+	if query.Latitude == nil || query.Longitude == nil {
+		return "Latitude and longitude must be given together"
+	}
+
+	// Check if the latitude is valid
+	if *query.Latitude < -90 || *query.Latitude > 90 {
+		return "Latitude must be between -90 and 90"
+	}
+
+	// Check if the longitude is valid
+	if *query.Longitude < -180 || *query.Longitude > 180 {
+		return "Longitude must be between -180 and 180"
+	}
+
+	// Check if the radius is valid
+	if query.RadiusKm != nil && (*query.RadiusKm <= 0 || *query.RadiusKm > constants.MAX_NEARBY_RADIUS_KM) {
+		return fmt.Sprintf("Radius must be greater than 0 and at most %g km", constants.MAX_NEARBY_RADIUS_KM)
+	}
+
+	return ""
+}
+
+// decodeCourtsCursor is a helper function that decodes the cursor of the courts query,
+// the cursor value is converted to the type of the sorted column.
+//
+// query: The courts query dto.
+//
+// Returns the cursor, nil if there is no cursor, and an error if any.
+func decodeCourtsCursor(query *dto.CourtsQueryDTO) (*types.Cursor, error) {
+	// Return nil if there is no cursor
+	if query.Cursor == "" {
+		return nil, nil
 	}
 
+	// Decode the cursor
+	cursor, err := utils.DecodeCursor(query.Cursor)
+
 	// Return an error if any
 	if err != nil {
 		return nil, err
 	}
 
-	// Create a new court maps slice
-	courtMaps := make([]types.CourtMap, len(*courts))
+	// Check if the courts are sorted by the newest
+	if query.Sort == "newest" {
+		// Get the cursor time
+		value, ok := cursor.Value.(string)
 
-	// Loop through the courts
-	for i, court := range *courts {
-		// Get the court average rating
-		totalRating, err := c.ReviewRepository.GetAvgRatingUsingCourtTypeVendorID(court.CourtType.Type, court.VendorID)
-
-		// Return an error if any
-		if err != nil {
-			return nil, err
+		// Return an error if the cursor value is not a time
+		if !ok {
+			return nil, errors.New("cursor value is not a time")
 		}
 
-		// Append the court map
-		courtMaps[i] = types.CourtMap{
-			"court":        court,
-			"total_rating": totalRating,
-		}
+		// Parse the cursor time
+		cursor.Value, err = time.Parse(time.RFC3339Nano, value)
+
+		return cursor, err
+	}
+
+	// Return an error if the cursor value is not a number
+	if _, ok := cursor.Value.(float64); !ok {
+		return nil, errors.New("cursor value is not a number")
 	}
 
-	// Sort the courts based by total rating
-	sort.Slice(courtMaps, func(i, j int) bool {
-		return courtMaps[i].GetTotalRating() > courtMaps[j].GetTotalRating()
-	})
+	return cursor, nil
+}
 
-	return &courtMaps, nil
+// getBoundingBox is a helper function that returns the bounding box around the location,
+// it's used to pre-filter the vendors before calculating the exact distances.
+//
+// latitude: The latitude of the location.
+// longitude: The longitude of the location.
+// radiusKm: The radius in kilometers.
+//
+// Returns the bounding box.
+func getBoundingBox(latitude float64, longitude float64, radiusKm float64) *types.BoundingBox {
+	// Get the angular radius
+	angularRadius := radiusKm / constants.EARTH_RADIUS_KM
+
+	// Get the latitude delta in degrees
+	deltaLatitude := angularRadius * 180 / math.Pi
+
+	// Create the bounding box covering every longitude
+	box := &types.BoundingBox{
+		MinLatitude:  math.Max(latitude-deltaLatitude, -90),
+		MaxLatitude:  math.Min(latitude+deltaLatitude, 90),
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+
+	// Keep every longitude if the box reaches a pole
+	if box.MinLatitude <= -90 || box.MaxLatitude >= 90 {
+		return box
+	}
+
+	// Get the longitude delta in degrees
+	deltaLongitude := math.Asin(math.Sin(angularRadius)/math.Cos(latitude*math.Pi/180)) * 180 / math.Pi
+
+	// Keep every longitude if the box crosses the antimeridian
+	if longitude-deltaLongitude < -180 || longitude+deltaLongitude > 180 {
+		return box
+	}
+
+	box.MinLongitude = longitude - deltaLongitude
+	box.MaxLongitude = longitude + deltaLongitude
+
+	return box
+}
+
+// GetCourts is a function that returns a page of the courts catalogue.
+//
+// query: The courts query dto.
+//
+// Returns the courts, the next cursor or nil on the last page, and an error if any.
+func (c *CourtUseCase) GetCourts(query *dto.CourtsQueryDTO) (*[]types.CourtMap, *types.Cursor, error) {
+	// Create the courts filter
+	filter := &types.CourtsFilter{
+		CourtTypes: query.GetCourtTypes(),
+		Search:     strings.TrimSpace(query.Search),
+		MinPrice:   query.MinPrice,
+		MaxPrice:   query.MaxPrice,
+		MinRating:  query.MinRating,
+		OpenAt:     query.OpenAt,
+		OpenOn:     today(),
+		Latitude:   query.Latitude,
+		Longitude:  query.Longitude,
+		RadiusKm:   constants.DEFAULT_NEARBY_RADIUS_KM,
+		Sort:       "rating",
+		Limit:      constants.DEFAULT_PAGE_LIMIT,
+	}
+
+	// Set the sort order
+	if query.Sort != "" {
+		filter.Sort = query.Sort
+	}
+
+	// Set the sort direction, each sort order has its own default direction
+	filter.Descending = constants.COURT_SORT_ORDERS[filter.Sort]
+
+	if query.Order != "" {
+		filter.Descending = query.Order == "desc"
+	}
+
+	// Set the page limit
+	if query.Limit != nil {
+		filter.Limit = *query.Limit
+	}
+
+	// Set the search radius
+	if query.RadiusKm != nil {
+		filter.RadiusKm = *query.RadiusKm
+	}
+
+	// Set the bounding box if the courts are searched near a location
+	if query.Latitude != nil && query.Longitude != nil {
+		filter.Box = getBoundingBox(*query.Latitude, *query.Longitude, filter.RadiusKm)
+	}
+
+	// Decode the cursor
+	cursor, err := decodeCourtsCursor(query)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter.Cursor = cursor
+
+	return c.CourtRepository.GetCatalogue(filter)
 }
 
 // GetVendorCourtsUsingCourtType is a function that returns the vendor courts with the given court type.
@@ -166,8 +359,10 @@ func (c *CourtUseCase) ValidateCreateNewCourtForm(form *dto.CreateNewCourtFormDT
 	}
 
 	// Check if the court image is blank
-	if utils.IsBlank(form.CourtImage) {
+	if utils.IsBlank(form.CourtImage) && form.CourtImageFile == nil {
 		errs["courts_image"] = append(errs["courts_image"], "Court image is required")
+	} else if err := c.UploadUseCase.ValidateFormImage(form.CourtImage, form.CourtImageFile); !utils.IsBlank(err) {
+		errs["courts_image"] = append(errs["courts_image"], err)
 	}
 
 	// Return the errors if any
@@ -189,67 +384,65 @@ func (c *CourtUseCase) CreateNewCourt(token *jwt.Token, courtType string, form *
 	// Get the token claims
 	claims := c.AuthUseCase.DecodeToken(token)
 
-	// Check if the court already exist
-	exist, err := c.CourtRepository.CheckExistUsingVendorIDCourtType(claims.Id, courtType)
+	// Get the court type
+	courtTypeRecord, err := c.CourtTypeRepository.GetUsingType(courtType)
 
 	// Return an error if any
 	if err != nil {
 		return nil, &entities.ProcessError{
 			ClientError: false,
-			Message:     "An error occured while checking court exist in this court type",
+			Message:     "An error occured while getting the court type",
 		}
 	}
 
-	// Check if the court is nil
-	if exist {
+	// Return an error if the court type is retired
+	if courtTypeRecord.RetiredAt != nil {
 		return nil, &entities.ProcessError{
 			ClientError: true,
-			Message:     "Vendor already has a court in this court type, use POST /vendor/me/courts/types/:type instead",
+			Message:     "Court type has been retired, new courts can't be created in this court type",
 		}
 	}
 
-	// Decode the image
-	fileBytes, err := base64.StdEncoding.DecodeString(form.CourtImage)
+	// Check if the court already exist
+	exist, err := c.CourtRepository.CheckExistUsingVendorIDCourtType(claims.Id, courtType)
 
 	// Return an error if any
 	if err != nil {
-		log.Println("Failed to decode court image: ", err)
-
 		return nil, &entities.ProcessError{
 			ClientError: false,
-			Message:     "An error occured while decoding court image",
+			Message:     "An error occured while checking court exist in this court type",
 		}
 	}
 
-	// Get the court type ID
-	courtTypeId := enums.GetCourtTypeID(courtType)
-
-	// Create the court image name
-	courtImageName := fmt.Sprintf("court_%d_%d.jpg", claims.Id, courtTypeId)
-
-	// Create the court image name path
-	courtImageNamePath := fmt.Sprintf("%s/%s", constants.PATH_TO_COURT_IMAGES, courtImageName)
+	// Check if the court is nil
+	if exist {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Vendor already has a court in this court type, use POST /vendor/me/courts/types/:type instead",
+		}
+	}
 
-	// Write the image to a file
-	err = os.WriteFile(courtImageNamePath, fileBytes, 0644)
+	// Save the court image
+	courtImageName, processErr := c.UploadUseCase.SaveFormImage(form.CourtImage, form.CourtImageFile, constants.PATH_TO_COURT_IMAGES)
 
 	// Return an error if any
-	if err != nil {
-		log.Println("Failed to save court image: ", err)
-
-		return nil, &entities.ProcessError{
-			ClientError: false,
-			Message:     "An error occured while saving court image",
-		}
+	if processErr != nil {
+		return nil, processErr
 	}
 
+	// Get the court type ID
+	courtTypeId := courtTypeRecord.ID
+
 	// Create a new court object
 	court := &models.Court{
 		VendorID:    claims.Id,
-		CourtTypeID: uint(courtTypeId),
+		CourtTypeID: courtTypeId,
 		Name:        "Court 1",
 		Price:       form.PricePerHour,
 		Image:       courtImageName,
+		CourtTypeLinks: []models.CourtTypeLink{
+			{CourtTypeID: courtTypeId, CourtType: *courtTypeRecord},
+		},
 	}
 
 	// Return an error if any
@@ -257,16 +450,8 @@ func (c *CourtUseCase) CreateNewCourt(token *jwt.Token, courtType string, form *
 
 	// Return an error if any
 	if err != nil {
-		// Remove the court image
-		err = os.Remove(courtImageNamePath)
-
-		// Return an error if any
-		if err != nil {
-			return nil, &entities.ProcessError{
-				ClientError: false,
-				Message:     "An error occured while creating a new court and removing the court image",
-			}
-		}
+		// Remove the saved court image if it's not used
+		removeCourtImageFile(c.CourtRepository, c.UploadUseCase, courtImageName)
 
 		return nil, &entities.ProcessError{
 			ClientError: false,
@@ -277,6 +462,27 @@ func (c *CourtUseCase) CreateNewCourt(token *jwt.Token, courtType string, form *
 	return court, nil
 }
 
+// removeCourtImageFile is a helper function that removes the stored files of the court image.
+// Files are content hashed, so a file is kept while another court still uses it.
+//
+// c: The court repository.
+// up: The upload use case.
+// image: The court image file name.
+//
+// Returns nothing.
+func removeCourtImageFile(c *repository.CourtRepository, up *UploadUseCase, image string) {
+	// Get the count of the courts using the file
+	count, err := c.GetCountUsingImage(image)
+
+	// Keep the file if it's still used or the count is unknown
+	if err != nil || count > 0 {
+		return
+	}
+
+	// Remove the court image files
+	up.RemoveImage(constants.PATH_TO_COURT_IMAGES, image)
+}
+
 // AddCourt is a function that adds a new court.
 //
 // token: The token.
@@ -317,6 +523,14 @@ func (c *CourtUseCase) AddCourt(token *jwt.Token, courtType string) (*models.Cou
 		}
 	}
 
+	// Return an error if the court type is retired
+	if court.CourtType.RetiredAt != nil {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Court type has been retired, new courts can't be added in this court type",
+		}
+	}
+
 	// Create new court name
 	courtName := "Court "
 
@@ -338,11 +552,15 @@ func (c *CourtUseCase) AddCourt(token *jwt.Token, courtType string) (*models.Cou
 
 	// Create a new court object
 	newCourt := &models.Court{
-		VendorID:  claims.Id,
-		CourtType: court.CourtType,
-		Name:      courtName,
-		Price:     court.Price,
-		Image:     court.Image,
+		VendorID:    claims.Id,
+		CourtTypeID: court.CourtType.ID,
+		CourtType:   court.CourtType,
+		Name:        courtName,
+		Price:       court.Price,
+		Image:       court.Image,
+		CourtTypeLinks: []models.CourtTypeLink{
+			{CourtTypeID: court.CourtType.ID, CourtType: court.CourtType},
+		},
 	}
 
 	// Create the new court
@@ -363,13 +581,31 @@ func (c *CourtUseCase) AddCourt(token *jwt.Token, courtType string) (*models.Cou
 //
 // token: The token.
 //
-// Returns the vendor court count as map and an error if any.
-func (c *CourtUseCase) GetCurrentVendorCourtStats(token *jwt.Token) (*types.CourtCountsMap, error) {
+// Returns the vendor court stats as map and an error if any.
+func (c *CourtUseCase) GetCurrentVendorCourtStats(token *jwt.Token) (*types.CourtStatsMap, error) {
 	// Get the token claims
 	claims := c.AuthUseCase.DecodeToken(token)
 
 	// Get the court counts
-	return c.CourtRepository.GetCountsUsingVendorID(claims.Id)
+	courtCounts, err := c.CourtRepository.GetCountsUsingVendorID(claims.Id)
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the physical court count, since a court can be counted in several court types
+	totalCourtCount, err := c.CourtRepository.GetTotalCountUsingVendorID(claims.Id)
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CourtStatsMap{
+		"total_court_count": &totalCourtCount,
+		"court_counts":      courtCounts,
+	}, nil
 }
 
 // ValidateUpdateCourtForm is a function to validate the update court form.
@@ -433,5 +669,197 @@ func (c *CourtUseCase) DeleteCourts(token *jwt.Token, data *dto.DeleteCourtsDTO)
 	claims := c.AuthUseCase.DecodeToken(token)
 
 	// Delete the courts
-	return c.CourtRepository.DeleteUsingCourtIDsVendorID(data.CourtIDs, claims.Id)
+	return c.DeleteVendorCourts(claims.Id, data)
+}
+
+// GetVendorCourts is a function to get every court of the vendor.
+//
+// vendorID: The vendor ID
+//
+// Returns the vendor courts and an error if any
+func (c *CourtUseCase) GetVendorCourts(vendorID uint) (*[]models.Court, error) {
+	return c.CourtRepository.GetUsingVendorID(vendorID)
+}
+
+// DeleteVendorCourts is a function to delete the courts of the vendor.
+//
+// vendorID: The vendor ID
+// data: The delete courts dto
+//
+// Returns error if any
+func (c *CourtUseCase) DeleteVendorCourts(vendorID uint, data *dto.DeleteCourtsDTO) error {
+	// Get the gallery images of the courts before they are deleted with the courts
+	images, err := c.GalleryImageRepository.GetUsingCourtIDsVendorID(data.CourtIDs, vendorID)
+
+	// Return an error if any
+	if err != nil {
+		return err
+	}
+
+	// Delete the courts
+	err = c.CourtRepository.DeleteUsingCourtIDsVendorID(data.CourtIDs, vendorID)
+
+	// Return an error if any
+	if err != nil {
+		return err
+	}
+
+	// Remove the gallery image files of the deleted courts
+	removeGalleryImageFiles(c.GalleryImageRepository, c.UploadUseCase, images)
+
+	return nil
+}
+
+// ValidateCourtLinks is a function to validate the court links data.
+//
+// data: The court links dto
+//
+// Returns an error message if any
+func (c *CourtUseCase) ValidateCourtLinks(data *dto.CourtLinksDTO) string {
+	// Check if the court IDs is empty
+	if len(data.CourtIDs) == 0 {
+		return "Court IDs is required"
+	}
+
+	return ""
+}
+
+// getCurrentVendorCourtsToLink is a helper function that gets the court type
+// and the current vendor courts to be linked or unlinked.
+//
+// vendorID: The vendor ID
+// courtType: The court type
+// courtIDs: The court IDs
+//
+// Returns the court type, the courts and an error if any
+func (c *CourtUseCase) getCurrentVendorCourtsToLink(vendorID uint, courtType string, courtIDs []uint) (*models.CourtType, *[]models.Court, *entities.ProcessError) {
+	// Get the court type
+	courtTypeRecord, err := c.CourtTypeRepository.GetUsingType(courtType)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occured while getting the court type",
+		}
+	}
+
+	// Get the vendor courts
+	courts, err := c.CourtRepository.GetUsingIDsVendorID(courtIDs, vendorID)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occured while getting the courts",
+		}
+	}
+
+	// Return an error if some of the courts don't belong to the vendor
+	if len(*courts) != len(courtIDs) {
+		return nil, nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Some of the courts are not found",
+		}
+	}
+
+	return courtTypeRecord, courts, nil
+}
+
+// LinkCourts is a function to link the current vendor courts to a court type,
+// so the courts can also be booked as the court type.
+//
+// token: The jwt token
+// courtType: The court type
+// data: The court links dto
+//
+// Returns error if any
+func (c *CourtUseCase) LinkCourts(token *jwt.Token, courtType string, data *dto.CourtLinksDTO) *entities.ProcessError {
+	// Get the token claims
+	claims := c.AuthUseCase.DecodeToken(token)
+
+	// Get the court type and the courts
+	courtTypeRecord, courts, processErr := c.getCurrentVendorCourtsToLink(claims.Id, courtType, data.CourtIDs)
+
+	// Return an error if any
+	if processErr != nil {
+		return processErr
+	}
+
+	// Return an error if the court type is retired
+	if courtTypeRecord.RetiredAt != nil {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Court type has been retired, courts can't be linked to this court type",
+		}
+	}
+
+	// Create the court type links
+	links := make([]models.CourtTypeLink, len(*courts))
+
+	// Loop through the courts
+	for i, court := range *courts {
+		links[i] = models.CourtTypeLink{
+			CourtID:     court.ID,
+			CourtTypeID: courtTypeRecord.ID,
+		}
+	}
+
+	// Link the courts
+	err := c.CourtTypeLinkRepository.Create(&links)
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occured while linking the courts",
+		}
+	}
+
+	return nil
+}
+
+// UnlinkCourts is a function to unlink the current vendor courts from a court type.
+// Courts can't be unlinked from the court type they were created in.
+//
+// token: The jwt token
+// courtType: The court type
+// data: The court links dto
+//
+// Returns error if any
+func (c *CourtUseCase) UnlinkCourts(token *jwt.Token, courtType string, data *dto.CourtLinksDTO) *entities.ProcessError {
+	// Get the token claims
+	claims := c.AuthUseCase.DecodeToken(token)
+
+	// Get the court type and the courts
+	courtTypeRecord, courts, processErr := c.getCurrentVendorCourtsToLink(claims.Id, courtType, data.CourtIDs)
+
+	// Return an error if any
+	if processErr != nil {
+		return processErr
+	}
+
+	// Loop through the courts
+	for _, court := range *courts {
+		// Return an error if the court type is the court primary court type
+		if court.CourtTypeID == courtTypeRecord.ID {
+			return &entities.ProcessError{
+				ClientError: true,
+				Message:     "Courts can't be unlinked from the court type they were created in, use DELETE /vendors/me/courts instead",
+			}
+		}
+	}
+
+	// Unlink the courts
+	err := c.CourtTypeLinkRepository.DeleteUsingCourtIDsCourtTypeID(data.CourtIDs, courtTypeRecord.ID)
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occured while unlinking the courts",
+		}
+	}
+
+	return nil
 }
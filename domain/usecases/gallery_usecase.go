@@ -0,0 +1,510 @@
+package usecases
+
+import (
+	"fmt"
+	"main/core/constants"
+	"main/core/types"
+	"main/data/models"
+	"main/domain/entities"
+	"main/internal/dto"
+	"main/internal/repository"
+	"main/pkg/utils"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+// GalleryUseCase is a struct that defines the use case for the court and venue gallery.
+type GalleryUseCase struct {
+	AuthUseCase            *AuthUseCase
+	GalleryImageRepository *repository.GalleryImageRepository
+	CourtRepository        *repository.CourtRepository
+	UploadUseCase          *UploadUseCase
+}
+
+// NewGalleryUseCase is a factory function that returns a new instance of the GalleryUseCase struct.
+//
+// a: The auth use case.
+// g: The gallery image repository.
+// c: The court repository.
+// up: The upload use case.
+//
+// Returns a new instance of the GalleryUseCase.
+func NewGalleryUseCase(a *AuthUseCase, g *repository.GalleryImageRepository, c *repository.CourtRepository, up *UploadUseCase) *GalleryUseCase {
+	return &GalleryUseCase{
+		AuthUseCase:            a,
+		GalleryImageRepository: g,
+		CourtRepository:        c,
+		UploadUseCase:          up,
+	}
+}
+
+// removeGalleryImageFiles is a helper function that removes the stored files of the gallery images.
+// Files are content hashed, so a file is kept while another gallery image still uses it.
+//
+// g: The gallery image repository.
+// up: The upload use case.
+// images: The gallery images.
+//
+// Returns nothing.
+func removeGalleryImageFiles(g *repository.GalleryImageRepository, up *UploadUseCase, images *[]models.GalleryImage) {
+	// Loop through the gallery images
+	for _, image := range *images {
+		// Get the count of the gallery images using the file
+		count, err := g.GetCountUsingImage(image.Image)
+
+		// Keep the file if it's still used or the count is unknown
+		if err != nil || count > 0 {
+			continue
+		}
+
+		// Remove the gallery image files
+		up.RemoveImage(constants.PATH_TO_GALLERY_IMAGES, image.Image)
+	}
+}
+
+// checkCourt is a helper function that checks if the court belongs to the vendor,
+// a nil court ID refers to the venue gallery.
+//
+// vendorID: The vendor ID.
+// courtID: The court ID.
+//
+// Returns an error if any.
+func (g *GalleryUseCase) checkCourt(vendorID uint, courtID *uint) *entities.ProcessError {
+	// Skip the check for the venue gallery
+	if courtID == nil {
+		return nil
+	}
+
+	// Get the court
+	courts, err := g.CourtRepository.GetUsingIDsVendorID([]uint{*courtID}, vendorID)
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the court",
+		}
+	}
+
+	// Return an error if the court is not found
+	if len(*courts) == 0 {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Court not found",
+		}
+	}
+
+	return nil
+}
+
+// GetCurrentVendorGalleryImages is a function that returns the current vendor gallery images
+// of a court, or the venue gallery images when the court ID is nil.
+//
+// token: The JWT token.
+// courtID: The court ID.
+//
+// Returns the gallery images and an error if any.
+func (g *GalleryUseCase) GetCurrentVendorGalleryImages(token *jwt.Token, courtID *uint) (*[]models.GalleryImage, *entities.ProcessError) {
+	// Get the token claims
+	claims := g.AuthUseCase.DecodeToken(token)
+
+	// Check the court
+	processErr := g.checkCourt(claims.Id, courtID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Get the gallery images
+	images, err := g.GalleryImageRepository.GetUsingVendorIDCourtID(claims.Id, courtID)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the gallery images",
+		}
+	}
+
+	return images, nil
+}
+
+// ValidateCreateGalleryImageForm is a function that validates the create gallery image form.
+//
+// form: The create gallery image form dto.
+//
+// Returns the form error response message.
+func (g *GalleryUseCase) ValidateCreateGalleryImageForm(form *dto.CreateGalleryImageFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if the image is blank
+	if utils.IsBlank(form.Image) && form.ImageFile == nil {
+		errs["image"] = append(errs["image"], "Image is required")
+	}
+
+	// Check if the caption is too long
+	if len(form.Caption) > 255 {
+		errs["caption"] = append(errs["caption"], "Caption must be at most 255 characters")
+	}
+
+	// Check if theres any error
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// CreateGalleryImage is a function that uploads a new gallery image for the current vendor.
+// The first image of a gallery becomes the cover image.
+//
+// token: The JWT token.
+// form: The create gallery image form dto.
+//
+// Returns the gallery image and an error if any.
+func (g *GalleryUseCase) CreateGalleryImage(token *jwt.Token, form *dto.CreateGalleryImageFormDTO) (*models.GalleryImage, *entities.ProcessError) {
+	// Get the token claims
+	claims := g.AuthUseCase.DecodeToken(token)
+
+	// Check the court
+	processErr := g.checkCourt(claims.Id, form.CourtID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Get the gallery images count
+	count, err := g.GalleryImageRepository.GetCountUsingVendorIDCourtID(claims.Id, form.CourtID)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while counting the gallery images",
+		}
+	}
+
+	// Return an error if the gallery is full
+	if count >= int64(constants.MAX_GALLERY_IMAGES) {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     fmt.Sprintf("Gallery can't have more than %d images", constants.MAX_GALLERY_IMAGES),
+		}
+	}
+
+	// Get the last position of the gallery
+	position, err := g.GalleryImageRepository.GetLastPositionUsingVendorIDCourtID(claims.Id, form.CourtID)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the gallery images position",
+		}
+	}
+
+	// Save the gallery image
+	imageName, processErr := g.UploadUseCase.SaveFormImage(form.Image, form.ImageFile, constants.PATH_TO_GALLERY_IMAGES)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Create a new gallery image object
+	image := &models.GalleryImage{
+		VendorID: claims.Id,
+		CourtID:  form.CourtID,
+		Image:    imageName,
+		Caption:  form.Caption,
+		Position: position + 1,
+		IsCover:  count == 0,
+	}
+
+	// Create the gallery image
+	err = g.GalleryImageRepository.Create(image)
+
+	// Return an error if any
+	if err != nil {
+		// Remove the gallery image file
+		removeGalleryImageFiles(g.GalleryImageRepository, g.UploadUseCase, &[]models.GalleryImage{*image})
+
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while creating the gallery image",
+		}
+	}
+
+	// Set the gallery image as the cover image if requested
+	if form.IsCover && !image.IsCover {
+		err = g.GalleryImageRepository.UpdateCoverUsingID(image)
+
+		// Return an error if any
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while setting the cover image",
+			}
+		}
+
+		image.IsCover = true
+	}
+
+	return image, nil
+}
+
+// getGalleryImage is a helper function that gets the current vendor gallery image.
+//
+// vendorID: The vendor ID.
+// imageID: The gallery image ID.
+//
+// Returns the gallery image and an error if any.
+func (g *GalleryUseCase) getGalleryImage(vendorID uint, imageID uint) (*models.GalleryImage, *entities.ProcessError) {
+	// Get the gallery image
+	image, err := g.GalleryImageRepository.GetUsingIDVendorID(imageID, vendorID)
+
+	// Return an error if the gallery image is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Gallery image not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the gallery image",
+		}
+	}
+
+	return image, nil
+}
+
+// ValidateUpdateGalleryImageForm is a function that validates the update gallery image form.
+//
+// form: The update gallery image form dto.
+//
+// Returns a string of error.
+func (g *GalleryUseCase) ValidateUpdateGalleryImageForm(form *dto.UpdateGalleryImageFormDTO) string {
+	// Check if there is nothing to update
+	if form.Caption == nil && form.IsCover == nil {
+		return "Caption or is cover is required"
+	}
+
+	// Check if the caption is too long
+	if form.Caption != nil && len(*form.Caption) > 255 {
+		return "Caption must be at most 255 characters"
+	}
+
+	return ""
+}
+
+// UpdateGalleryImage is a function that updates the current vendor gallery image.
+//
+// token: The JWT token.
+// imageID: The gallery image ID.
+// form: The update gallery image form dto.
+//
+// Returns the gallery image and an error if any.
+func (g *GalleryUseCase) UpdateGalleryImage(token *jwt.Token, imageID uint, form *dto.UpdateGalleryImageFormDTO) (*models.GalleryImage, *entities.ProcessError) {
+	// Get the token claims
+	claims := g.AuthUseCase.DecodeToken(token)
+
+	// Get the gallery image
+	image, processErr := g.getGalleryImage(claims.Id, imageID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Return an error if the cover image is unset, since a gallery always has a cover image
+	if form.IsCover != nil && !*form.IsCover && image.IsCover {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Gallery must have a cover image, set another image as the cover instead",
+		}
+	}
+
+	// Update the caption
+	if form.Caption != nil {
+		err := g.GalleryImageRepository.UpdateCaptionUsingID(image.ID, *form.Caption)
+
+		// Return an error if any
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while updating the gallery image caption",
+			}
+		}
+
+		image.Caption = *form.Caption
+	}
+
+	// Set the gallery image as the cover image
+	if form.IsCover != nil && *form.IsCover && !image.IsCover {
+		err := g.GalleryImageRepository.UpdateCoverUsingID(image)
+
+		// Return an error if any
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while setting the cover image",
+			}
+		}
+
+		image.IsCover = true
+	}
+
+	return image, nil
+}
+
+// ValidateReorderGalleryImages is a function that validates the reorder gallery images data.
+//
+// data: The reorder gallery images dto.
+//
+// Returns a string of error.
+func (g *GalleryUseCase) ValidateReorderGalleryImages(data *dto.ReorderGalleryImagesDTO) string {
+	// Check if the image IDs is empty
+	if len(data.ImageIDs) == 0 {
+		return "Image IDs is required"
+	}
+
+	return ""
+}
+
+// ReorderGalleryImages is a function that reorders the current vendor gallery images
+// of a court or the venue.
+//
+// token: The JWT token.
+// data: The reorder gallery images dto.
+//
+// Returns the reordered gallery images and an error if any.
+func (g *GalleryUseCase) ReorderGalleryImages(token *jwt.Token, data *dto.ReorderGalleryImagesDTO) (*[]models.GalleryImage, *entities.ProcessError) {
+	// Get the current gallery images
+	images, processErr := g.GetCurrentVendorGalleryImages(token, data.CourtID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Create a set of the gallery image IDs
+	imageIDs := make(map[uint]bool)
+
+	// Loop through the gallery images
+	for _, image := range *images {
+		imageIDs[image.ID] = true
+	}
+
+	// Check every gallery image is given exactly once
+	for _, imageID := range data.ImageIDs {
+		// Return an error if the image is unknown or duplicated
+		if !imageIDs[imageID] {
+			return nil, &entities.ProcessError{
+				ClientError: true,
+				Message:     "Image IDs must contain every image of the gallery exactly once",
+			}
+		}
+
+		delete(imageIDs, imageID)
+	}
+
+	// Return an error if some of the images are missing
+	if len(imageIDs) > 0 {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Image IDs must contain every image of the gallery exactly once",
+		}
+	}
+
+	// Get the token claims
+	claims := g.AuthUseCase.DecodeToken(token)
+
+	// Update the gallery images position
+	err := g.GalleryImageRepository.UpdatePositionsUsingIDs(data.ImageIDs, claims.Id, data.CourtID)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while reordering the gallery images",
+		}
+	}
+
+	return g.GetCurrentVendorGalleryImages(token, data.CourtID)
+}
+
+// DeleteGalleryImage is a function that deletes the current vendor gallery image and its stored file.
+// The next image of the gallery becomes the cover image when the cover image is deleted.
+//
+// token: The JWT token.
+// imageID: The gallery image ID.
+//
+// Returns an error if any.
+func (g *GalleryUseCase) DeleteGalleryImage(token *jwt.Token, imageID uint) *entities.ProcessError {
+	// Get the token claims
+	claims := g.AuthUseCase.DecodeToken(token)
+
+	// Get the gallery image
+	image, processErr := g.getGalleryImage(claims.Id, imageID)
+
+	// Return an error if any
+	if processErr != nil {
+		return processErr
+	}
+
+	// Delete the gallery image
+	err := g.GalleryImageRepository.DeleteUsingID(image.ID)
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while deleting the gallery image",
+		}
+	}
+
+	// Remove the gallery image file
+	removeGalleryImageFiles(g.GalleryImageRepository, g.UploadUseCase, &[]models.GalleryImage{*image})
+
+	// Return if the deleted image is not the cover image
+	if !image.IsCover {
+		return nil
+	}
+
+	// Get the remaining gallery images
+	images, err := g.GalleryImageRepository.GetUsingVendorIDCourtID(claims.Id, image.CourtID)
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the gallery images",
+		}
+	}
+
+	// Return if the gallery is empty
+	if len(*images) == 0 {
+		return nil
+	}
+
+	// Set the next image as the cover image
+	err = g.GalleryImageRepository.UpdateCoverUsingID(&(*images)[0])
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while setting the cover image",
+		}
+	}
+
+	return nil
+}
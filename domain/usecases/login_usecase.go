@@ -1,32 +1,42 @@
 package usecases
 
 import (
+	"main/core/enums"
 	"main/core/types"
 	"main/data/models"
 	"main/domain/entities"
 	"main/internal/dto"
 	"main/internal/repository"
 	"main/pkg/utils"
+
+	"gorm.io/gorm"
 )
 
 // LoginUseCase is a struct that defines the login use case.
 type LoginUseCase struct {
-	AuthUseCase     *AuthUseCase
-	UserRepository  *repository.UserRepository
-	VendorRepositoy *repository.VendorRepository
+	AuthUseCase           *AuthUseCase
+	UserRepository        *repository.UserRepository
+	VendorRepositoy       *repository.VendorRepository
+	VendorStaffRepository *repository.VendorStaffRepository
+	AdminRepository       *repository.AdminRepository
 }
 
 // NewLoginUseCase is a factory function that returns a new instance of the LoginUseCase.
 //
 // a: The auth use case.
 // u: The user repository.
+// v: The vendor repository.
+// s: The vendor staff repository.
+// ad: The admin repository.
 //
 // Returns a new instance of the LoginUseCase.
-func NewLoginUseCase(a *AuthUseCase, u *repository.UserRepository, v *repository.VendorRepository) *LoginUseCase {
+func NewLoginUseCase(a *AuthUseCase, u *repository.UserRepository, v *repository.VendorRepository, s *repository.VendorStaffRepository, ad *repository.AdminRepository) *LoginUseCase {
 	return &LoginUseCase{
-		AuthUseCase:     a,
-		UserRepository:  u,
-		VendorRepositoy: v,
+		AuthUseCase:           a,
+		UserRepository:        u,
+		VendorRepositoy:       v,
+		VendorStaffRepository: s,
+		AdminRepository:       ad,
 	}
 }
 
@@ -84,6 +94,33 @@ func (l LoginUseCase) ValidateVendorForm(form *dto.VendorLoginFormDTO) types.For
 	return nil
 }
 
+// ValidateAdminForm is a function that validates the admin login form.
+//
+// form: The login form data.
+//
+// Returns a map of errors.
+func (l LoginUseCase) ValidateAdminForm(form *dto.AdminLoginFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if the username is blank
+	if utils.IsBlank(form.Username) {
+		errs["username"] = append(errs["username"], "Username is required")
+	}
+
+	// Check if the password is blank
+	if form.Password == "" {
+		errs["password"] = append(errs["password"], "Password is required")
+	}
+
+	// Return the errors if any
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
 // Process is a function that processes the user login form.
 //
 // form: The login form data.
@@ -132,6 +169,14 @@ func (l LoginUseCase) ProcessUser(form *dto.UserLoginForm) (*models.User, *entit
 		}
 	}
 
+	// Return an error if the user is disabled
+	if user.DisabledAt != nil {
+		return nil, &entities.ProcessError{
+			Message:     "User is disabled",
+			ClientError: true,
+		}
+	}
+
 	return user, nil
 }
 
@@ -183,5 +228,118 @@ func (l LoginUseCase) ProcessVendor(form *dto.VendorLoginFormDTO) (*models.Vendo
 		}
 	}
 
+	// Return an error if the vendor registration is waiting for approval
+	if vendor.Status == enums.VendorPending.Label() {
+		return nil, &entities.ProcessError{
+			Message:     "Vendor registration is waiting for approval",
+			ClientError: true,
+		}
+	}
+
+	// Return an error if the vendor registration is rejected
+	if vendor.Status == enums.VendorRejected.Label() {
+		return nil, &entities.ProcessError{
+			Message:     "Vendor registration is rejected: " + vendor.RejectionReason,
+			ClientError: true,
+		}
+	}
+
+	// Return an error if the vendor is suspended
+	if vendor.Status == enums.VendorSuspended.Label() {
+		return nil, &entities.ProcessError{
+			Message:     "Vendor is suspended: " + vendor.RejectionReason,
+			ClientError: true,
+		}
+	}
+
 	return vendor, nil
 }
+
+// ProcessVendorStaff is a function that processes the vendor staff login form,
+// the staff can only sign in to an approved vendor.
+//
+// form: The login form data.
+//
+// Returns the vendor staff object along with its vendor and an error if any.
+func (l LoginUseCase) ProcessVendorStaff(form *dto.VendorLoginFormDTO) (*models.VendorStaff, *entities.ProcessError) {
+	// Get the vendor staff by email
+	staff, err := l.VendorStaffRepository.GetUsingEmail(form.Email)
+
+	// Return an error if the email does not exist
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			Message: types.FormErrorResponseMsg{
+				"email": []string{"Email does not exist"},
+			},
+			ClientError: true,
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			Message:     "An error occurred while getting the staff using the email",
+			ClientError: false,
+		}
+	}
+
+	// Check if the password is correct
+	if !l.AuthUseCase.VerifyPassword(form.Password, staff.Password) {
+		return nil, &entities.ProcessError{
+			Message: types.FormErrorResponseMsg{
+				"password": []string{"Password is incorrect"},
+			},
+			ClientError: true,
+		}
+	}
+
+	// Return an error if the vendor is not approved
+	if staff.Vendor.Status != enums.VendorApproved.Label() {
+		return nil, &entities.ProcessError{
+			Message:     "Vendor is not approved",
+			ClientError: true,
+		}
+	}
+
+	return staff, nil
+}
+
+// ProcessAdmin is a function that processes the admin login form.
+//
+// form: The login form data.
+//
+// Returns the admin object and an error if any.
+func (l LoginUseCase) ProcessAdmin(form *dto.AdminLoginFormDTO) (*models.Admin, *entities.ProcessError) {
+	// Get the admin by username
+	admin, err := l.AdminRepository.GetUsingUsername(form.Username)
+
+	// Return an error if the username does not exist
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			Message: types.FormErrorResponseMsg{
+				"username": []string{"Username does not exist"},
+			},
+			ClientError: true,
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			Message:     "An error occurred while getting the admin using the username",
+			ClientError: false,
+		}
+	}
+
+	// Check if the password is correct
+	if !l.AuthUseCase.VerifyPassword(form.Password, admin.Password) {
+		return nil, &entities.ProcessError{
+			Message: types.FormErrorResponseMsg{
+				"password": []string{"Password is incorrect"},
+			},
+			ClientError: true,
+		}
+	}
+
+	return admin, nil
+}
@@ -0,0 +1,147 @@
+package usecases
+
+import (
+	"main/core/enums"
+	"main/core/types"
+	"main/data/models"
+	"main/domain/entities"
+	"main/internal/repository"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// NotificationUseCase is a struct that defines the notification use case.
+type NotificationUseCase struct {
+	AuthUseCase            *AuthUseCase
+	NotificationRepository *repository.NotificationRepository
+}
+
+// NewNotificationUseCase is a factory function that returns a new instance of the NotificationUseCase.
+//
+// a: The auth use case.
+// n: The notification repository.
+//
+// Returns a new instance of the NotificationUseCase.
+func NewNotificationUseCase(a *AuthUseCase, n *repository.NotificationRepository) *NotificationUseCase {
+	return &NotificationUseCase{
+		AuthUseCase:            a,
+		NotificationRepository: n,
+	}
+}
+
+// Notify is a use case function that sends a notification to the user.
+//
+// userID: The user ID.
+// notificationType: The notification type.
+// message: The notification message.
+// referenceID: The id of the data the notification is about, nil if there is none.
+//
+// Returns an error if any.
+func (n *NotificationUseCase) Notify(userID uint, notificationType enums.NotificationType, message string, referenceID *uint) *entities.ProcessError {
+	// Create a new notification
+	notification := models.Notification{
+		UserID:      userID,
+		Type:        notificationType.Label(),
+		Message:     message,
+		ReferenceID: referenceID,
+	}
+
+	// Save the notification
+	if err := n.NotificationRepository.Create(&notification); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while creating the notification",
+		}
+	}
+
+	return nil
+}
+
+// GetCurrentUserNotifications is a use case function that returns a page of the current
+// user notifications, newest first.
+//
+// token: The user token.
+// page: The page of the notifications.
+//
+// Returns the count of the unread notifications, the notifications, the next cursor
+// or nil on the last page, and an error if any.
+func (n *NotificationUseCase) GetCurrentUserNotifications(token *jwt.Token, page *types.Page) (int64, *[]models.Notification, *types.Cursor, *entities.ProcessError) {
+	// Get the token claims
+	claims := n.AuthUseCase.DecodeToken(token)
+
+	// Get the count of the unread notifications
+	unread, err := n.NotificationRepository.GetUnreadCountUsingUserID(claims.Id)
+
+	// Return an error if any
+	if err != nil {
+		return 0, nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the unread notifications count",
+		}
+	}
+
+	// Get the notifications
+	notifications, next, err := n.NotificationRepository.GetUsingUserID(claims.Id, page)
+
+	// Return an error if any
+	if err != nil {
+		return 0, nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the notifications",
+		}
+	}
+
+	return unread, notifications, next, nil
+}
+
+// ReadNotification is a use case function that marks the current user notification as read.
+//
+// token: The user token.
+// notificationID: The notification ID.
+//
+// Returns an error if any.
+func (n *NotificationUseCase) ReadNotification(token *jwt.Token, notificationID uint) *entities.ProcessError {
+	// Get the token claims
+	claims := n.AuthUseCase.DecodeToken(token)
+
+	// Mark the notification as read
+	found, err := n.NotificationRepository.MarkRead(claims.Id, notificationID)
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while reading the notification",
+		}
+	}
+
+	// Return an error if the notification is not found
+	if !found {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Notification not found",
+		}
+	}
+
+	return nil
+}
+
+// ReadAllNotifications is a use case function that marks every current user notification as read.
+//
+// token: The user token.
+//
+// Returns an error if any.
+func (n *NotificationUseCase) ReadAllNotifications(token *jwt.Token) *entities.ProcessError {
+	// Get the token claims
+	claims := n.AuthUseCase.DecodeToken(token)
+
+	// Mark the notifications as read
+	if err := n.NotificationRepository.MarkAllRead(claims.Id); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while reading the notifications",
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 	"main/core/constants"
+	"main/core/enums"
 	"main/core/shared"
 	"main/core/types"
 	"main/data/models"
@@ -12,6 +13,8 @@ import (
 	"main/internal/providers/mysql"
 	"main/internal/repository"
 	"main/pkg/utils"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -21,10 +24,12 @@ import (
 
 // OrderUseCase is a struct that defines the OrderUseCase
 type OrderUseCase struct {
-	AuthUseCase       *AuthUseCase
-	OrderRepository   *repository.OrderRepository
-	BookingRepository *repository.BookingRepository
-	CourtRepository   *repository.CourtRepository
+	AuthUseCase         *AuthUseCase
+	OrderRepository     *repository.OrderRepository
+	BookingRepository   *repository.BookingRepository
+	CourtRepository     *repository.CourtRepository
+	CourtTypeRepository *repository.CourtTypeRepository
+	ScheduleUseCase     *ScheduleUseCase
 }
 
 // NewOrderUseCase is a function that returns a new OrderUseCase
@@ -33,14 +38,18 @@ type OrderUseCase struct {
 // o: The OrderRepository
 // b: The BookingRepository
 // c: The CourtRepository
+// t: The CourtTypeRepository
+// s: The ScheduleUseCase
 //
 // Returns a pointer to the OrderUseCase struct
-func NewOrderUseCase(a *AuthUseCase, o *repository.OrderRepository, b *repository.BookingRepository, c *repository.CourtRepository) *OrderUseCase {
+func NewOrderUseCase(a *AuthUseCase, o *repository.OrderRepository, b *repository.BookingRepository, c *repository.CourtRepository, t *repository.CourtTypeRepository, s *ScheduleUseCase) *OrderUseCase {
 	return &OrderUseCase{
-		AuthUseCase:       a,
-		OrderRepository:   o,
-		BookingRepository: b,
-		CourtRepository:   c,
+		AuthUseCase:         a,
+		OrderRepository:     o,
+		BookingRepository:   b,
+		CourtRepository:     c,
+		CourtTypeRepository: t,
+		ScheduleUseCase:     s,
 	}
 }
 
@@ -48,40 +57,42 @@ func NewOrderUseCase(a *AuthUseCase, o *repository.OrderRepository, b *repositor
 //
 // token: The JWT token.
 // courtType: The court type.
+// page: The page of the orders.
 //
-// Returns the orders and an error if any.
-func (o *OrderUseCase) GetCurrentUserOrders(token *jwt.Token, courtType *string) (*[]models.Order, error) {
+// Returns the orders, the next cursor or nil on the last page, and an error if any.
+func (o *OrderUseCase) GetCurrentUserOrders(token *jwt.Token, courtType *string, page *types.Page) (*[]models.Order, *types.Cursor, error) {
 	// Get the user ID from the JWT
 	claims := o.AuthUseCase.DecodeToken(token)
 
 	// Check if the court type is not empty
 	if courtType != nil && utils.IsBlank(*courtType) {
 		// Get the orders using the user ID
-		return o.OrderRepository.GetUsingUserID(claims.Id)
+		return o.OrderRepository.GetUsingUserID(claims.Id, page)
 	}
 
 	// Get the orders using the user ID
-	return o.OrderRepository.GetUsingUserIDCourtType(claims.Id, *courtType)
+	return o.OrderRepository.GetUsingUserIDCourtType(claims.Id, *courtType, page)
 }
 
 // GetCurrentVendorOrders is a method that gets the current vendor order from the database.
 //
 // token: The JWT token.
 // courtType: The court type.
+// page: The page of the orders.
 //
-// Returns the orders and an error if any.
-func (o *OrderUseCase) GetCurrentVendorOrders(token *jwt.Token, courtType *string) (*[]models.Order, error) {
+// Returns the orders, the next cursor or nil on the last page, and an error if any.
+func (o *OrderUseCase) GetCurrentVendorOrders(token *jwt.Token, courtType *string, page *types.Page) (*[]models.Order, *types.Cursor, error) {
 	// Get the user ID from the JWT
 	claims := o.AuthUseCase.DecodeToken(token)
 
 	// Check if the court type is not empty
 	if courtType != nil && utils.IsBlank(*courtType) {
 		// Get the orders using the user ID
-		return o.OrderRepository.GetUsingVendorID(claims.Id)
+		return o.OrderRepository.GetUsingVendorID(claims.Id, page)
 	}
 
 	// Get the orders using the user ID
-	return o.OrderRepository.GetUsingVendorIDCourtType(claims.Id, *courtType)
+	return o.OrderRepository.GetUsingVendorIDCourtType(claims.Id, *courtType, page)
 }
 
 // GetCurrentUserOrderDetail is a method that gets the current user order detail from the database.
@@ -112,8 +123,8 @@ func (o *OrderUseCase) GetCurrentUserOrderDetail(token *jwt.Token, orderID uint)
 	// Get the user ID from the JWT
 	claims := o.AuthUseCase.DecodeToken(token)
 
-	// Return an error if the order is not belongs to the user
-	if order.Bookings[0].UserID != claims.Id {
+	// Return an error if the order is not belongs to the user, walk-in orders have no user
+	if userID := order.Bookings[0].UserID; userID == nil || *userID != claims.Id {
 		return nil, &entities.ProcessError{
 			ClientError: true,
 			Message:     "This order is not belongs to this user",
@@ -178,11 +189,36 @@ func (o *OrderUseCase) ValidateCreateOrder(data dto.CreateOrderDTO) string {
 		return "Date is required"
 	}
 
+	// Parse the date
+	parsedDate, err := time.Parse("2006-01-02", data.Date)
+
+	// Return an error if any
+	if err != nil {
+		return "Invalid date format"
+	}
+
 	// Check if the bookings is empty
 	if data.Bookings == nil {
 		return "Bookings is required"
 	}
 
+	// Get the opening hours of the date
+	hours, err := o.ScheduleUseCase.GetOpeningHoursUsingVendorIDDate(data.VendorID, parsedDate)
+
+	// Return an error if any
+	if err == gorm.ErrRecordNotFound {
+		return "Vendor not found"
+	}
+
+	if err != nil {
+		return "Failed to get opening hours"
+	}
+
+	// Return an error if the vendor is closed on the date
+	if hours.IsClosed {
+		return "Vendor is closed on this date"
+	}
+
 	// Loop through the bookings
 	for _, booking := range *data.Bookings {
 		// Check if the court ID is empty
@@ -195,6 +231,21 @@ func (o *OrderUseCase) ValidateCreateOrder(data dto.CreateOrderDTO) string {
 			return "Book time is required"
 		}
 
+		// Check if the court can be booked as the court type
+		if !utils.IsBlank(data.CourtType) {
+			linked, err := o.CourtRepository.CheckLinkedUsingIDCourtType(booking.CourtID, data.CourtType)
+
+			// Return an error if any
+			if err != nil {
+				return "Failed to check court type"
+			}
+
+			// Return an error if the court is not linked to the court type
+			if !linked {
+				return "Court can't be booked as this court type"
+			}
+		}
+
 		// Loop through the book time
 		for _, bookTime := range booking.BookTime {
 			// Check if the book time is empty
@@ -202,6 +253,19 @@ func (o *OrderUseCase) ValidateCreateOrder(data dto.CreateOrderDTO) string {
 				return "Book time is required"
 			}
 
+			// Parse the book time
+			parsedTime, err := time.Parse("15:04", bookTime)
+
+			// Return an error if any
+			if err != nil {
+				return "Invalid time format"
+			}
+
+			// Check if the vendor is open for the whole booking
+			if !o.ScheduleUseCase.IsOpenBetween(hours, parsedTime, parsedTime.Add(time.Hour)) {
+				return "Vendor is closed at this time"
+			}
+
 			// Check if the book time is valid
 			available, err := o.BookingRepository.CheckAvailability(booking.CourtID, data.Date, bookTime)
 
@@ -261,15 +325,11 @@ func (o *OrderUseCase) CreateOrder(token *jwt.Token, data dto.CreateOrderDTO) (*
 		}
 	}
 
-	// Create a context with a cancel function
-	_, cancel := context.WithCancel(context.Background())
-
-	// Defer the cancel function
-	defer cancel()
-
-	// Create a wait group
+	// Create a wait group and the mutex shared by the goroutines, the transaction
+	// and the process error can only be used by one goroutine at a time
 	var (
 		wg         sync.WaitGroup
+		mu         sync.Mutex
 		processErr *entities.ProcessError
 	)
 
@@ -284,6 +344,36 @@ func (o *OrderUseCase) CreateOrder(token *jwt.Token, data dto.CreateOrderDTO) (*
 		}
 	}
 
+	// Return an error if the vendor of the court is suspended
+	if court.Vendor.Status != enums.VendorApproved.Label() {
+		// Rollback the transaction
+		tx.Rollback()
+
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Vendor is not available",
+		}
+	}
+
+	// Get the court type the courts are booked as, default to the court type
+	// the court was created in
+	courtTypeID := court.CourtTypeID
+
+	// Check if the court type is given
+	if !utils.IsBlank(data.CourtType) {
+		courtType, err := o.CourtTypeRepository.GetUsingType(data.CourtType)
+
+		// Return an error if any
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "Failed to get court type",
+			}
+		}
+
+		courtTypeID = courtType.ID
+	}
+
 	// Get the total bookings
 	totalBookings := 0
 
@@ -361,6 +451,15 @@ func (o *OrderUseCase) CreateOrder(token *jwt.Token, data dto.CreateOrderDTO) (*
 
 					parsedTime, e := time.Parse("15:04", bookTime)
 
+					// Lock the transaction and the process error
+					mu.Lock()
+					defer mu.Unlock()
+
+					// Return if another booking has failed
+					if processErr != nil {
+						return
+					}
+
 					// Return an error if any
 					if e != nil {
 						// Set the process error
@@ -369,35 +468,23 @@ func (o *OrderUseCase) CreateOrder(token *jwt.Token, data dto.CreateOrderDTO) (*
 							Message:     "Invalid time format",
 						}
 
-						// Rollback the transaction
-						tx.Rollback()
-
-						cancel()
-
 						return
 					}
 
 					// Create a new booking
 					book := models.Booking{
-						UserID:        claims.Id,
+						UserID:        &claims.Id,
 						VendorID:      data.VendorID,
 						OrderID:       order.ID,
 						CourtID:       booking.CourtID,
+						CourtTypeID:   &courtTypeID,
 						Date:          shared.DateOnly{Time: parsedDate},
 						BookStartTime: shared.TimeOnly{Time: parsedTime},
 						BookEndTime:   shared.TimeOnly{Time: parsedTime.Add(time.Hour)},
 					}
 
-					// Return an error if any
-					e = func() error {
-						mu := sync.Mutex{}
-
-						// Lock the database
-						mu.Lock()
-						defer mu.Unlock()
-
-						return o.BookingRepository.Create(tx, &book)
-					}()
+					// Create the booking
+					e = o.BookingRepository.Create(tx, &book)
 
 					// Return an error if any
 					if e != nil {
@@ -406,13 +493,6 @@ func (o *OrderUseCase) CreateOrder(token *jwt.Token, data dto.CreateOrderDTO) (*
 							ClientError: false,
 							Message:     "Failed to create booking",
 						}
-
-						// Rollback the transaction
-						tx.Rollback()
-
-						cancel()
-
-						return
 					}
 				}(bookTime)
 			}
@@ -427,6 +507,9 @@ func (o *OrderUseCase) CreateOrder(token *jwt.Token, data dto.CreateOrderDTO) (*
 
 	// Return an error if any
 	if processErr != nil {
+		// Rollback the transaction
+		tx.Rollback()
+
 		return nil, processErr
 	}
 
@@ -441,6 +524,199 @@ func (o *OrderUseCase) CreateOrder(token *jwt.Token, data dto.CreateOrderDTO) (*
 	return paymentToken, nil
 }
 
+// ValidateCreateWalkInOrder is a use case that validates the create walk-in order data,
+// the courts are checked the same way as the orders made by the users.
+//
+// token: The JWT token
+// data: The walk-in order data
+//
+// Returns an error message if any
+func (o *OrderUseCase) ValidateCreateWalkInOrder(token *jwt.Token, data *dto.CreateWalkInOrderDTO) string {
+	// Get the token claims
+	claims := o.AuthUseCase.DecodeToken(token)
+
+	// Book the courts of the current vendor
+	data.VendorID = claims.Id
+
+	// Check if the customer name is empty
+	if utils.IsBlank(data.CustomerName) {
+		return "Customer name is required"
+	}
+
+	// Check if the customer name is too long
+	if len(strings.TrimSpace(data.CustomerName)) > 255 {
+		return "Customer name must be at most 255 characters"
+	}
+
+	// Validate the order data
+	if errMsg := o.ValidateCreateOrder(data.CreateOrderDTO); !utils.IsBlank(errMsg) {
+		return errMsg
+	}
+
+	// Get the court IDs without the duplicates
+	courtIDs := []uint{}
+
+	for _, booking := range *data.Bookings {
+		if !slices.Contains(courtIDs, booking.CourtID) {
+			courtIDs = append(courtIDs, booking.CourtID)
+		}
+	}
+
+	// Get the courts of the current vendor
+	courts, err := o.CourtRepository.GetUsingIDsVendorID(courtIDs, claims.Id)
+
+	// Return an error if any
+	if err != nil {
+		return "Failed to get courts"
+	}
+
+	// Return an error if any court doesn't belong to the current vendor
+	if len(*courts) != len(courtIDs) {
+		return "Court not found"
+	}
+
+	return ""
+}
+
+// CreateWalkInOrder is a use case that creates an order for a walk-in customer
+// by the vendor, the order is paid at the venue so it's created as paid and
+// without the app fee.
+//
+// token: The JWT token
+// data: The walk-in order data
+//
+// Returns the order and an error if any
+func (o *OrderUseCase) CreateWalkInOrder(token *jwt.Token, data *dto.CreateWalkInOrderDTO) (*models.Order, *entities.ProcessError) {
+	// Parse the date
+	parsedDate, err := time.Parse("2006-01-02", data.Date)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Invalid date format",
+		}
+	}
+
+	// Get the court
+	court, err := o.CourtRepository.GetUsingID((*data.Bookings)[0].CourtID)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "Failed to get court",
+		}
+	}
+
+	// Get the court type the courts are booked as, default to the court type
+	// the court was created in
+	courtTypeID := court.CourtTypeID
+
+	// Check if the court type is given
+	if !utils.IsBlank(data.CourtType) {
+		courtType, err := o.CourtTypeRepository.GetUsingType(data.CourtType)
+
+		// Return an error if any
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "Failed to get court type",
+			}
+		}
+
+		courtTypeID = courtType.ID
+	}
+
+	// Get the total bookings
+	totalBookings := 0
+
+	for _, booking := range *data.Bookings {
+		totalBookings += len(booking.BookTime)
+	}
+
+	// Get the customer name
+	customerName := strings.TrimSpace(data.CustomerName)
+
+	// Create the paid order for the bookings
+	order := models.Order{
+		Price:        court.Price * float64(totalBookings),
+		AppFee:       0,
+		Status:       enums.Success.Label(),
+		CustomerName: &customerName,
+	}
+
+	// Begin a transaction
+	tx := mysql.Conn.Begin()
+
+	// Return an error if any
+	if tx.Error != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "Failed to begin transaction",
+		}
+	}
+
+	// Create the order
+	err = o.OrderRepository.Create(tx, &order)
+
+	// Return an error if any
+	if err != nil {
+		// Rollback the transaction
+		tx.Rollback()
+
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "Failed to create order",
+		}
+	}
+
+	// Loop through the bookings
+	for _, booking := range *data.Bookings {
+		// Loop through the booking times
+		for _, bookTime := range booking.BookTime {
+			// Parse the book time, it's already validated
+			parsedTime, _ := time.Parse("15:04", bookTime)
+
+			// Create a new booking without a user
+			book := models.Booking{
+				VendorID:      data.VendorID,
+				OrderID:       order.ID,
+				CourtID:       booking.CourtID,
+				CourtTypeID:   &courtTypeID,
+				Date:          shared.DateOnly{Time: parsedDate},
+				BookStartTime: shared.TimeOnly{Time: parsedTime},
+				BookEndTime:   shared.TimeOnly{Time: parsedTime.Add(time.Hour)},
+			}
+
+			// Create the booking
+			err = o.BookingRepository.Create(tx, &book)
+
+			// Return an error if any
+			if err != nil {
+				// Rollback the transaction
+				tx.Rollback()
+
+				return nil, &entities.ProcessError{
+					ClientError: false,
+					Message:     "Failed to create booking",
+				}
+			}
+		}
+	}
+
+	// Return an error if any
+	if err := tx.Commit().Error; err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "Failed to commit transaction",
+		}
+	}
+
+	// Get the created order with its bookings
+	return o.GetCurrentVendorOrderDetail(token, order.ID)
+}
+
 // GetCurrentVendorOrdersStats is a use case that gets the current vendor orders
 // statistics from the database.
 //
@@ -551,3 +827,69 @@ func (o *OrderUseCase) GetCurrentVendorOrdersStats(token *jwt.Token) (*types.Ord
 
 	return &stats, nil
 }
+
+// GetPaymentStatus is a function that returns the payment status label of the status,
+// the status is matched case insensitively.
+//
+// status: The payment status.
+//
+// Returns the payment status label and whether the status is valid.
+func (o *OrderUseCase) GetPaymentStatus(status string) (string, bool) {
+	// Loop through the payment statuses
+	for _, paymentStatus := range []enums.PaymentStatus{enums.Success, enums.Pending, enums.Canceled} {
+		if strings.EqualFold(status, paymentStatus.Label()) {
+			return paymentStatus.Label(), true
+		}
+	}
+
+	return "", false
+}
+
+// GetOrders is a function that returns a page of the orders of every user.
+//
+// status: The payment status label, empty for every status.
+// page: The page of the orders.
+//
+// Returns the orders, the next cursor or nil on the last page, and an error if any.
+func (o *OrderUseCase) GetOrders(status string, page *types.Page) (*[]models.Order, *types.Cursor, *entities.ProcessError) {
+	// Get the orders
+	orders, next, err := o.OrderRepository.Get(status, page)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the orders",
+		}
+	}
+
+	return orders, next, nil
+}
+
+// GetOrderDetail is a function that returns the order detail of any user.
+//
+// orderID: The order ID.
+//
+// Returns the order and an error if any.
+func (o *OrderUseCase) GetOrderDetail(orderID uint) (*models.Order, *entities.ProcessError) {
+	// Get the order
+	order, err := o.OrderRepository.GetUsingID(orderID)
+
+	// Return an error if the order is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Order not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the order",
+		}
+	}
+
+	return order, nil
+}
@@ -0,0 +1,66 @@
+package usecases
+
+import (
+	"fmt"
+	"main/core/constants"
+	"main/core/types"
+	"main/internal/dto"
+	"main/pkg/utils"
+)
+
+// PaginationUseCase is a struct that defines the use case for the cursor pagination
+// of the list endpoints.
+type PaginationUseCase struct{}
+
+// NewPaginationUseCase is a factory function that returns a new instance of the PaginationUseCase struct.
+//
+// Returns a new instance of the PaginationUseCase.
+func NewPaginationUseCase() *PaginationUseCase {
+	return &PaginationUseCase{}
+}
+
+// ValidatePaginationQuery is a function that validates the pagination query parameters.
+//
+// query: The pagination query dto.
+//
+// Returns a string of error.
+func (p *PaginationUseCase) ValidatePaginationQuery(query *dto.PaginationQueryDTO) string {
+	// Check if the limit is valid
+	if query.Limit != nil && (*query.Limit < 1 || *query.Limit > constants.MAX_PAGE_LIMIT) {
+		return fmt.Sprintf("Limit must be between 1 and %d", constants.MAX_PAGE_LIMIT)
+	}
+
+	// Check if the cursor is valid
+	if query.Cursor != "" {
+		if _, err := utils.DecodeCursor(query.Cursor); err != nil {
+			return "Invalid cursor"
+		}
+	}
+
+	return ""
+}
+
+// GetPage is a function that returns the page of the pagination query parameters,
+// the query parameters should be validated first.
+//
+// query: The pagination query dto.
+//
+// Returns the page.
+func (p *PaginationUseCase) GetPage(query *dto.PaginationQueryDTO) *types.Page {
+	// Create the page with the default limit
+	page := &types.Page{
+		Limit: constants.DEFAULT_PAGE_LIMIT,
+	}
+
+	// Set the page limit
+	if query.Limit != nil {
+		page.Limit = *query.Limit
+	}
+
+	// Set the page cursor
+	if query.Cursor != "" {
+		page.Cursor, _ = utils.DecodeCursor(query.Cursor)
+	}
+
+	return page
+}
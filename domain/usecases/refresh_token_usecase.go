@@ -0,0 +1,350 @@
+package usecases
+
+import (
+	"main/core/config"
+	"main/core/enums"
+	"main/data/models"
+	"main/domain/entities"
+	"main/internal/dto"
+	"main/internal/repository"
+	"main/pkg/utils"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+// RefreshTokenUseCase is a struct that defines the refresh token use case.
+type RefreshTokenUseCase struct {
+	AuthUseCase            *AuthUseCase
+	RefreshTokenRepository *repository.RefreshTokenRepository
+	UserRepository         *repository.UserRepository
+	VendorRepository       *repository.VendorRepository
+	VendorStaffRepository  *repository.VendorStaffRepository
+	AdminRepository        *repository.AdminRepository
+}
+
+// NewRefreshTokenUseCase is a factory function that returns a new instance of the RefreshTokenUseCase.
+//
+// a: The auth use case.
+// r: The refresh token repository.
+// u: The user repository.
+// v: The vendor repository.
+// s: The vendor staff repository.
+// ad: The admin repository.
+//
+// Returns a new instance of the RefreshTokenUseCase.
+func NewRefreshTokenUseCase(a *AuthUseCase, r *repository.RefreshTokenRepository, u *repository.UserRepository, v *repository.VendorRepository, s *repository.VendorStaffRepository, ad *repository.AdminRepository) *RefreshTokenUseCase {
+	return &RefreshTokenUseCase{
+		AuthUseCase:            a,
+		RefreshTokenRepository: r,
+		UserRepository:         u,
+		VendorRepository:       v,
+		VendorStaffRepository:  s,
+		AdminRepository:        ad,
+	}
+}
+
+// GenerateTokens is a use case that generates a JWT access token and a refresh token
+// of a new token family for the client that logs in.
+//
+// id: The id of the client, the vendor id for a vendor staff.
+// clientType: The client type.
+// staffID: The id of the vendor staff, 0 when the client isn't a vendor staff.
+//
+// Returns the access token, the refresh token and an error if any.
+func (r *RefreshTokenUseCase) GenerateTokens(id uint, clientType enums.ClientType, staffID uint) (string, string, error) {
+	// Generate the id of the token family
+	familyID, err := r.AuthUseCase.GenerateRandomToken()
+
+	// Return an error if any
+	if err != nil {
+		return "", "", err
+	}
+
+	// Create a new refresh token object
+	token := &models.RefreshToken{
+		FamilyID:   familyID,
+		ClientID:   id,
+		ClientType: int(clientType),
+	}
+
+	// Set the vendor staff of the token
+	if staffID != 0 {
+		token.StaffID = &staffID
+	}
+
+	// Generate the refresh token
+	refreshToken, err := r.prepareRefreshToken(token)
+
+	// Return an error if any
+	if err != nil {
+		return "", "", err
+	}
+
+	// Create the refresh token
+	if err := r.RefreshTokenRepository.Create(token); err != nil {
+		return "", "", err
+	}
+
+	// Generate the access token
+	accessToken, err := r.generateAccessToken(token)
+
+	// Return an error if any
+	if err != nil {
+		return "", "", err
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// ValidateRefreshTokenForm is a use case that validates the refresh token form.
+//
+// form: The refresh token form.
+//
+// Returns an error message if the form is invalid.
+func (r *RefreshTokenUseCase) ValidateRefreshTokenForm(form *dto.RefreshTokenFormDTO) string {
+	// Remove the leading and trailing spaces
+	form.RefreshToken = strings.TrimSpace(form.RefreshToken)
+
+	// Check if the refresh token is blank
+	if utils.IsBlank(form.RefreshToken) {
+		return "Refresh token is required"
+	}
+
+	return ""
+}
+
+// ProcessRefreshToken is a use case that rotates the refresh token to a new refresh token of
+// the same family and generates a new JWT access token. A used refresh token is a sign that
+// the token is stolen, the whole family is revoked when it's used again. The family is also
+// revoked when the account can no longer log in.
+//
+// form: The refresh token form.
+//
+// Returns the access token, the refresh token and an error if any.
+func (r *RefreshTokenUseCase) ProcessRefreshToken(form *dto.RefreshTokenFormDTO) (string, string, *entities.ProcessError) {
+	// Get the refresh token by the token hash
+	token, err := r.RefreshTokenRepository.GetUsingTokenHash(r.AuthUseCase.HashRandomToken(form.RefreshToken))
+
+	// Return an error if the token is unknown, expired or revoked
+	if err == gorm.ErrRecordNotFound || (err == nil && (token.ExpiresAt.Before(time.Now()) || token.RevokedAt != nil)) {
+		return "", "", &entities.ProcessError{
+			ClientError: true,
+			Message:     "Invalid or expired refresh token",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return "", "", &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the refresh token",
+		}
+	}
+
+	// Check if the account can still log in
+	if processErr := r.checkAccount(token); processErr != nil {
+		// Revoke the token family when the account can't log in
+		if processErr.ClientError {
+			if err := r.RefreshTokenRepository.RevokeFamily(token.FamilyID); err != nil {
+				return "", "", &entities.ProcessError{
+					ClientError: false,
+					Message:     "An error occurred while revoking the refresh tokens",
+				}
+			}
+		}
+
+		return "", "", processErr
+	}
+
+	// Create the next refresh token of the family
+	next := &models.RefreshToken{
+		FamilyID:   token.FamilyID,
+		ClientID:   token.ClientID,
+		ClientType: token.ClientType,
+		StaffID:    token.StaffID,
+	}
+
+	// Generate the next refresh token
+	refreshToken, err := r.prepareRefreshToken(next)
+
+	// Return an error if any
+	if err != nil {
+		return "", "", &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while generating the refresh token",
+		}
+	}
+
+	// Rotate the refresh token, the token is already used when it's not rotated
+	rotated := false
+
+	if token.UsedAt == nil {
+		rotated, err = r.RefreshTokenRepository.Rotate(token, next)
+
+		// Return an error if any
+		if err != nil {
+			return "", "", &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while rotating the refresh token",
+			}
+		}
+	}
+
+	// Revoke the token family when the token is reused
+	if !rotated {
+		if err := r.RefreshTokenRepository.RevokeFamily(token.FamilyID); err != nil {
+			return "", "", &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while revoking the refresh tokens",
+			}
+		}
+
+		return "", "", &entities.ProcessError{
+			ClientError: true,
+			Message:     "Refresh token has already been used, please log in again",
+		}
+	}
+
+	// Generate the access token
+	accessToken, err := r.generateAccessToken(next)
+
+	// Return an error if any
+	if err != nil {
+		return "", "", &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while generating the token",
+		}
+	}
+
+	return accessToken, refreshToken, nil
+}
+
+// RevokeTokenFamily is a use case that revokes the refresh token family of the JWT access token,
+// the tokens issued before the refresh tokens have no family and are skipped.
+//
+// token: The JWT access token.
+//
+// Returns an error if any.
+func (r *RefreshTokenUseCase) RevokeTokenFamily(token *jwt.Token) error {
+	// Get the token claims
+	claims := r.AuthUseCase.DecodeToken(token)
+
+	// Skip the token without a family
+	if utils.IsBlank(claims.FamilyID) {
+		return nil
+	}
+
+	return r.RefreshTokenRepository.RevokeFamily(claims.FamilyID)
+}
+
+// checkAccount is a helper function that checks if the account of the refresh token can
+// still log in, the account may have been disabled, suspended or deleted after the login.
+//
+// token: The refresh token object.
+//
+// Returns an error if the account can't log in.
+func (r *RefreshTokenUseCase) checkAccount(token *models.RefreshToken) *entities.ProcessError {
+	var err error
+
+	switch enums.ClientType(token.ClientType) {
+	case enums.User:
+		var user *models.User
+
+		// Get the user by ID
+		user, err = r.UserRepository.GetUsingID(token.ClientID)
+
+		// Return an error if the user is disabled
+		if err == nil && user.DisabledAt != nil {
+			return &entities.ProcessError{
+				ClientError: true,
+				Message:     "User is disabled",
+			}
+		}
+	case enums.Vendor:
+		var vendor *models.Vendor
+
+		// Get the vendor status by ID
+		vendor, err = r.VendorRepository.GetStatusUsingID(token.ClientID)
+
+		// Return an error if the vendor is suspended
+		if err == nil && vendor.Status == enums.VendorSuspended.Label() {
+			return &entities.ProcessError{
+				ClientError: true,
+				Message:     "Vendor is suspended: " + vendor.RejectionReason,
+			}
+		}
+
+		// Return an error if the vendor is not approved
+		if err == nil && vendor.Status != enums.VendorApproved.Label() {
+			return &entities.ProcessError{
+				ClientError: true,
+				Message:     "Vendor is not approved",
+			}
+		}
+
+		// Check if the vendor staff still exists
+		if err == nil && token.StaffID != nil {
+			_, err = r.VendorStaffRepository.GetUsingID(token.ClientID, *token.StaffID)
+		}
+	case enums.Admin:
+		// Check if the admin still exists
+		_, err = r.AdminRepository.GetUsingID(token.ClientID)
+	}
+
+	// Return an error if the account is deleted
+	if err == gorm.ErrRecordNotFound {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Account not found, please log in again",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the account",
+		}
+	}
+
+	return nil
+}
+
+// prepareRefreshToken is a helper function that generates a random refresh token
+// and sets its hash and expiry on the refresh token object.
+//
+// token: The refresh token object.
+//
+// Returns the refresh token and an error if any.
+func (r *RefreshTokenUseCase) prepareRefreshToken(token *models.RefreshToken) (string, error) {
+	// Generate the refresh token
+	refreshToken, err := r.AuthUseCase.GenerateRandomToken()
+
+	// Return an error if any
+	if err != nil {
+		return "", err
+	}
+
+	token.TokenHash = r.AuthUseCase.HashRandomToken(refreshToken)
+	token.ExpiresAt = time.Now().Add(config.JWTConfig.RefreshTokenExpiry)
+
+	return refreshToken, nil
+}
+
+// generateAccessToken is a helper function that generates the JWT access token
+// of the client of the refresh token.
+//
+// token: The refresh token object.
+//
+// Returns the access token and an error if any.
+func (r *RefreshTokenUseCase) generateAccessToken(token *models.RefreshToken) (string, error) {
+	// Generate a staff token for the vendor staff
+	if token.StaffID != nil {
+		return r.AuthUseCase.GenerateStaffToken(token.ClientID, *token.StaffID, token.FamilyID)
+	}
+
+	return r.AuthUseCase.GenerateToken(token.ClientID, enums.ClientType(token.ClientType), token.FamilyID)
+}
@@ -3,31 +3,39 @@ package usecases
 import (
 	"fmt"
 	"main/core/constants"
+	"main/core/enums"
 	"main/core/types"
 	"main/data/models"
 	"main/domain/entities"
 	"main/internal/dto"
 	"main/internal/repository"
 	"main/pkg/utils"
+	"sort"
 	"strings"
 )
 
 // RegisterUseCase is a struct that defines the register use case.
 type RegisterUseCase struct {
-	AuthUseCase    *AuthUseCase
-	UserRepository *repository.UserRepository
+	AuthUseCase      *AuthUseCase
+	UserRepository   *repository.UserRepository
+	VendorRepository *repository.VendorRepository
+	UploadUseCase    *UploadUseCase
 }
 
 // NewRegisterUseCase is a factory function that returns a new instance of the RegisterUseCase.
 //
 // a: The auth use case.
 // u: The user repository.
+// v: The vendor repository.
+// up: The upload use case.
 //
 // Returns a new instance of the RegisterUseCase.
-func NewRegisterUseCase(a *AuthUseCase, u *repository.UserRepository) *RegisterUseCase {
+func NewRegisterUseCase(a *AuthUseCase, u *repository.UserRepository, v *repository.VendorRepository, up *UploadUseCase) *RegisterUseCase {
 	return &RegisterUseCase{
-		AuthUseCase:    a,
-		UserRepository: u,
+		AuthUseCase:      a,
+		UserRepository:   u,
+		VendorRepository: v,
+		UploadUseCase:    up,
 	}
 }
 
@@ -170,3 +178,284 @@ func (r *RegisterUseCase) CreateNewUser(form *dto.UserRegisterFormDTO) (*models.
 
 	return &user, nil
 }
+
+// SanitizeVendorRegisterForm is a helper function that sanitizes the vendor register input.
+//
+// form: The vendor register form dto.
+//
+// Returns void
+func (r RegisterUseCase) SanitizeVendorRegisterForm(form *dto.VendorRegisterFormDTO) {
+	form.Name = strings.TrimSpace(form.Name)
+	form.Email = strings.TrimSpace(form.Email)
+	form.Address = strings.TrimSpace(form.Address)
+	form.OpenTime = strings.TrimSpace(form.OpenTime)
+	form.CloseTime = strings.TrimSpace(form.CloseTime)
+	form.BankName = strings.TrimSpace(form.BankName)
+	form.BankAccountHolder = strings.TrimSpace(form.BankAccountHolder)
+	form.BankAccountNumber = strings.ReplaceAll(strings.TrimSpace(form.BankAccountNumber), " ", "")
+}
+
+// ValidateVendorRegisterForm is a function that validates the vendor register form.
+//
+// form: The vendor register form dto.
+//
+// Returns a map of errors.
+func (r RegisterUseCase) ValidateVendorRegisterForm(form *dto.VendorRegisterFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if the name is blank
+	if utils.IsBlank(form.Name) {
+		errs["name"] = append(errs["name"], "Name is required")
+	}
+
+	// Check if the email is blank
+	if utils.IsBlank(form.Email) {
+		errs["email"] = append(errs["email"], "Email is required")
+	} else if !utils.IsValidEmail(form.Email) {
+		errs["email"] = append(errs["email"], "Email is invalid")
+	}
+
+	// Check if the password is too short
+	if len(form.Password) < constants.MINIMUM_PASSWORD_LENGTH {
+		errs["password"] = append(errs["password"], fmt.Sprintf("Password must be at least %d characters long", constants.MINIMUM_PASSWORD_LENGTH))
+	}
+
+	// Check if the password and confirm password are the same
+	if form.Password != form.ConfirmPassword {
+		errs["confirm_password"] = append(errs["confirm_password"], "Password and confirm password do not match")
+	}
+
+	// Check if the address is blank
+	if utils.IsBlank(form.Address) {
+		errs["address"] = append(errs["address"], "Address is required")
+	}
+
+	// Check if the location is incomplete
+	if (form.Latitude == nil) != (form.Longitude == nil) {
+		errs["location"] = append(errs["location"], "Latitude and longitude must be provided together")
+	}
+
+	// Check if the latitude is valid
+	if form.Latitude != nil && (*form.Latitude < -90 || *form.Latitude > 90) {
+		errs["latitude"] = append(errs["latitude"], "Latitude must be between -90 and 90")
+	}
+
+	// Check if the longitude is valid
+	if form.Longitude != nil && (*form.Longitude < -180 || *form.Longitude > 180) {
+		errs["longitude"] = append(errs["longitude"], "Longitude must be between -180 and 180")
+	}
+
+	// Check if the opening times are valid
+	if _, _, errMsg := parseOpeningTimes(form.OpenTime, form.CloseTime); !utils.IsBlank(errMsg) {
+		errs["time"] = append(errs["time"], errMsg)
+	}
+
+	// Check if the bank name is blank
+	if utils.IsBlank(form.BankName) {
+		errs["bank_name"] = append(errs["bank_name"], "Bank name is required")
+	}
+
+	// Check if the bank account holder is blank
+	if utils.IsBlank(form.BankAccountHolder) {
+		errs["bank_account_holder"] = append(errs["bank_account_holder"], "Bank account holder is required")
+	}
+
+	// Check if the bank account number is blank
+	if utils.IsBlank(form.BankAccountNumber) {
+		errs["bank_account_number"] = append(errs["bank_account_number"], "Bank account number is required")
+	} else if strings.Trim(form.BankAccountNumber, "0123456789") != "" {
+		errs["bank_account_number"] = append(errs["bank_account_number"], "Bank account number must only contain digits")
+	}
+
+	// Loop through the documents to check the unknown document types
+	for documentType := range form.Documents {
+		if _, ok := constants.VENDOR_DOCUMENT_TYPES[documentType]; !ok {
+			errs["documents"] = append(errs["documents"], fmt.Sprintf("Unknown document type %s", documentType))
+		}
+	}
+
+	// Sort the unknown document type errors, since the documents map is not ordered
+	sort.Strings(errs["documents"])
+
+	// Loop through the document types to check the required documents
+	for documentType, required := range constants.VENDOR_DOCUMENT_TYPES {
+		if required && utils.IsBlank(form.Documents[documentType]) && form.DocumentFiles[documentType] == nil {
+			errs[documentType] = append(errs[documentType], "Document is required")
+		}
+	}
+
+	// Check if there are any errors
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ProcessVendorRegister is a function that processes the vendor register form,
+// and registers the vendor waiting for the admin approval.
+//
+// form: The vendor register form dto.
+//
+// Returns the registered vendor and an error if any.
+func (r *RegisterUseCase) ProcessVendorRegister(form *dto.VendorRegisterFormDTO) (*models.Vendor, *entities.ProcessError) {
+	// Check if the name is taken
+	taken, err := r.VendorRepository.IsNameTaken(form.Name)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			Message:     "An error occurred while checking if the name is taken",
+			ClientError: false,
+		}
+	}
+
+	// Check if the name is taken
+	if taken {
+		return nil, &entities.ProcessError{
+			Message: types.FormErrorResponseMsg{
+				"name": []string{"Name is taken"},
+			},
+			ClientError: true,
+		}
+	}
+
+	// Check if the email is taken
+	taken, err = r.VendorRepository.IsEmailTaken(form.Email)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			Message:     "An error occurred while checking if the email is taken",
+			ClientError: false,
+		}
+	}
+
+	// Check if the email is taken
+	if taken {
+		return nil, &entities.ProcessError{
+			Message: types.FormErrorResponseMsg{
+				"email": []string{"Email is taken"},
+			},
+			ClientError: true,
+		}
+	}
+
+	// Create the documents slice
+	documents := []models.VendorDocument{}
+
+	// Get the sorted document types, so the documents are saved in the same order
+	documentTypes := make([]string, 0, len(constants.VENDOR_DOCUMENT_TYPES))
+
+	for documentType := range constants.VENDOR_DOCUMENT_TYPES {
+		documentTypes = append(documentTypes, documentType)
+	}
+
+	sort.Strings(documentTypes)
+
+	// Loop through the document types
+	for _, documentType := range documentTypes {
+		// Skip the document if it's not uploaded
+		if utils.IsBlank(form.Documents[documentType]) && form.DocumentFiles[documentType] == nil {
+			continue
+		}
+
+		// Validate the document
+		contentType, processErr := r.UploadUseCase.ValidateFormDocument(form.Documents[documentType], form.DocumentFiles[documentType])
+
+		// Return an error if any
+		if processErr != nil {
+			// Key the client error with the document type
+			if processErr.ClientError {
+				processErr.Message = types.FormErrorResponseMsg{
+					documentType: []string{processErr.Message.(string)},
+				}
+			}
+
+			return nil, processErr
+		}
+
+		documents = append(documents, models.VendorDocument{
+			Type:        documentType,
+			ContentType: contentType,
+		})
+	}
+
+	// Parse the opening times, the times are validated with the form
+	openTime, closeTime, _ := parseOpeningTimes(form.OpenTime, form.CloseTime)
+
+	// Hash the password
+	hashedPwd, err := r.AuthUseCase.HashPassword(form.Password)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			Message:     "An error occurred while registering the vendor",
+			ClientError: false,
+		}
+	}
+
+	// Loop through the documents to save them
+	for i := range documents {
+		// Save the document
+		documentName, processErr :=
+			r.UploadUseCase.SaveFormDocument(form.Documents[documents[i].Type], form.DocumentFiles[documents[i].Type], documents[i].ContentType, constants.PATH_TO_VENDOR_DOCUMENTS)
+
+		// Return an error if any
+		if processErr != nil {
+			r.removeDocuments(documents[:i])
+
+			return nil, processErr
+		}
+
+		documents[i].File = documentName
+	}
+
+	// Create a new vendor waiting for the approval
+	vendor := models.Vendor{
+		Name:      form.Name,
+		Address:   form.Address,
+		Latitude:  form.Latitude,
+		Longitude: form.Longitude,
+		Email:     form.Email,
+		Password:  hashedPwd,
+		OpenTime:  openTime,
+		CloseTime: closeTime,
+		Status:    enums.VendorPending.Label(),
+		Documents: documents,
+		BankAccount: &models.VendorBankAccount{
+			BankName:      form.BankName,
+			AccountHolder: form.BankAccountHolder,
+			AccountNumber: form.BankAccountNumber,
+		},
+	}
+
+	// Register the vendor along with its documents and bank account into the database
+	err = r.VendorRepository.Create(&vendor)
+
+	// Return an error if any
+	if err != nil {
+		r.removeDocuments(documents)
+
+		return nil, &entities.ProcessError{
+			Message:     "An error occurred while registering the vendor",
+			ClientError: false,
+		}
+	}
+
+	return &vendor, nil
+}
+
+// removeDocuments is a helper function that removes the saved documents of a
+// failed vendor registration from the storage.
+//
+// documents: The saved documents.
+//
+// Returns nothing.
+func (r *RegisterUseCase) removeDocuments(documents []models.VendorDocument) {
+	// Loop through the documents
+	for _, document := range documents {
+		r.UploadUseCase.RemoveDocument(constants.PATH_TO_VENDOR_DOCUMENTS, document.File)
+	}
+}
@@ -2,40 +2,78 @@ package usecases
 
 import (
 	"context"
+	"fmt"
+	"log"
+	"main/core/config"
+	"main/core/constants"
 	"main/core/enums"
 	"main/core/types"
 	"main/data/models"
 	"main/domain/entities"
 	"main/internal/dto"
+	"main/internal/providers/mysql"
 	"main/internal/repository"
+	"main/pkg/utils"
+	"mime/multipart"
+	"regexp"
 	"strings"
 	"sync"
+	"time"
+	"unicode/utf8"
 
 	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
 )
 
+// reviewWordPattern is the pattern of a word in the review checked by the word filter.
+var reviewWordPattern = regexp.MustCompile(`[\p{L}\p{N}]+`)
+
 // ReviewUseCase is a struct that defines the review use case.
 type ReviewUseCase struct {
-	AuthUseCase       *AuthUseCase
-	ReviewRepository  *repository.ReviewRepository
-	BookingRepository *repository.BookingRepository
-	CourtRepository   *repository.CourtRepository
+	AuthUseCase              *AuthUseCase
+	ReviewRepository         *repository.ReviewRepository
+	ReviewRevisionRepository *repository.ReviewRevisionRepository
+	ReviewReplyRepository    *repository.ReviewReplyRepository
+	ReviewReportRepository   *repository.ReviewReportRepository
+	ReviewImageRepository    *repository.ReviewImageRepository
+	ReviewVoteRepository     *repository.ReviewVoteRepository
+	BookingRepository        *repository.BookingRepository
+	CourtRepository          *repository.CourtRepository
+	CourtTypeRepository      *repository.CourtTypeRepository
+	NotificationUseCase      *NotificationUseCase
+	UploadUseCase            *UploadUseCase
 }
 
 // NewReviewUseCase is a factory function that returns a new instance of the ReviewUseCase.
 //
 // a: The auth use case.
 // r: The review repository.
+// v: The review revision repository.
+// p: The review reply repository.
+// o: The review report repository.
+// i: The review image repository.
+// e: The review vote repository.
 // b: The booking repository.
 // c: The court repository.
+// t: The court type repository.
+// n: The notification use case.
+// u: The upload use case.
 //
 // Returns a new instance of the ReviewUseCase.
-func NewReviewUseCase(a *AuthUseCase, r *repository.ReviewRepository, b *repository.BookingRepository, c *repository.CourtRepository) *ReviewUseCase {
+func NewReviewUseCase(a *AuthUseCase, r *repository.ReviewRepository, v *repository.ReviewRevisionRepository, p *repository.ReviewReplyRepository, o *repository.ReviewReportRepository, i *repository.ReviewImageRepository, e *repository.ReviewVoteRepository, b *repository.BookingRepository, c *repository.CourtRepository, t *repository.CourtTypeRepository, n *NotificationUseCase, u *UploadUseCase) *ReviewUseCase {
 	return &ReviewUseCase{
-		AuthUseCase:       a,
-		ReviewRepository:  r,
-		BookingRepository: b,
-		CourtRepository:   c,
+		AuthUseCase:              a,
+		ReviewRepository:         r,
+		ReviewRevisionRepository: v,
+		ReviewReplyRepository:    p,
+		ReviewReportRepository:   o,
+		ReviewImageRepository:    i,
+		ReviewVoteRepository:     e,
+		BookingRepository:        b,
+		CourtRepository:          c,
+		CourtTypeRepository:      t,
+		NotificationUseCase:      n,
+		UploadUseCase:            u,
 	}
 }
 
@@ -97,6 +135,29 @@ func (r *ReviewUseCase) ValidateRatingParam(rating int) bool {
 	return true
 }
 
+// ValidateReviewsSort is a use case that validates the sort order of the reviews
+// and the cursor of the sorted page.
+//
+// sort: The sort order of the reviews.
+// page: The page of the reviews.
+//
+// Returns a string of error.
+func (r *ReviewUseCase) ValidateReviewsSort(sort string, page *types.Page) string {
+	// Check if the sort order is valid
+	if _, ok := constants.REVIEW_SORT_ORDERS[sort]; sort != "" && !ok {
+		return "Sort must be either newest, highest_rating, lowest_rating or most_helpful"
+	}
+
+	// Check if the cursor value is a number, the newest reviews are paginated by the id only
+	if page.Cursor != nil && sort != "" && sort != "newest" {
+		if _, ok := page.Cursor.Value.(float64); !ok {
+			return "Invalid cursor"
+		}
+	}
+
+	return ""
+}
+
 // ValidateCreateReviewForm is a use case that validates the create review form.
 //
 // form: The create review form.
@@ -106,6 +167,11 @@ func (r *ReviewUseCase) ValidateCreateReviewForm(form *dto.CreateReviewFormDTO)
 	// Create an empty error map
 	errs := make(types.FormErrorResponseMsg)
 
+	// Check if the booking is given
+	if form.BookingID == 0 {
+		errs["booking_id"] = append(errs["booking_id"], "Booking ID is required")
+	}
+
 	// Check if rating is valid
 	if form.Rating <= 0 {
 		errs["rating"] = append(errs["rating"], "Rating must be greater than 0")
@@ -116,6 +182,64 @@ func (r *ReviewUseCase) ValidateCreateReviewForm(form *dto.CreateReviewFormDTO)
 		errs["rating"] = append(errs["rating"], "Rating must be less than or equal to 5")
 	}
 
+	// Check if there are too many images
+	if len(form.Images)+len(form.ImageFiles) > constants.MAX_REVIEW_IMAGES {
+		errs["images"] = append(errs["images"], fmt.Sprintf("Review can't have more than %d images", constants.MAX_REVIEW_IMAGES))
+	} else {
+		// Validate the base64 encoded images
+		for _, image := range form.Images {
+			if err := r.UploadUseCase.ValidateFormImage(image, nil); !utils.IsBlank(err) {
+				errs["images"] = append(errs["images"], err)
+			}
+		}
+
+		// Validate the uploaded image files
+		for _, file := range form.ImageFiles {
+			if err := r.UploadUseCase.ValidateFormImage("", file); !utils.IsBlank(err) {
+				errs["images"] = append(errs["images"], err)
+			}
+		}
+	}
+
+	// Check if theres any error
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ValidateUpdateReviewForm is a use case that validates the update review form.
+//
+// form: The update review form.
+//
+// Returns a form error response message.
+func (r *ReviewUseCase) ValidateUpdateReviewForm(form *dto.UpdateReviewFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if there is nothing to update
+	if form.Rating == nil && form.Review == nil {
+		errs["review"] = append(errs["review"], "Nothing to update")
+
+		return errs
+	}
+
+	// Check if rating is valid
+	if form.Rating != nil && *form.Rating <= 0 {
+		errs["rating"] = append(errs["rating"], "Rating must be greater than 0")
+	}
+
+	// Check if rating is valid
+	if form.Rating != nil && *form.Rating > 5 {
+		errs["rating"] = append(errs["rating"], "Rating must be less than or equal to 5")
+	}
+
+	// Sanitize the review
+	if form.Review != nil {
+		*form.Review = strings.TrimSpace(*form.Review)
+	}
+
 	// Check if theres any error
 	if len(errs) > 0 {
 		return errs
@@ -138,6 +262,31 @@ func (r *ReviewUseCase) CheckCurrentUserHasReviewedUsingVendorIDCourtType(token
 	return r.ReviewRepository.CheckUserHasReviewCourtType(claims.Id, uint(vendorID), courtType)
 }
 
+// GetCurrentUserBookingsAwaitingReview is a use case that returns a page of the completed bookings
+// of the current user waiting for a review.
+//
+// token: The JWT token.
+// page: The page of the bookings.
+//
+// Returns the bookings, the next cursor or nil on the last page, and an error if any.
+func (r *ReviewUseCase) GetCurrentUserBookingsAwaitingReview(token *jwt.Token, page *types.Page) (*[]models.Booking, *types.Cursor, *entities.ProcessError) {
+	// Get the user ID from the token
+	claims := r.AuthUseCase.DecodeToken(token)
+
+	// Get the bookings waiting for a review
+	bookings, next, err := r.BookingRepository.GetAwaitingReviewUsingUserID(claims.Id, page)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the bookings awaiting review",
+		}
+	}
+
+	return bookings, next, nil
+}
+
 // ProcessCreateReview is a use case that processes the creation of a review.
 //
 // token: The JWT token.
@@ -150,23 +299,30 @@ func (r *ReviewUseCase) ProcessCreateReview(token *jwt.Token, vendorID int, cour
 	// Get the user ID from the token
 	claims := r.AuthUseCase.DecodeToken(token)
 
-	// Check if user already book the court
-	booked, err :=
-		r.BookingRepository.CheckUserHasBookCourt(claims.Id, uint(vendorID), courtType)
+	// Get the completed booking of the user
+	booking, err := r.BookingRepository.GetCompletedUsingIDUserID(form.BookingID, claims.Id)
+
+	// Return an error if the booking is not found or not completed yet
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Booking not found or not completed yet",
+		}
+	}
 
 	// Check if there is an error
 	if err != nil {
 		return nil, &entities.ProcessError{
 			ClientError: false,
-			Message:     "An error occurred while checking if user has booked the court",
+			Message:     "An error occurred while getting the booking",
 		}
 	}
 
-	// Return an error if user has not booked the court
-	if !booked {
+	// Return an error if the booking is not for the court
+	if booking.VendorID != uint(vendorID) || booking.CourtType.Type != courtType {
 		return nil, &entities.ProcessError{
 			ClientError: true,
-			Message:     "User has not booked the court",
+			Message:     "Booking is not for this court",
 		}
 	}
 
@@ -186,24 +342,61 @@ func (r *ReviewUseCase) ProcessCreateReview(token *jwt.Token, vendorID int, cour
 	if reviewed {
 		return nil, &entities.ProcessError{
 			ClientError: true,
+			Conflict:    true,
 			Message:     "User has already reviewed the court",
 		}
 	}
 
+	// Get the court type
+	courtTypeRecord, err := r.CourtTypeRepository.GetUsingType(courtType)
+
+	// Check if there is an error
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the court type",
+		}
+	}
+
 	// Create a new review object
 	review := &models.Review{
 		UserID:      claims.Id,
 		VendorID:    uint(vendorID),
-		CourtTypeID: enums.GetCourtTypeID(courtType),
+		CourtTypeID: courtTypeRecord.ID,
+		BookingID:   &booking.ID,
 		Rating:      form.Rating,
-		Review:      form.Review,
 	}
 
-	// Create the review
+	// Filter the banned words of the review
+	review.Review, review.Status = filterReview(form.Review)
+
+	// Save the review images
+	images, processErr := r.saveReviewImages(form)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	review.Images = images
+
+	// Create the review with its images
 	err = r.ReviewRepository.Create(review)
 
 	// Check if there is an error
 	if err != nil {
+		// Remove the review image files
+		removeReviewImageFiles(r.ReviewImageRepository, r.UploadUseCase, &review.Images)
+
+		// Return an error if user has reviewed the court in the meantime
+		if mysql.IsDuplicateKeyError(err) {
+			return nil, &entities.ProcessError{
+				ClientError: true,
+				Conflict:    true,
+				Message:     "User has already reviewed the court",
+			}
+		}
+
 		return nil, &entities.ProcessError{
 			ClientError: false,
 			Message:     "An error occurred while creating the review",
@@ -213,12 +406,647 @@ func (r *ReviewUseCase) ProcessCreateReview(token *jwt.Token, vendorID int, cour
 	return review, nil
 }
 
+// saveReviewImages is a helper function that saves the images of the create review form,
+// the base64 encoded images are placed before the uploaded image files.
+//
+// form: The create review form.
+//
+// Returns the review images and an error if any.
+func (r *ReviewUseCase) saveReviewImages(form *dto.CreateReviewFormDTO) ([]models.ReviewImage, *entities.ProcessError) {
+	// Create a slice of the review images
+	images := []models.ReviewImage{}
+
+	// Save the image and append it to the review images
+	save := func(data string, file *multipart.FileHeader) *entities.ProcessError {
+		imageName, processErr := r.UploadUseCase.SaveFormImage(data, file, constants.PATH_TO_REVIEW_IMAGES)
+
+		// Return an error if any
+		if processErr != nil {
+			return processErr
+		}
+
+		images = append(images, models.ReviewImage{
+			Image:    imageName,
+			Position: len(images) + 1,
+		})
+
+		return nil
+	}
+
+	// Save the base64 encoded images
+	for _, image := range form.Images {
+		if processErr := save(image, nil); processErr != nil {
+			// Remove the saved image files
+			removeReviewImageFiles(r.ReviewImageRepository, r.UploadUseCase, &images)
+
+			return nil, processErr
+		}
+	}
+
+	// Save the uploaded image files
+	for _, file := range form.ImageFiles {
+		if processErr := save("", file); processErr != nil {
+			// Remove the saved image files
+			removeReviewImageFiles(r.ReviewImageRepository, r.UploadUseCase, &images)
+
+			return nil, processErr
+		}
+	}
+
+	return images, nil
+}
+
+// removeReviewImageFiles is a helper function that removes the stored files of the review images.
+// Files are content hashed, so a file is kept while another review image still uses it.
+//
+// i: The review image repository.
+// up: The upload use case.
+// images: The review images.
+//
+// Returns nothing.
+func removeReviewImageFiles(i *repository.ReviewImageRepository, up *UploadUseCase, images *[]models.ReviewImage) {
+	// Loop through the review images
+	for _, image := range *images {
+		// Get the count of the review images using the file
+		count, err := i.GetCountUsingImage(image.Image)
+
+		// Keep the file if it's still used or the count is unknown
+		if err != nil || count > 0 {
+			continue
+		}
+
+		// Remove the review image files
+		up.RemoveImage(constants.PATH_TO_REVIEW_IMAGES, image.Image)
+	}
+}
+
+// ProcessUpdateReview is a use case that processes the update of the current user review,
+// the previous version is kept as a revision.
+//
+// token: The JWT token.
+// reviewID: The id of the review.
+// form: The update review form.
+//
+// Returns the updated review and an error if any.
+func (r *ReviewUseCase) ProcessUpdateReview(token *jwt.Token, reviewID uint, form *dto.UpdateReviewFormDTO) (*models.Review, *entities.ProcessError) {
+	// Get the current user review
+	review, processErr := r.getCurrentUserReview(token, reviewID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Return an error if the edit window has passed
+	if time.Since(review.CreatedAt) > config.ReviewConfig.EditWindow {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review can no longer be edited",
+		}
+	}
+
+	// Create a revision of the current version
+	revision := &models.ReviewRevision{
+		ReviewID:  review.ID,
+		Rating:    review.Rating,
+		Review:    review.Review,
+		WrittenAt: review.CreatedAt,
+	}
+
+	// The current version was written when the review was last edited
+	if review.EditedAt != nil {
+		revision.WrittenAt = *review.EditedAt
+	}
+
+	// Get the edit time
+	editedAt := time.Now()
+
+	// Create the updated fields
+	fields := map[string]any{"edited_at": editedAt}
+
+	// Update the rating
+	if form.Rating != nil {
+		review.Rating = *form.Rating
+		fields["rating"] = *form.Rating
+	}
+
+	// Update the review
+	if form.Review != nil {
+		// Filter the banned words of the review
+		text, status := filterReview(*form.Review)
+
+		review.Review = text
+		fields["review"] = text
+
+		// The hidden review stays hidden until an admin approves it
+		if review.Status != enums.ReviewHidden.Label() {
+			review.Status = status
+			fields["status"] = status
+		}
+	}
+
+	// Update the review and keep the revision
+	if err := r.ReviewRepository.UpdateWithRevision(revision, fields); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while updating the review",
+		}
+	}
+
+	review.EditedAt = &editedAt
+
+	return review, nil
+}
+
+// ProcessDeleteReview is a use case that processes the deletion of the current user review,
+// the deleted review is kept for moderation.
+//
+// token: The JWT token.
+// reviewID: The id of the review.
+//
+// Returns an error if any.
+func (r *ReviewUseCase) ProcessDeleteReview(token *jwt.Token, reviewID uint) *entities.ProcessError {
+	// Get the current user review
+	review, processErr := r.getCurrentUserReview(token, reviewID)
+
+	// Return an error if any
+	if processErr != nil {
+		return processErr
+	}
+
+	// Delete the review
+	return r.DeleteReview(review.ID)
+}
+
+// filterReview is a helper function that checks the review against the banned words, the review
+// with a banned word is held for moderation or has its banned words masked, depending on the configuration.
+//
+// review: The review text.
+//
+// Returns the filtered review text and the review status.
+func filterReview(review string) (string, string) {
+	// Whether the review contains a banned word
+	flagged := false
+
+	// Mask the banned words of the review
+	masked := reviewWordPattern.ReplaceAllStringFunc(review, func(word string) string {
+		if !config.ReviewConfig.BannedWords[strings.ToLower(word)] {
+			return word
+		}
+
+		flagged = true
+
+		return strings.Repeat("*", utf8.RuneCountInString(word))
+	})
+
+	// Publish the review without any banned word
+	if !flagged {
+		return review, enums.ReviewPublished.Label()
+	}
+
+	// Hold the review for moderation
+	if config.ReviewConfig.AutoHold {
+		return review, enums.ReviewHeld.Label()
+	}
+
+	return masked, enums.ReviewPublished.Label()
+}
+
+// getCurrentUserReview is a helper function that returns the review of the current user.
+//
+// token: The JWT token.
+// reviewID: The id of the review.
+//
+// Returns the review and an error if any.
+func (r *ReviewUseCase) getCurrentUserReview(token *jwt.Token, reviewID uint) (*models.Review, *entities.ProcessError) {
+	// Get the user ID from the token
+	claims := r.AuthUseCase.DecodeToken(token)
+
+	// Get the review
+	review, err := r.ReviewRepository.GetUsingID(reviewID)
+
+	// Return an error if the review is not found or not written by the current user
+	if err == gorm.ErrRecordNotFound || (err == nil && review.UserID != claims.Id) {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the review",
+		}
+	}
+
+	return review, nil
+}
+
+// ValidateReviewReplyForm is a use case that validates the review reply form.
+//
+// form: The review reply form.
+//
+// Returns a form error response message.
+func (r *ReviewUseCase) ValidateReviewReplyForm(form *dto.ReviewReplyFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Sanitize the reply
+	form.Reply = strings.TrimSpace(form.Reply)
+
+	// Check if reply is empty
+	if form.Reply == "" {
+		errs["reply"] = append(errs["reply"], "Reply is required")
+	}
+
+	// Check if reply is too long
+	if len(form.Reply) > constants.MAX_REVIEW_REPLY_LENGTH {
+		errs["reply"] = append(errs["reply"], fmt.Sprintf("Reply must be at most %d characters long", constants.MAX_REVIEW_REPLY_LENGTH))
+	}
+
+	// Check if theres any error
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ValidateReviewReportForm is a use case that validates the review report form.
+//
+// form: The review report form.
+//
+// Returns a form error response message.
+func (r *ReviewUseCase) ValidateReviewReportForm(form *dto.ReviewReportFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Sanitize the reason
+	form.Reason = strings.TrimSpace(form.Reason)
+
+	// Check if reason is empty
+	if form.Reason == "" {
+		errs["reason"] = append(errs["reason"], "Reason is required")
+	}
+
+	// Check if reason is too long
+	if len(form.Reason) > constants.MAX_REVIEW_REPORT_REASON_LENGTH {
+		errs["reason"] = append(errs["reason"], fmt.Sprintf("Reason must be at most %d characters long", constants.MAX_REVIEW_REPORT_REASON_LENGTH))
+	}
+
+	// Check if theres any error
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ProcessReportReview is a use case that processes the report of a published review, a user can
+// report the reviews of other users and a vendor can report the reviews of the vendor.
+//
+// token: The JWT token.
+// reviewID: The id of the review.
+// form: The review report form.
+//
+// Returns an error if any.
+func (r *ReviewUseCase) ProcessReportReview(token *jwt.Token, reviewID uint, form *dto.ReviewReportFormDTO) *entities.ProcessError {
+	// Get the token claims
+	claims := r.AuthUseCase.DecodeToken(token)
+
+	// Get the review
+	review, err := r.ReviewRepository.GetUsingID(reviewID)
+
+	// Return an error if the review is not found or can't be seen by the reporter
+	if err == gorm.ErrRecordNotFound || (err == nil && (review.Status != enums.ReviewPublished.Label() ||
+		(claims.ClientType == enums.Vendor && review.VendorID != claims.Id))) {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the review",
+		}
+	}
+
+	// Return an error if the user reports their own review
+	if claims.ClientType == enums.User && review.UserID == claims.Id {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Cannot report your own review",
+		}
+	}
+
+	// Check if the reporter has reported the review
+	reported, err := r.ReviewReportRepository.CheckUnresolvedExists(review.ID, claims.ClientType.String(), claims.Id)
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while checking the review reports",
+		}
+	}
+
+	// Return an error if the review has been reported by the reporter
+	if reported {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review has already been reported",
+		}
+	}
+
+	// Create the review report
+	report := &models.ReviewReport{
+		ReviewID:     review.ID,
+		ReporterType: claims.ClientType.String(),
+		ReporterID:   claims.Id,
+		Reason:       form.Reason,
+	}
+
+	// Save the review report
+	if err := r.ReviewReportRepository.Create(report); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while reporting the review",
+		}
+	}
+
+	return nil
+}
+
+// ProcessMarkReviewHelpful is a use case that processes the request of the current user
+// to mark a published review of another user as helpful, each review can be marked once.
+//
+// token: The JWT token.
+// reviewID: The id of the review.
+//
+// Returns the review and an error if any.
+func (r *ReviewUseCase) ProcessMarkReviewHelpful(token *jwt.Token, reviewID uint) (*models.Review, *entities.ProcessError) {
+	// Get the token claims
+	claims := r.AuthUseCase.DecodeToken(token)
+
+	// Get the published review
+	review, processErr := r.getPublishedReview(reviewID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Return an error if the review is the current user review
+	if review.UserID == claims.Id {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Cannot mark your own review as helpful",
+		}
+	}
+
+	// Create the review vote
+	created, err := r.ReviewVoteRepository.Create(&models.ReviewVote{
+		ReviewID: review.ID,
+		UserID:   claims.Id,
+	})
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while marking the review as helpful",
+		}
+	}
+
+	// Return an error if the review has already been marked
+	if !created {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review has already been marked as helpful",
+		}
+	}
+
+	review.HelpfulCount++
+
+	return review, nil
+}
+
+// ProcessUnmarkReviewHelpful is a use case that processes the request of the current user
+// to remove the helpful mark of a published review.
+//
+// token: The JWT token.
+// reviewID: The id of the review.
+//
+// Returns the review and an error if any.
+func (r *ReviewUseCase) ProcessUnmarkReviewHelpful(token *jwt.Token, reviewID uint) (*models.Review, *entities.ProcessError) {
+	// Get the token claims
+	claims := r.AuthUseCase.DecodeToken(token)
+
+	// Get the published review
+	review, processErr := r.getPublishedReview(reviewID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Delete the review vote
+	deleted, err := r.ReviewVoteRepository.DeleteUsingReviewIDUserID(review.ID, claims.Id)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while removing the helpful mark",
+		}
+	}
+
+	// Return an error if the review hasn't been marked
+	if !deleted {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review has not been marked as helpful",
+		}
+	}
+
+	review.HelpfulCount--
+
+	return review, nil
+}
+
+// getPublishedReview is a helper function that gets a published review.
+//
+// reviewID: The id of the review.
+//
+// Returns the review and an error if any.
+func (r *ReviewUseCase) getPublishedReview(reviewID uint) (*models.Review, *entities.ProcessError) {
+	// Get the review
+	review, err := r.ReviewRepository.GetUsingID(reviewID)
+
+	// Return an error if the review is not found or not published
+	if err == gorm.ErrRecordNotFound || (err == nil && review.Status != enums.ReviewPublished.Label()) {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the review",
+		}
+	}
+
+	return review, nil
+}
+
+// ProcessReplyReview is a use case that processes the reply of the current vendor to a review,
+// the existing reply is replaced and the reviewer is notified.
+//
+// token: The JWT token.
+// reviewID: The id of the review.
+// form: The review reply form.
+//
+// Returns the replied review and an error if any.
+func (r *ReviewUseCase) ProcessReplyReview(token *jwt.Token, reviewID uint, form *dto.ReviewReplyFormDTO) (*models.Review, *entities.ProcessError) {
+	// Get the current vendor review
+	review, processErr := r.getCurrentVendorReview(token, reviewID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Create the reply of the review
+	reply := &models.ReviewReply{
+		ReviewID: review.ID,
+		VendorID: review.VendorID,
+		Reply:    form.Reply,
+	}
+
+	// Create or replace the reply
+	if err := r.ReviewReplyRepository.Save(reply); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while saving the reply",
+		}
+	}
+
+	// Create the notification message
+	message := fmt.Sprintf("%s replied to your review", review.Vendor.Name)
+
+	// The existing reply is kept with its posting time
+	if review.Reply != nil {
+		reply.ID = review.Reply.ID
+		reply.CreatedAt = review.Reply.CreatedAt
+
+		message = fmt.Sprintf("%s updated their reply to your review", review.Vendor.Name)
+	}
+
+	review.Reply = reply
+
+	// Notify the reviewer, the reply is kept even if the notification fails
+	if processErr := r.NotificationUseCase.Notify(review.UserID, enums.NotificationReviewReply, message, &review.ID); processErr != nil {
+		log.Println("Error notifying review reply: ", processErr.Message)
+	}
+
+	return review, nil
+}
+
+// ProcessDeleteReviewReply is a use case that processes the deletion of the current vendor
+// reply to a review.
+//
+// token: The JWT token.
+// reviewID: The id of the review.
+//
+// Returns an error if any.
+func (r *ReviewUseCase) ProcessDeleteReviewReply(token *jwt.Token, reviewID uint) *entities.ProcessError {
+	// Get the current vendor review
+	review, processErr := r.getCurrentVendorReview(token, reviewID)
+
+	// Return an error if any
+	if processErr != nil {
+		return processErr
+	}
+
+	// Delete the reply
+	deleted, err := r.ReviewReplyRepository.DeleteUsingReviewID(review.ID)
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while deleting the reply",
+		}
+	}
+
+	// Return an error if the review has no reply
+	if !deleted {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Reply not found",
+		}
+	}
+
+	return nil
+}
+
+// getCurrentVendorReview is a helper function that returns the review of the current vendor.
+//
+// token: The JWT token.
+// reviewID: The id of the review.
+//
+// Returns the review and an error if any.
+func (r *ReviewUseCase) getCurrentVendorReview(token *jwt.Token, reviewID uint) (*models.Review, *entities.ProcessError) {
+	// Get the vendor ID from the token
+	claims := r.AuthUseCase.DecodeToken(token)
+
+	// Get the review
+	review, err := r.ReviewRepository.GetUsingID(reviewID)
+
+	// Return an error if the review is not found or not written for the current vendor
+	if err == gorm.ErrRecordNotFound || (err == nil && review.VendorID != claims.Id) {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the review",
+		}
+	}
+
+	return review, nil
+}
+
 // GetCourtTypeReviews is a use case that handles the request to get the court type reviews.
 //
+// vendorID: The id of the vendor.
 // courtType: The type of the court.
+// rating: The rating of the review.
+// withPhotos: Whether to get only the reviews with photos.
+// sort: The sort order of the reviews.
+// page: The page of the reviews.
 //
 // Returns the reviews map and an error if any.
-func (r *ReviewUseCase) GetCourtTypeReviews(vendorID uint, courtType string, rating *int) (*types.CourtReviewsMap, error) {
+func (r *ReviewUseCase) GetCourtTypeReviews(vendorID uint, courtType string, rating *int, withPhotos bool, sort string, page *types.Page) (*types.CourtReviewsMap, error) {
+	// Create the reviews filter
+	filter := &types.ReviewsFilter{
+		WithPhotos: withPhotos,
+		Sort:       sort,
+	}
+
 	// Create a new context with a cancel function
 	_, cancel := context.WithCancel(context.Background())
 
@@ -288,19 +1116,20 @@ func (r *ReviewUseCase) GetCourtTypeReviews(vendorID uint, courtType string, rat
 		// Defer the wait group
 		defer wg.Done()
 
-		// Create a variable to store the reviews and error
+		// Create a variable to store the reviews, the next cursor and error
 		var (
 			records *[]models.Review
+			next    *types.Cursor
 			e       error
 		)
 
 		// Get the reviews
 		// Check if the rating query parameter is empty
 		if rating != nil && *rating != 0 {
-			records, e =
-				r.ReviewRepository.GetUsingVendorIDCourtTypeRating(vendorID, courtType, *rating)
+			records, next, e =
+				r.ReviewRepository.GetUsingVendorIDCourtTypeRating(vendorID, courtType, *rating, filter, page)
 		} else {
-			records, e = r.ReviewRepository.GetUsingVendorIDCourtType(vendorID, courtType)
+			records, next, e = r.ReviewRepository.GetUsingVendorIDCourtType(vendorID, courtType, filter, page)
 		}
 
 		// Check if there is an error
@@ -312,8 +1141,9 @@ func (r *ReviewUseCase) GetCourtTypeReviews(vendorID uint, courtType string, rat
 			return
 		}
 
-		// Add the reviews to the reviews map
+		// Add the reviews and the next cursor to the reviews map
 		reviews["reviews"] = records
+		reviews["next_cursor"] = next
 	}()
 
 	wg.Add(1)
@@ -352,12 +1182,21 @@ func (r *ReviewUseCase) GetCourtTypeReviews(vendorID uint, courtType string, rat
 //
 // token: The JWT token.
 // rating: The rating of the review.
+// withPhotos: Whether to get only the reviews with photos.
+// sort: The sort order of the reviews.
+// page: The page of the reviews.
 //
 // Returns the reviews map and an error if any.
-func (r *ReviewUseCase) GetCurrentVendorReviews(token *jwt.Token, rating *int) (*types.CourtReviewsMap, error) {
+func (r *ReviewUseCase) GetCurrentVendorReviews(token *jwt.Token, rating *int, withPhotos bool, sort string, page *types.Page) (*types.CourtReviewsMap, error) {
 	// Get the vendor ID from the token
 	claims := r.AuthUseCase.DecodeToken(token)
 
+	// Create the reviews filter
+	filter := &types.ReviewsFilter{
+		WithPhotos: withPhotos,
+		Sort:       sort,
+	}
+
 	// Create a new context with a cancel function
 	_, cancel := context.WithCancel(context.Background())
 
@@ -426,19 +1265,20 @@ func (r *ReviewUseCase) GetCurrentVendorReviews(token *jwt.Token, rating *int) (
 		// Defer the wait group
 		defer wg.Done()
 
-		// Create a variable to store the reviews and error
+		// Create a variable to store the reviews, the next cursor and error
 		var (
 			records *[]models.Review
+			next    *types.Cursor
 			e       error
 		)
 
 		// Get the reviews
 		// Check if the rating query parameter is empty
 		if rating != nil && *rating != 0 {
-			records, e =
-				r.ReviewRepository.GetUsingVendorIDRating(claims.Id, *rating)
+			records, next, e =
+				r.ReviewRepository.GetUsingVendorIDRating(claims.Id, *rating, filter, page)
 		} else {
-			records, e = r.ReviewRepository.GetUsingVendorID(claims.Id)
+			records, next, e = r.ReviewRepository.GetUsingVendorID(claims.Id, filter, page)
 		}
 
 		// Check if there is an error
@@ -450,8 +1290,9 @@ func (r *ReviewUseCase) GetCurrentVendorReviews(token *jwt.Token, rating *int) (
 			return
 		}
 
-		// Add the reviews to the reviews map
+		// Add the reviews and the next cursor to the reviews map
 		reviews["reviews"] = records
+		reviews["next_cursor"] = next
 	}()
 
 	// Add a new wait group
@@ -493,11 +1334,12 @@ func (r *ReviewUseCase) GetCurrentVendorReviews(token *jwt.Token, rating *int) (
 // vendorID: The id of the vendor.
 // courtType: The type of the court.
 // rating: The rating of the review.
+// page: The page of the reviews.
 //
-// Returns the reviews and an error if any.
-func (r *ReviewUseCase) GetReviewsUsingVendorIDCourtTypeRating(vendorID uint, courtType string, rating int) (*[]models.Review, error) {
+// Returns the reviews, the next cursor or nil on the last page, and an error if any.
+func (r *ReviewUseCase) GetReviewsUsingVendorIDCourtTypeRating(vendorID uint, courtType string, rating int, page *types.Page) (*[]models.Review, *types.Cursor, error) {
 	// Get the reviews using the vendor ID, court type, and rating
-	return r.ReviewRepository.GetUsingVendorIDCourtTypeRating(vendorID, courtType, rating)
+	return r.ReviewRepository.GetUsingVendorIDCourtTypeRating(vendorID, courtType, rating, &types.ReviewsFilter{}, page)
 }
 
 // GetCurrentVendorReviewsUsingRating is a use case that handles the request to get the current vendor's
@@ -505,12 +1347,161 @@ func (r *ReviewUseCase) GetReviewsUsingVendorIDCourtTypeRating(vendorID uint, co
 //
 // token: The JWT token.
 // rating: The rating of the review.
+// page: The page of the reviews.
 //
-// Returns the reviews and an error if any.
-func (r *ReviewUseCase) GetCurrentVendorReviewsUsingRating(token *jwt.Token, rating int) (*[]models.Review, error) {
+// Returns the reviews, the next cursor or nil on the last page, and an error if any.
+func (r *ReviewUseCase) GetCurrentVendorReviewsUsingRating(token *jwt.Token, rating int, page *types.Page) (*[]models.Review, *types.Cursor, error) {
 	// Get the vendor ID from the token
 	claims := r.AuthUseCase.DecodeToken(token)
 
 	// Get the reviews using the vendor ID and rating
-	return r.ReviewRepository.GetUsingVendorIDRating(claims.Id, rating)
+	return r.ReviewRepository.GetUsingVendorIDRating(claims.Id, rating, &types.ReviewsFilter{}, page)
+}
+
+// GetReviews is a function that returns a page of the reviews of every vendor.
+//
+// vendorID: The vendor ID to filter the reviews, nil for every vendor.
+// page: The page of the reviews.
+//
+// Returns the reviews, the next cursor or nil on the last page, and an error if any.
+func (r *ReviewUseCase) GetReviews(vendorID *uint, page *types.Page) (*[]models.Review, *types.Cursor, *entities.ProcessError) {
+	// Get the reviews
+	reviews, next, err := r.ReviewRepository.Get(vendorID, page)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the reviews",
+		}
+	}
+
+	return reviews, next, nil
+}
+
+// DeleteReview is a function that deletes the review.
+//
+// reviewID: The review ID.
+//
+// Returns an error if any.
+func (r *ReviewUseCase) DeleteReview(reviewID uint) *entities.ProcessError {
+	// Delete the review
+	deleted, err := r.ReviewRepository.DeleteUsingID(reviewID)
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while deleting the review",
+		}
+	}
+
+	// Return an error if the review is not found
+	if !deleted {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review not found",
+		}
+	}
+
+	return nil
+}
+
+// GetModerationQueue is a function that returns a page of the reviews waiting for moderation,
+// the reviews held by the word filter and the reviews with unresolved reports.
+//
+// page: The page of the reviews.
+//
+// Returns the reviews, the next cursor or nil on the last page, and an error if any.
+func (r *ReviewUseCase) GetModerationQueue(page *types.Page) (*[]models.Review, *types.Cursor, *entities.ProcessError) {
+	// Get the reviews waiting for moderation
+	reviews, next, err := r.ReviewRepository.GetModerationQueue(page)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the moderation queue",
+		}
+	}
+
+	return reviews, next, nil
+}
+
+// ModerateReview is a function that sets the moderation status of the review,
+// the reports of the review are resolved.
+//
+// reviewID: The review ID.
+// status: The review status, either published or hidden.
+//
+// Returns an error if any.
+func (r *ReviewUseCase) ModerateReview(reviewID uint, status enums.ReviewStatus) *entities.ProcessError {
+	// Get the review
+	_, err := r.ReviewRepository.GetUsingID(reviewID)
+
+	// Return an error if the review is not found
+	if err == gorm.ErrRecordNotFound {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the review",
+		}
+	}
+
+	// Update the review status
+	if err := r.ReviewRepository.UpdateStatus(reviewID, status.Label()); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while moderating the review",
+		}
+	}
+
+	return nil
+}
+
+// GetReviewHistory is a function that returns the review with its previous versions,
+// including the deleted review.
+//
+// reviewID: The review ID.
+//
+// Returns the review, its revisions, and an error if any.
+func (r *ReviewUseCase) GetReviewHistory(reviewID uint) (*models.Review, *[]models.ReviewRevision, *entities.ProcessError) {
+	// Get the review
+	review, err := r.ReviewRepository.GetUsingIDWithDeleted(reviewID)
+
+	// Return an error if the review is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Review not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the review",
+		}
+	}
+
+	// Get the review revisions
+	revisions, err := r.ReviewRevisionRepository.GetUsingReviewID(reviewID)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the review revisions",
+		}
+	}
+
+	return review, revisions, nil
 }
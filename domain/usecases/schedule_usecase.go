@@ -0,0 +1,491 @@
+package usecases
+
+import (
+	"main/core/constants"
+	"main/core/shared"
+	"main/core/types"
+	"main/data/models"
+	"main/domain/entities"
+	"main/internal/dto"
+	"main/internal/repository"
+	"main/pkg/utils"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ScheduleUseCase is a struct that defines the use case for the vendor opening hours
+// and special days.
+type ScheduleUseCase struct {
+	AuthUseCase           *AuthUseCase
+	VendorRepository      *repository.VendorRepository
+	OpeningHourRepository *repository.OpeningHourRepository
+	SpecialDayRepository  *repository.SpecialDayRepository
+}
+
+// NewScheduleUseCase is a factory function that returns a new instance of the ScheduleUseCase struct.
+//
+// a: The auth use case.
+// v: The vendor repository.
+// o: The opening hour repository.
+// s: The special day repository.
+//
+// Returns a new instance of the ScheduleUseCase.
+func NewScheduleUseCase(a *AuthUseCase, v *repository.VendorRepository, o *repository.OpeningHourRepository, s *repository.SpecialDayRepository) *ScheduleUseCase {
+	return &ScheduleUseCase{
+		AuthUseCase:           a,
+		VendorRepository:      v,
+		OpeningHourRepository: o,
+		SpecialDayRepository:  s,
+	}
+}
+
+// today is a helper function that returns the current date without the time.
+//
+// Returns the current date.
+func today() time.Time {
+	// Get the current time
+	now := time.Now()
+
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// weekOpeningHours is a helper function that returns the opening hours of every day
+// of the week, the days without opening hours use the vendor open and close time.
+//
+// vendor: The vendor.
+// hours: The opening hours of the vendor.
+//
+// Returns the opening hours ordered by the day of the week.
+func weekOpeningHours(vendor *models.Vendor, hours *[]models.OpeningHour) *[]models.OpeningHour {
+	// Create the opening hours of the week using the vendor open and close time
+	week := make([]models.OpeningHour, 7)
+
+	for weekday := range week {
+		week[weekday] = models.OpeningHour{
+			VendorID:  vendor.ID,
+			Weekday:   weekday,
+			OpenTime:  vendor.OpenTime,
+			CloseTime: vendor.CloseTime,
+		}
+	}
+
+	// Replace the days with opening hours
+	for _, hour := range *hours {
+		week[hour.Weekday] = hour
+	}
+
+	return &week
+}
+
+// GetOpeningHoursUsingVendor is a function that returns the effective opening hours
+// of the vendor for a number of days. A special day takes precedence over the opening
+// hours of the day of the week, which take precedence over the vendor open and close time.
+//
+// vendor: The vendor.
+// from: The first date.
+// days: The number of days.
+//
+// Returns the opening hours of every date and an error if any.
+func (s *ScheduleUseCase) GetOpeningHoursUsingVendor(vendor *models.Vendor, from time.Time, days int) (*[]types.OpeningHours, error) {
+	// Get the opening hours of the vendor
+	hours, err := s.OpeningHourRepository.GetUsingVendorID(vendor.ID)
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the last date
+	to := from.AddDate(0, 0, days-1)
+
+	// Get the special days between the dates
+	specialDays, err := s.SpecialDayRepository.GetUsingVendorIDDateRange(vendor.ID, from.Format("2006-01-02"), to.Format("2006-01-02"))
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	// Map the special days by date
+	specialDaysMap := make(map[string]models.SpecialDay)
+
+	for _, day := range *specialDays {
+		specialDaysMap[day.Date.Format("2006-01-02")] = day
+	}
+
+	// Get the opening hours of the week
+	week := *weekOpeningHours(vendor, hours)
+
+	// Create the opening hours of every date
+	openingHours := []types.OpeningHours{}
+
+	for i := 0; i < days; i++ {
+		date := from.AddDate(0, 0, i)
+
+		// Use the special day of the date if any
+		if day, ok := specialDaysMap[date.Format("2006-01-02")]; ok {
+			openingHours = append(openingHours, types.OpeningHours{
+				Date:      date,
+				IsClosed:  day.IsClosed,
+				OpenTime:  day.OpenTime,
+				CloseTime: day.CloseTime,
+				IsSpecial: true,
+				Note:      day.Note,
+			})
+
+			continue
+		}
+
+		hour := week[date.Weekday()]
+
+		openingHours = append(openingHours, types.OpeningHours{
+			Date:      date,
+			IsClosed:  hour.IsClosed,
+			OpenTime:  hour.OpenTime,
+			CloseTime: hour.CloseTime,
+		})
+	}
+
+	return &openingHours, nil
+}
+
+// GetOpeningHoursUsingVendorIDDate is a function that returns the effective opening
+// hours of the vendor on a date.
+//
+// vendorID: The vendor ID.
+// date: The date.
+//
+// Returns the opening hours and an error if any.
+func (s *ScheduleUseCase) GetOpeningHoursUsingVendorIDDate(vendorID uint, date time.Time) (*types.OpeningHours, error) {
+	// Get the vendor
+	vendor, err := s.VendorRepository.GetUsingID(vendorID)
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	// Get the opening hours of the date
+	hours, err := s.GetOpeningHoursUsingVendor(vendor, date, 1)
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	return &(*hours)[0], nil
+}
+
+// GetCurrentVendorOpeningHours is a function that returns the effective opening
+// hours of the current vendor on a date.
+//
+// token: The JWT token.
+// date: The date.
+//
+// Returns the opening hours and an error if any.
+func (s *ScheduleUseCase) GetCurrentVendorOpeningHours(token *jwt.Token, date time.Time) (*types.OpeningHours, error) {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	return s.GetOpeningHoursUsingVendorIDDate(claims.Id, date)
+}
+
+// IsOpenBetween is a function that checks whether the vendor is open for the whole
+// time between the start and the end time.
+//
+// hours: The opening hours.
+// start: The start time.
+// end: The end time.
+//
+// Returns true if the vendor is open, false otherwise.
+func (s *ScheduleUseCase) IsOpenBetween(hours *types.OpeningHours, start time.Time, end time.Time) bool {
+	// Check if the vendor is closed
+	if hours.IsClosed {
+		return false
+	}
+
+	return !start.Before(hours.OpenTime.Time) && !end.After(hours.CloseTime.Time)
+}
+
+// GetCurrentVendorSchedule is a function that returns the opening hours of the week
+// and the upcoming special days of the current vendor.
+//
+// token: The JWT token.
+//
+// Returns the opening hours, the special days and an error if any.
+func (s *ScheduleUseCase) GetCurrentVendorSchedule(token *jwt.Token) (*[]models.OpeningHour, *[]models.SpecialDay, *entities.ProcessError) {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Get the vendor
+	vendor, err := s.VendorRepository.GetUsingID(claims.Id)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the vendor",
+		}
+	}
+
+	// Get the opening hours
+	hours, err := s.OpeningHourRepository.GetUsingVendorID(vendor.ID)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the opening hours",
+		}
+	}
+
+	// Get the upcoming special days
+	from := today()
+	to := from.AddDate(0, 0, constants.MAX_SPECIAL_DAYS_AHEAD)
+
+	specialDays, err := s.SpecialDayRepository.GetUsingVendorIDDateRange(vendor.ID, from.Format("2006-01-02"), to.Format("2006-01-02"))
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the special days",
+		}
+	}
+
+	return weekOpeningHours(vendor, hours), specialDays, nil
+}
+
+// parseOpeningTimes is a helper function that parses the opening and closing time.
+//
+// openTime: The opening time, formatted as HH:MM.
+// closeTime: The closing time, formatted as HH:MM.
+//
+// Returns the opening time, the closing time and an error message if any.
+func parseOpeningTimes(openTime string, closeTime string) (shared.TimeOnly, shared.TimeOnly, string) {
+	// Parse the opening time
+	opening, err := time.Parse("15:04", openTime)
+
+	// Return an error if any
+	if err != nil {
+		return shared.TimeOnly{}, shared.TimeOnly{}, "Open time must be formatted as HH:MM"
+	}
+
+	// Parse the closing time
+	closing, err := time.Parse("15:04", closeTime)
+
+	// Return an error if any
+	if err != nil {
+		return shared.TimeOnly{}, shared.TimeOnly{}, "Close time must be formatted as HH:MM"
+	}
+
+	// Check if the closing time is after the opening time
+	if !closing.After(opening) {
+		return shared.TimeOnly{}, shared.TimeOnly{}, "Close time must be after open time"
+	}
+
+	return shared.TimeOnly{Time: opening}, shared.TimeOnly{Time: closing}, ""
+}
+
+// ValidateOpeningHoursForm is a function that validates the opening hours form.
+//
+// form: The opening hours form.
+//
+// Returns an error message if any.
+func (s *ScheduleUseCase) ValidateOpeningHoursForm(form *dto.OpeningHoursFormDTO) string {
+	// Check if the days is empty
+	if form.Days == nil {
+		return "Days is required"
+	}
+
+	// Create a set of the days of the week
+	weekdays := make(map[int]bool)
+
+	// Loop through the days
+	for _, day := range *form.Days {
+		// Check if the day of the week is valid
+		if day.Weekday == nil {
+			return "Weekday is required"
+		}
+
+		if *day.Weekday < 0 || *day.Weekday > 6 {
+			return "Weekday must be between 0 and 6"
+		}
+
+		// Check if the day of the week is duplicated
+		if weekdays[*day.Weekday] {
+			return "Weekday must be unique"
+		}
+
+		weekdays[*day.Weekday] = true
+
+		// Skip the times of the closed days
+		if day.IsClosed {
+			continue
+		}
+
+		// Check if the times are valid
+		if _, _, errMsg := parseOpeningTimes(day.OpenTime, day.CloseTime); !utils.IsBlank(errMsg) {
+			return errMsg
+		}
+	}
+
+	return ""
+}
+
+// ProcessUpdateOpeningHours is a function that replaces the opening hours of the
+// current vendor, the days not given use the vendor open and close time.
+//
+// token: The JWT token.
+// form: The opening hours form.
+//
+// Returns the opening hours, the special days and an error if any.
+func (s *ScheduleUseCase) ProcessUpdateOpeningHours(token *jwt.Token, form *dto.OpeningHoursFormDTO) (*[]models.OpeningHour, *[]models.SpecialDay, *entities.ProcessError) {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Create the opening hours
+	hours := []models.OpeningHour{}
+
+	for _, day := range *form.Days {
+		hour := models.OpeningHour{
+			VendorID: claims.Id,
+			Weekday:  *day.Weekday,
+			IsClosed: day.IsClosed,
+		}
+
+		// Set the times of the open days
+		if !day.IsClosed {
+			hour.OpenTime, hour.CloseTime, _ = parseOpeningTimes(day.OpenTime, day.CloseTime)
+		}
+
+		hours = append(hours, hour)
+	}
+
+	// Replace the opening hours
+	err := s.OpeningHourRepository.ReplaceUsingVendorID(claims.Id, &hours)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while updating the opening hours",
+		}
+	}
+
+	return s.GetCurrentVendorSchedule(token)
+}
+
+// ValidateSpecialDayForm is a function that validates the special day form.
+//
+// form: The special day form.
+//
+// Returns the form error response message.
+func (s *ScheduleUseCase) ValidateSpecialDayForm(form *dto.SpecialDayFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if the date is valid
+	if utils.IsBlank(form.Date) {
+		errs["date"] = append(errs["date"], "Date is required")
+	} else if date, err := time.Parse("2006-01-02", form.Date); err != nil {
+		errs["date"] = append(errs["date"], "Date must be formatted as YYYY-MM-DD")
+	} else if date.Before(today()) {
+		errs["date"] = append(errs["date"], "Date must not be in the past")
+	} else if date.After(today().AddDate(0, 0, constants.MAX_SPECIAL_DAYS_AHEAD)) {
+		errs["date"] = append(errs["date"], "Date must be within a year")
+	}
+
+	// Check if the times are valid
+	if !form.IsClosed {
+		if _, _, errMsg := parseOpeningTimes(form.OpenTime, form.CloseTime); !utils.IsBlank(errMsg) {
+			errs["time"] = append(errs["time"], errMsg)
+		}
+	}
+
+	// Check if the note is too long
+	if len(form.Note) > 255 {
+		errs["note"] = append(errs["note"], "Note must be at most 255 characters")
+	}
+
+	// Check if the errors map is not empty
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ProcessSaveSpecialDay is a function that creates or replaces the special day of the
+// current vendor on a date.
+//
+// token: The JWT token.
+// form: The special day form.
+//
+// Returns the special day and an error if any.
+func (s *ScheduleUseCase) ProcessSaveSpecialDay(token *jwt.Token, form *dto.SpecialDayFormDTO) (*models.SpecialDay, *entities.ProcessError) {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Parse the date
+	date, _ := time.Parse("2006-01-02", form.Date)
+
+	// Create the special day
+	day := models.SpecialDay{
+		VendorID: claims.Id,
+		Date:     shared.DateOnly{Time: date},
+		IsClosed: form.IsClosed,
+		Note:     form.Note,
+	}
+
+	// Set the times of the open date
+	if !form.IsClosed {
+		day.OpenTime, day.CloseTime, _ = parseOpeningTimes(form.OpenTime, form.CloseTime)
+	}
+
+	// Save the special day
+	err := s.SpecialDayRepository.Save(&day)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while saving the special day",
+		}
+	}
+
+	return &day, nil
+}
+
+// DeleteSpecialDay is a function that deletes the special day of the current vendor on a date.
+//
+// token: The JWT token.
+// date: The date, formatted as YYYY-MM-DD.
+//
+// Returns an error if any.
+func (s *ScheduleUseCase) DeleteSpecialDay(token *jwt.Token, date string) *entities.ProcessError {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Delete the special day
+	deleted, err := s.SpecialDayRepository.DeleteUsingVendorIDDate(claims.Id, date)
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while deleting the special day",
+		}
+	}
+
+	// Return an error if the special day is not found
+	if deleted == 0 {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Special day not found",
+		}
+	}
+
+	return nil
+}
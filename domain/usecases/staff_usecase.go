@@ -0,0 +1,482 @@
+package usecases
+
+import (
+	"fmt"
+	"main/core/constants"
+	"main/core/enums"
+	"main/core/types"
+	"main/data/models"
+	"main/domain/entities"
+	"main/internal/dto"
+	"main/internal/repository"
+	"main/pkg/utils"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
+)
+
+// StaffUseCase is a struct that defines the use case for the vendor staff accounts.
+type StaffUseCase struct {
+	AuthUseCase             *AuthUseCase
+	VendorStaffRepository   *repository.VendorStaffRepository
+	StaffActivityRepository *repository.StaffActivityRepository
+	RefreshTokenRepository  *repository.RefreshTokenRepository
+}
+
+// NewStaffUseCase is a factory function that returns a new instance of the StaffUseCase.
+//
+// a: The auth use case.
+// s: The vendor staff repository.
+// sa: The staff activity repository.
+// r: The refresh token repository.
+//
+// Returns a new instance of the StaffUseCase.
+func NewStaffUseCase(a *AuthUseCase, s *repository.VendorStaffRepository, sa *repository.StaffActivityRepository, r *repository.RefreshTokenRepository) *StaffUseCase {
+	return &StaffUseCase{
+		AuthUseCase:             a,
+		VendorStaffRepository:   s,
+		StaffActivityRepository: sa,
+		RefreshTokenRepository:  r,
+	}
+}
+
+// GetCurrentStaff is a function that returns the vendor staff signed in with the token.
+//
+// token: The vendor token.
+//
+// Returns the vendor staff, nil when the token is not a staff token, and an error if any.
+func (s *StaffUseCase) GetCurrentStaff(token *jwt.Token) (*models.VendorStaff, *entities.ProcessError) {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Return nil if the vendor is signed in with the vendor account
+	if claims.StaffID == 0 {
+		return nil, nil
+	}
+
+	// Get the vendor staff by ID
+	staff, err := s.VendorStaffRepository.GetUsingID(claims.Id, claims.StaffID)
+
+	// Return an error if the staff is deleted
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Staff account not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the staff",
+		}
+	}
+
+	return staff, nil
+}
+
+// GetStaffRole is a function that returns the role of the signed in staff,
+// the vendor account has the owner role.
+//
+// staff: The vendor staff, nil when signed in with the vendor account.
+//
+// Returns the staff role.
+func (s *StaffUseCase) GetStaffRole(staff *models.VendorStaff) enums.StaffRole {
+	// Return the owner role for the vendor account
+	if staff == nil {
+		return enums.StaffOwner
+	}
+
+	// Get the staff role, an unknown role falls back to the least privileged role
+	role, ok := enums.ParseStaffRole(staff.Role)
+
+	if !ok {
+		return enums.StaffCashier
+	}
+
+	return role
+}
+
+// GetStaffMembers is a function that returns the staff of the current vendor.
+//
+// token: The vendor token.
+//
+// Returns the vendor staff and an error if any.
+func (s *StaffUseCase) GetStaffMembers(token *jwt.Token) (*[]models.VendorStaff, *entities.ProcessError) {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Get the vendor staff
+	staff, err := s.VendorStaffRepository.GetUsingVendorID(claims.Id)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the staff",
+		}
+	}
+
+	return staff, nil
+}
+
+// SanitizeCreateStaffForm is a helper function that sanitizes the create vendor staff input.
+//
+// form: The create vendor staff form dto.
+//
+// Returns void
+func (s *StaffUseCase) SanitizeCreateStaffForm(form *dto.CreateVendorStaffFormDTO) {
+	form.Name = strings.TrimSpace(form.Name)
+	form.Email = strings.TrimSpace(form.Email)
+	form.Role = strings.TrimSpace(form.Role)
+}
+
+// ValidateCreateStaffForm is a function that validates the create vendor staff form.
+//
+// form: The create vendor staff form dto.
+//
+// Returns a map of errors.
+func (s *StaffUseCase) ValidateCreateStaffForm(form *dto.CreateVendorStaffFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if the name is blank
+	if utils.IsBlank(form.Name) {
+		errs["name"] = append(errs["name"], "Name is required")
+	}
+
+	// Check if the email is blank
+	if utils.IsBlank(form.Email) {
+		errs["email"] = append(errs["email"], "Email is required")
+	} else if !utils.IsValidEmail(form.Email) {
+		errs["email"] = append(errs["email"], "Email is invalid")
+	}
+
+	// Check if the password is too short
+	if len(form.Password) < constants.MINIMUM_PASSWORD_LENGTH {
+		errs["password"] = append(errs["password"], fmt.Sprintf("Password must be at least %d characters long", constants.MINIMUM_PASSWORD_LENGTH))
+	}
+
+	// Check if the password and confirm password are the same
+	if form.Password != form.ConfirmPassword {
+		errs["confirm_password"] = append(errs["confirm_password"], "Password and confirm password do not match")
+	}
+
+	// Check if the role is valid
+	if _, ok := enums.ParseStaffRole(form.Role); !ok {
+		errs["role"] = append(errs["role"], "Role must be one of Owner, Manager or Cashier")
+	}
+
+	// Check if the errors map is not empty
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ProcessCreateStaff is a function that processes the create vendor staff use case.
+//
+// token: The vendor token.
+// form: The create vendor staff form dto.
+//
+// Returns the created vendor staff and an error if any.
+func (s *StaffUseCase) ProcessCreateStaff(token *jwt.Token, form *dto.CreateVendorStaffFormDTO) (*models.VendorStaff, *entities.ProcessError) {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Check if the email is taken
+	taken, err := s.VendorStaffRepository.IsEmailTaken(form.Email)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while checking if the email is taken",
+		}
+	}
+
+	// Return an error if the email is taken
+	if taken {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message: types.FormErrorResponseMsg{
+				"email": []string{"Email is taken"},
+			},
+		}
+	}
+
+	// Hash the password
+	hashedPwd, err := s.AuthUseCase.HashPassword(form.Password)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while hashing the password",
+		}
+	}
+
+	// Get the staff role, the role is validated with the form
+	role, _ := enums.ParseStaffRole(form.Role)
+
+	// Create a new vendor staff
+	staff := models.VendorStaff{
+		VendorID: claims.Id,
+		Name:     form.Name,
+		Email:    form.Email,
+		Password: hashedPwd,
+		Role:     role.Label(),
+	}
+
+	// Save the vendor staff
+	if err := s.VendorStaffRepository.Create(&staff); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while creating the staff",
+		}
+	}
+
+	return &staff, nil
+}
+
+// ValidateUpdateStaffForm is a function that validates the update vendor staff form.
+//
+// form: The update vendor staff form dto.
+//
+// Returns a map of errors.
+func (s *StaffUseCase) ValidateUpdateStaffForm(form *dto.UpdateVendorStaffFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if there is nothing to update
+	if form.Name == nil && form.Role == nil && form.Password == nil {
+		errs["staff"] = append(errs["staff"], "Nothing to update")
+
+		return errs
+	}
+
+	// Check if the name is blank
+	if form.Name != nil {
+		*form.Name = strings.TrimSpace(*form.Name)
+
+		if utils.IsBlank(*form.Name) {
+			errs["name"] = append(errs["name"], "Name is required")
+		}
+	}
+
+	// Check if the role is valid
+	if form.Role != nil {
+		if _, ok := enums.ParseStaffRole(strings.TrimSpace(*form.Role)); !ok {
+			errs["role"] = append(errs["role"], "Role must be one of Owner, Manager or Cashier")
+		}
+	}
+
+	// Check if the password is too short
+	if form.Password != nil && len(*form.Password) < constants.MINIMUM_PASSWORD_LENGTH {
+		errs["password"] = append(errs["password"], fmt.Sprintf("Password must be at least %d characters long", constants.MINIMUM_PASSWORD_LENGTH))
+	}
+
+	// Check if the errors map is not empty
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ProcessUpdateStaff is a function that processes the update vendor staff use case.
+//
+// token: The vendor token.
+// staffID: The vendor staff ID.
+// form: The update vendor staff form dto.
+//
+// Returns the updated vendor staff and an error if any.
+func (s *StaffUseCase) ProcessUpdateStaff(token *jwt.Token, staffID uint, form *dto.UpdateVendorStaffFormDTO) (*models.VendorStaff, *entities.ProcessError) {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Check if the staff exists
+	if _, processErr := s.getStaff(claims.Id, staffID); processErr != nil {
+		return nil, processErr
+	}
+
+	// Create the updated fields
+	fields := make(map[string]any)
+
+	// Update the name
+	if form.Name != nil {
+		fields["name"] = *form.Name
+	}
+
+	// Update the role, the role is validated with the form
+	if form.Role != nil {
+		role, _ := enums.ParseStaffRole(strings.TrimSpace(*form.Role))
+
+		fields["role"] = role.Label()
+	}
+
+	// Update the password
+	if form.Password != nil {
+		// Hash the password
+		hashedPwd, err := s.AuthUseCase.HashPassword(*form.Password)
+
+		// Return an error if any
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while hashing the password",
+			}
+		}
+
+		fields["password"] = hashedPwd
+	}
+
+	// Update the vendor staff
+	if err := s.VendorStaffRepository.Update(claims.Id, staffID, fields); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while updating the staff",
+		}
+	}
+
+	// Revoke the refresh tokens of the staff when the password is changed
+	if form.Password != nil {
+		if err := s.RefreshTokenRepository.RevokeUsingAccount(claims.Id, int(enums.Vendor), staffID); err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while revoking the refresh tokens",
+			}
+		}
+	}
+
+	return s.getStaff(claims.Id, staffID)
+}
+
+// ProcessDeleteStaff is a function that processes the delete vendor staff use case,
+// the tokens of the deleted staff stop working right away.
+//
+// token: The vendor token.
+// staffID: The vendor staff ID.
+//
+// Returns an error if any.
+func (s *StaffUseCase) ProcessDeleteStaff(token *jwt.Token, staffID uint) *entities.ProcessError {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Check if the staff exists
+	if _, processErr := s.getStaff(claims.Id, staffID); processErr != nil {
+		return processErr
+	}
+
+	// Revoke the refresh tokens of the staff
+	if err := s.RefreshTokenRepository.RevokeUsingAccount(claims.Id, int(enums.Vendor), staffID); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while revoking the refresh tokens",
+		}
+	}
+
+	// Delete the vendor staff
+	if err := s.VendorStaffRepository.Delete(claims.Id, staffID); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while deleting the staff",
+		}
+	}
+
+	return nil
+}
+
+// RecordActivity is a function that records the change made by the signed in staff.
+//
+// token: The vendor token.
+// staff: The vendor staff, nil when signed in with the vendor account.
+// method: The HTTP method of the request.
+// path: The path of the request.
+// status: The HTTP status code of the response.
+//
+// Returns an error if any.
+func (s *StaffUseCase) RecordActivity(token *jwt.Token, staff *models.VendorStaff, method string, path string, status int) *entities.ProcessError {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Create a new staff activity
+	activity := models.StaffActivity{
+		VendorID: claims.Id,
+		Method:   method,
+		Path:     path,
+		Status:   status,
+	}
+
+	// Attribute the activity to the staff if any
+	if staff != nil {
+		activity.StaffID = &staff.ID
+		activity.StaffName = &staff.Name
+	}
+
+	// Save the staff activity
+	if err := s.StaffActivityRepository.Create(&activity); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while recording the activity",
+		}
+	}
+
+	return nil
+}
+
+// GetActivities is a function that returns a page of the staff activities of the current vendor.
+//
+// token: The vendor token.
+// page: The page of the staff activities.
+//
+// Returns the staff activities, the next cursor or nil on the last page, and an error if any.
+func (s *StaffUseCase) GetActivities(token *jwt.Token, page *types.Page) (*[]models.StaffActivity, *types.Cursor, *entities.ProcessError) {
+	// Get the token claims
+	claims := s.AuthUseCase.DecodeToken(token)
+
+	// Get the staff activities
+	activities, next, err := s.StaffActivityRepository.GetUsingVendorID(claims.Id, page)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the staff activities",
+		}
+	}
+
+	return activities, next, nil
+}
+
+// getStaff is a helper function that returns the vendor staff of the vendor.
+//
+// vendorID: The vendor ID.
+// staffID: The vendor staff ID.
+//
+// Returns the vendor staff and an error if any.
+func (s *StaffUseCase) getStaff(vendorID uint, staffID uint) (*models.VendorStaff, *entities.ProcessError) {
+	// Get the vendor staff by ID
+	staff, err := s.VendorStaffRepository.GetUsingID(vendorID, staffID)
+
+	// Return an error if the staff is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Staff not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the staff",
+		}
+	}
+
+	return staff, nil
+}
@@ -0,0 +1,793 @@
+package usecases
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"io"
+	"log"
+	"main/core/config"
+	"main/core/constants"
+	"main/domain/entities"
+	"main/internal/providers/storage"
+	"main/pkg/utils"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	// Register the image decoders
+	_ "image/gif"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+
+	xdraw "golang.org/x/image/draw"
+)
+
+// allowedImageTypes is the list of the image MIME types that can be uploaded.
+var allowedImageTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+	"image/gif":  true,
+	"image/webp": true,
+}
+
+// allowedDocumentTypes is the document MIME types that can be uploaded,
+// mapped to their file extension.
+var allowedDocumentTypes = map[string]string{
+	"application/pdf": ".pdf",
+	"image/jpeg":      ".jpg",
+	"image/png":       ".png",
+}
+
+// UploadUseCase is a struct that defines the use case for the image and document uploads.
+// Every uploaded image is validated, re-encoded as JPEG without its metadata,
+// and saved with a content hashed file name along with its thumbnail sizes.
+// Every uploaded document is validated and saved as is with a random file name into the private storage.
+type UploadUseCase struct{}
+
+// NewUploadUseCase is a factory function that returns a new instance of the UploadUseCase struct.
+//
+// Returns a new instance of the UploadUseCase.
+func NewUploadUseCase() *UploadUseCase {
+	return &UploadUseCase{}
+}
+
+// DecodeBase64Image is a function that decodes a base64 encoded image,
+// a data URL prefix is allowed.
+//
+// data: The base64 encoded image.
+//
+// Returns the image bytes and an error if any.
+func (u *UploadUseCase) DecodeBase64Image(data string) ([]byte, *entities.ProcessError) {
+	return u.decodeBase64File(data, "image")
+}
+
+// ReadImageFile is a function that reads an uploaded multipart image file.
+//
+// file: The uploaded image file.
+//
+// Returns the image bytes and an error if any.
+func (u *UploadUseCase) ReadImageFile(file *multipart.FileHeader) ([]byte, *entities.ProcessError) {
+	return u.readUploadedFile(file, "image")
+}
+
+// decodeBase64File is a helper function that decodes a base64 encoded file,
+// a data URL prefix is allowed.
+//
+// data: The base64 encoded file.
+// kind: The kind of the file used in the error messages, like "image".
+//
+// Returns the file bytes and an error if any.
+func (u *UploadUseCase) decodeBase64File(data string, kind string) ([]byte, *entities.ProcessError) {
+	// Remove the data URL prefix if any
+	if strings.HasPrefix(data, "data:") {
+		if comma := strings.Index(data, ","); comma >= 0 {
+			data = data[comma+1:]
+		}
+	}
+
+	// Return an error if the encoded file is already too large
+	if int64(base64.StdEncoding.DecodedLen(len(data))) > config.UploadConfig.MaxSize+2 {
+		return nil, u.tooLargeError(kind)
+	}
+
+	// Decode the file
+	fileBytes, err := base64.StdEncoding.DecodeString(data)
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to decode base64 "+kind+": ", err)
+
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     fmt.Sprintf("Invalid %s encoding", kind),
+		}
+	}
+
+	return fileBytes, nil
+}
+
+// openUploadedFile is a helper function that opens an uploaded multipart file.
+//
+// file: The uploaded file.
+// kind: The kind of the file used in the error messages, like "image".
+//
+// Returns the opened file and an error if any.
+func (u *UploadUseCase) openUploadedFile(file *multipart.FileHeader, kind string) (multipart.File, *entities.ProcessError) {
+	// Return an error if the file is too large
+	if file.Size > config.UploadConfig.MaxSize {
+		return nil, u.tooLargeError(kind)
+	}
+
+	// Open the file
+	src, err := file.Open()
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to open uploaded "+kind+": ", err)
+
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     fmt.Sprintf("An error occurred while reading the %s", kind),
+		}
+	}
+
+	return src, nil
+}
+
+// readUploadedFile is a helper function that reads an uploaded multipart file into memory.
+// Only the images are read this way on purpose, they're decoded and re-encoded as a whole,
+// so they're buffered up to the maximum upload size, the other files are streamed to the storage.
+//
+// file: The uploaded file.
+// kind: The kind of the file used in the error messages, like "image".
+//
+// Returns the file bytes and an error if any.
+func (u *UploadUseCase) readUploadedFile(file *multipart.FileHeader, kind string) ([]byte, *entities.ProcessError) {
+	// Open the file
+	src, processErr := u.openUploadedFile(file, kind)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	defer src.Close()
+
+	// Read the file, never more than the maximum size
+	fileBytes, err := io.ReadAll(io.LimitReader(src, config.UploadConfig.MaxSize+1))
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to read uploaded "+kind+": ", err)
+
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     fmt.Sprintf("An error occurred while reading the %s", kind),
+		}
+	}
+
+	return fileBytes, nil
+}
+
+// readFormImage is a helper function that reads the image of a form, from the
+// uploaded file of a multipart request or from the base64 encoded image.
+//
+// data: The base64 encoded image.
+// file: The uploaded image file, nil for JSON requests.
+//
+// Returns the image bytes and an error if any.
+func (u *UploadUseCase) readFormImage(data string, file *multipart.FileHeader) ([]byte, *entities.ProcessError) {
+	// Read the uploaded file if any
+	if file != nil {
+		return u.ReadImageFile(file)
+	}
+
+	return u.DecodeBase64Image(data)
+}
+
+// tooLargeError is a helper function that returns the file too large error.
+//
+// kind: The kind of the file, like "image".
+//
+// Returns the process error.
+func (u *UploadUseCase) tooLargeError(kind string) *entities.ProcessError {
+	return &entities.ProcessError{
+		ClientError: true,
+		Message:     fmt.Sprintf("%s must be at most %d MB", utils.ToUpperFirst(kind), config.UploadConfig.MaxSize>>20),
+	}
+}
+
+// ValidateImage is a function that validates the image size, type and dimension
+// without decoding the whole image.
+//
+// fileBytes: The image bytes.
+//
+// Returns an error if any.
+func (u *UploadUseCase) ValidateImage(fileBytes []byte) *entities.ProcessError {
+	// Return an error if the image is too large
+	if int64(len(fileBytes)) > config.UploadConfig.MaxSize {
+		return u.tooLargeError("image")
+	}
+
+	// Return an error if the real image type is not allowed
+	if !allowedImageTypes[http.DetectContentType(fileBytes)] {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Image must be a JPEG, PNG, GIF or WebP image",
+		}
+	}
+
+	// Read the image dimension before decoding the whole image
+	imageConfig, _, err := image.DecodeConfig(bytes.NewReader(fileBytes))
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to decode image config: ", err)
+
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Invalid image",
+		}
+	}
+
+	// Return an error if the image dimension is not allowed
+	if imageConfig.Width <= 0 || imageConfig.Height <= 0 ||
+		imageConfig.Width > config.UploadConfig.MaxDimension || imageConfig.Height > config.UploadConfig.MaxDimension {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     fmt.Sprintf("Image width and height must be at most %d pixels", config.UploadConfig.MaxDimension),
+		}
+	}
+
+	return nil
+}
+
+// ValidateFormImage is a function that reads the image of a form and validates it.
+//
+// data: The base64 encoded image.
+// file: The uploaded image file, nil for JSON requests.
+//
+// Returns a string of error.
+func (u *UploadUseCase) ValidateFormImage(data string, file *multipart.FileHeader) string {
+	// Read the image
+	fileBytes, processErr := u.readFormImage(data, file)
+
+	// Validate the image if it's read
+	if processErr == nil {
+		processErr = u.ValidateImage(fileBytes)
+	}
+
+	// Return the error message if any
+	if processErr != nil {
+		return processErr.Message.(string)
+	}
+
+	return ""
+}
+
+// SaveImage is a function that validates the image, re-encodes it, and saves it
+// with its thumbnail sizes into the given storage path.
+//
+// fileBytes: The image bytes.
+// dir: The storage path to save the image to.
+//
+// Returns the content hashed image file name and an error if any.
+func (u *UploadUseCase) SaveImage(fileBytes []byte, dir string) (string, *entities.ProcessError) {
+	// Validate the image
+	processErr := u.ValidateImage(fileBytes)
+
+	// Return an error if any
+	if processErr != nil {
+		return "", processErr
+	}
+
+	// Decode the image
+	decoded, _, err := image.Decode(bytes.NewReader(fileBytes))
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to decode image: ", err)
+
+		return "", &entities.ProcessError{
+			ClientError: true,
+			Message:     "Invalid image",
+		}
+	}
+
+	// Flatten the image on a white background, since JPEG has no transparency,
+	// and apply the EXIF orientation before the metadata is dropped
+	img := orientImage(flattenImage(decoded), utils.GetJPEGOrientation(fileBytes))
+
+	// Encode the original size
+	original, processErr := encodeJPEG(img)
+
+	// Return an error if any
+	if processErr != nil {
+		return "", processErr
+	}
+
+	// Create the content hashed image name
+	hash := sha256.Sum256(original)
+	imageName := hex.EncodeToString(hash[:]) + ".jpg"
+
+	// Write the original size
+	processErr = writeImageFile(dir, imageName, original)
+
+	// Return an error if any
+	if processErr != nil {
+		return "", processErr
+	}
+
+	// Loop through the thumbnail sizes
+	for size, maxDimension := range constants.IMAGE_SIZES {
+		// Encode the original size when the image is smaller than the thumbnail size
+		encoded := original
+
+		// Resize the image when it's larger than the thumbnail size
+		if bounds := img.Bounds(); bounds.Dx() > maxDimension || bounds.Dy() > maxDimension {
+			encoded, processErr = encodeJPEG(resizeImage(img, maxDimension))
+
+			// Return an error if any
+			if processErr != nil {
+				return "", processErr
+			}
+		}
+
+		// Write the thumbnail size
+		processErr = writeImageFile(dir, utils.ImageVariantName(imageName, size), encoded)
+
+		// Return an error if any
+		if processErr != nil {
+			return "", processErr
+		}
+	}
+
+	return imageName, nil
+}
+
+// SaveFormImage is a function that reads the image of a form and saves it.
+//
+// data: The base64 encoded image.
+// file: The uploaded image file, nil for JSON requests.
+// dir: The storage path to save the image to.
+//
+// Returns the content hashed image file name and an error if any.
+func (u *UploadUseCase) SaveFormImage(data string, file *multipart.FileHeader, dir string) (string, *entities.ProcessError) {
+	// Read the image
+	fileBytes, processErr := u.readFormImage(data, file)
+
+	// Return an error if any
+	if processErr != nil {
+		return "", processErr
+	}
+
+	return u.SaveImage(fileBytes, dir)
+}
+
+// ValidateDocument is a function that validates the document size and type.
+//
+// fileBytes: The document bytes.
+//
+// Returns the real content type of the document and an error if any.
+func (u *UploadUseCase) ValidateDocument(fileBytes []byte) (string, *entities.ProcessError) {
+	// Return an error if the document is empty
+	if len(fileBytes) == 0 {
+		return "", &entities.ProcessError{
+			ClientError: true,
+			Message:     "Document is empty",
+		}
+	}
+
+	// Return an error if the document is too large
+	if int64(len(fileBytes)) > config.UploadConfig.MaxSize {
+		return "", u.tooLargeError("document")
+	}
+
+	// Get the real document type
+	contentType := http.DetectContentType(fileBytes)
+
+	// Return an error if the real document type is not allowed
+	if _, ok := allowedDocumentTypes[contentType]; !ok {
+		return "", &entities.ProcessError{
+			ClientError: true,
+			Message:     "Document must be a PDF, JPEG or PNG file",
+		}
+	}
+
+	return contentType, nil
+}
+
+// ValidateFormDocument is a function that validates the document of a form, from the
+// uploaded file of a multipart request or from the base64 encoded document, only the
+// beginning of the uploaded file is read to detect its type.
+//
+// data: The base64 encoded document.
+// file: The uploaded document file, nil for JSON requests.
+//
+// Returns the real content type of the document and an error if any.
+func (u *UploadUseCase) ValidateFormDocument(data string, file *multipart.FileHeader) (string, *entities.ProcessError) {
+	// Validate the base64 encoded document if there is no uploaded file
+	if file == nil {
+		fileBytes, processErr := u.decodeBase64File(data, "document")
+
+		// Return an error if any
+		if processErr != nil {
+			return "", processErr
+		}
+
+		return u.ValidateDocument(fileBytes)
+	}
+
+	// Open the file
+	src, processErr := u.openUploadedFile(file, "document")
+
+	// Return an error if any
+	if processErr != nil {
+		return "", processErr
+	}
+
+	defer src.Close()
+
+	// Read the beginning of the document the content type is detected from
+	header := make([]byte, 512)
+
+	n, err := io.ReadFull(src, header)
+
+	// Return an error if any
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		log.Println("Failed to read uploaded document: ", err)
+
+		return "", &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while reading the document",
+		}
+	}
+
+	return u.ValidateDocument(header[:n])
+}
+
+// SaveFormDocument is a function that saves the document of a form, the uploaded file of
+// a multipart request is streamed to the private storage, the base64 encoded document is saved with SaveDocument.
+//
+// data: The base64 encoded document.
+// file: The uploaded document file, nil for JSON requests.
+// contentType: The content type of the document, validated with ValidateFormDocument.
+// dir: The storage path to save the document to.
+//
+// Returns the random document file name and an error if any.
+func (u *UploadUseCase) SaveFormDocument(data string, file *multipart.FileHeader, contentType string, dir string) (string, *entities.ProcessError) {
+	// Save the base64 encoded document if there is no uploaded file
+	if file == nil {
+		fileBytes, processErr := u.decodeBase64File(data, "document")
+
+		// Return an error if any
+		if processErr != nil {
+			return "", processErr
+		}
+
+		return u.SaveDocument(fileBytes, contentType, dir)
+	}
+
+	// Create the random document name
+	documentName, processErr := u.newDocumentName(contentType)
+
+	// Return an error if any
+	if processErr != nil {
+		return "", processErr
+	}
+
+	// Open the file
+	src, processErr := u.openUploadedFile(file, "document")
+
+	// Return an error if any
+	if processErr != nil {
+		return "", processErr
+	}
+
+	defer src.Close()
+
+	// Stream the document to the storage
+	err := storage.PrivateStore.PutReader(fmt.Sprintf("%s/%s", dir, documentName), src, file.Size, contentType)
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to save document: ", err)
+
+		return "", &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while saving the document",
+		}
+	}
+
+	return documentName, nil
+}
+
+// newDocumentName is a helper function that returns a random document file name,
+// so that the private documents can't be guessed.
+//
+// contentType: The content type of the document.
+//
+// Returns the random document file name and an error if any.
+func (u *UploadUseCase) newDocumentName(contentType string) (string, *entities.ProcessError) {
+	// Create the random document name
+	random := make([]byte, 32)
+
+	// Return an error if any
+	if _, err := rand.Read(random); err != nil {
+		log.Println("Failed to generate document name: ", err)
+
+		return "", &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while saving the document",
+		}
+	}
+
+	return hex.EncodeToString(random) + allowedDocumentTypes[contentType], nil
+}
+
+// SaveDocument is a function that saves the document as is, with a random file name
+// into the given private storage path.
+//
+// fileBytes: The document bytes, validated with ValidateDocument.
+// contentType: The content type of the document.
+// dir: The storage path to save the document to.
+//
+// Returns the random document file name and an error if any.
+func (u *UploadUseCase) SaveDocument(fileBytes []byte, contentType string, dir string) (string, *entities.ProcessError) {
+	// Create the random document name
+	documentName, processErr := u.newDocumentName(contentType)
+
+	// Return an error if any
+	if processErr != nil {
+		return "", processErr
+	}
+
+	// Write the document to the storage
+	err := storage.PrivateStore.Put(fmt.Sprintf("%s/%s", dir, documentName), fileBytes, contentType)
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to save document: ", err)
+
+		return "", &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while saving the document",
+		}
+	}
+
+	return documentName, nil
+}
+
+// GetDocument is a function that reads the document from the private storage.
+//
+// dir: The storage path of the document.
+// documentName: The document file name.
+//
+// Returns the document bytes and an error if any.
+func (u *UploadUseCase) GetDocument(dir string, documentName string) ([]byte, *entities.ProcessError) {
+	// Read the document from the storage
+	data, err := storage.PrivateStore.Get(fmt.Sprintf("%s/%s", dir, documentName))
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to get document: ", err)
+
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while reading the document",
+		}
+	}
+
+	return data, nil
+}
+
+// RemoveDocument is a function that removes the document from the private storage.
+//
+// dir: The storage path of the document.
+// documentName: The document file name.
+//
+// Returns nothing.
+func (u *UploadUseCase) RemoveDocument(dir string, documentName string) {
+	// Remove the file
+	err := storage.PrivateStore.Delete(fmt.Sprintf("%s/%s", dir, documentName))
+
+	// Log the error if any
+	if err != nil {
+		log.Println("Failed to remove document file: ", err)
+	}
+}
+
+// RemoveImage is a function that removes the image and its thumbnail sizes from the storage.
+//
+// dir: The storage path of the image.
+// imageName: The image file name.
+//
+// Returns nothing.
+func (u *UploadUseCase) RemoveImage(dir string, imageName string) {
+	// Create the list of the files to remove
+	fileNames := []string{imageName}
+
+	// Append the thumbnail sizes of the content hashed images
+	if utils.IsHashedImageName(imageName) {
+		for size := range constants.IMAGE_SIZES {
+			fileNames = append(fileNames, utils.ImageVariantName(imageName, size))
+		}
+	}
+
+	// Loop through the files
+	for _, fileName := range fileNames {
+		// Remove the file
+		err := storage.Store.Delete(fmt.Sprintf("%s/%s", dir, fileName))
+
+		// Log the error if any
+		if err != nil {
+			log.Println("Failed to remove image file: ", err)
+		}
+	}
+}
+
+// writeImageFile is a helper function that writes the image file to the storage,
+// files with the same content hashed name already exist are kept.
+//
+// dir: The storage path of the image.
+// fileName: The image file name.
+// data: The image bytes.
+//
+// Returns an error if any.
+func writeImageFile(dir string, fileName string, data []byte) *entities.ProcessError {
+	// Create the image key
+	key := fmt.Sprintf("%s/%s", dir, fileName)
+
+	// Skip the file if it already exists
+	if exists, err := storage.Store.Exists(key); err == nil && exists {
+		return nil
+	}
+
+	// Write the image to the storage
+	err := storage.Store.Put(key, data, "image/jpeg")
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to save image: ", err)
+
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while saving the image",
+		}
+	}
+
+	return nil
+}
+
+// encodeJPEG is a helper function that encodes the image as JPEG.
+//
+// img: The image.
+//
+// Returns the JPEG bytes and an error if any.
+func encodeJPEG(img image.Image) ([]byte, *entities.ProcessError) {
+	// Create a buffer for the encoded image
+	var buf bytes.Buffer
+
+	// Encode the image
+	err := jpeg.Encode(&buf, img, &jpeg.Options{Quality: config.UploadConfig.JPEGQuality})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to encode image: ", err)
+
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while encoding the image",
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// flattenImage is a helper function that draws the image on a white background.
+//
+// img: The image.
+//
+// Returns the flattened image.
+func flattenImage(img image.Image) *image.RGBA {
+	// Get the image bounds starting at zero
+	bounds := image.Rect(0, 0, img.Bounds().Dx(), img.Bounds().Dy())
+
+	// Create the white background
+	flattened := image.NewRGBA(bounds)
+	draw.Draw(flattened, bounds, &image.Uniform{C: color.White}, image.Point{}, draw.Src)
+
+	// Draw the image over the background
+	draw.Draw(flattened, bounds, img, img.Bounds().Min, draw.Over)
+
+	return flattened
+}
+
+// orientImage is a helper function that rotates and flips the image following its EXIF orientation.
+//
+// img: The image.
+// orientation: The EXIF orientation.
+//
+// Returns the oriented image.
+func orientImage(img *image.RGBA, orientation int) *image.RGBA {
+	// Return the image if it doesn't need to be oriented
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	// Get the image size
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+
+	// Swap the width and height for the rotated orientations
+	dw, dh := w, h
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+
+	// Create the oriented image
+	oriented := image.NewRGBA(image.Rect(0, 0, dw, dh))
+
+	// Loop through the oriented image pixels
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			// Get the source pixel of the oriented pixel
+			var sx, sy int
+
+			switch orientation {
+			case 2:
+				sx, sy = w-1-x, y
+			case 3:
+				sx, sy = w-1-x, h-1-y
+			case 4:
+				sx, sy = x, h-1-y
+			case 5:
+				sx, sy = y, x
+			case 6:
+				sx, sy = y, h-1-x
+			case 7:
+				sx, sy = w-1-y, h-1-x
+			case 8:
+				sx, sy = w-1-y, x
+			}
+
+			oriented.SetRGBA(x, y, img.RGBAAt(sx, sy))
+		}
+	}
+
+	return oriented
+}
+
+// resizeImage is a helper function that scales the image down to fit the maximum dimension.
+//
+// img: The image.
+// maxDimension: The maximum width and height.
+//
+// Returns the resized image.
+func resizeImage(img image.Image, maxDimension int) image.Image {
+	// Get the image size
+	w, h := img.Bounds().Dx(), img.Bounds().Dy()
+
+	// Scale the size keeping the aspect ratio
+	if w >= h {
+		h = max(1, h*maxDimension/w)
+		w = maxDimension
+	} else {
+		w = max(1, w*maxDimension/h)
+		h = maxDimension
+	}
+
+	// Create the resized image
+	resized := image.NewRGBA(image.Rect(0, 0, w, h))
+	xdraw.CatmullRom.Scale(resized, resized.Bounds(), img, img.Bounds(), xdraw.Src, nil)
+
+	return resized
+}
@@ -1,37 +1,44 @@
 package usecases
 
 import (
-	"encoding/base64"
 	"fmt"
-	"log"
 	"main/core/constants"
+	"main/core/enums"
 	"main/core/types"
 	"main/data/models"
 	"main/domain/entities"
 	"main/internal/dto"
 	"main/internal/repository"
 	"main/pkg/utils"
-	"os"
+	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
 )
 
 // UserUseCase is a struct that defines the use case for the user entity.
 type UserUseCase struct {
-	AuthUseCase    *AuthUseCase
-	UserRepository *repository.UserRepository
+	AuthUseCase            *AuthUseCase
+	UserRepository         *repository.UserRepository
+	RefreshTokenRepository *repository.RefreshTokenRepository
+	UploadUseCase          *UploadUseCase
 }
 
 // NewUserUseCase is a factory function that returns a new instance of the UserUseCase struct.
 //
 // a: The auth use case.
 // u: The user repository.
+// r: The refresh token repository.
+// up: The upload use case.
 //
 // Returns a new instance of the UserUseCase.
-func NewUserUseCase(a *AuthUseCase, u *repository.UserRepository) *UserUseCase {
+func NewUserUseCase(a *AuthUseCase, u *repository.UserRepository, r *repository.RefreshTokenRepository, up *UploadUseCase) *UserUseCase {
 	return &UserUseCase{
-		AuthUseCase:    a,
-		UserRepository: u,
+		AuthUseCase:            a,
+		UserRepository:         u,
+		RefreshTokenRepository: r,
+		UploadUseCase:          up,
 	}
 }
 
@@ -89,7 +96,8 @@ func (u *UserUseCase) ValidateChangePasswordForm(form *dto.ChangePasswordFormDTO
 	return nil
 }
 
-// ProcessChangePassword is a function that processes the change password use case.
+// ProcessChangePassword is a function that processes the change password use case,
+// the refresh tokens of the user are revoked so the other sessions have to log in again.
 //
 // token: The user token.
 // form: The change password form dto.
@@ -142,6 +150,14 @@ func (u *UserUseCase) ProcessChangePassword(token *jwt.Token, form *dto.ChangePa
 		}
 	}
 
+	// Revoke the refresh tokens of the user
+	if err := u.RefreshTokenRepository.RevokeUsingClient(claims.Id, int(enums.User)); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while revoking the refresh tokens",
+		}
+	}
+
 	return nil
 }
 
@@ -223,7 +239,7 @@ func (u *UserUseCase) ProcessChangeUsername(token *jwt.Token, form *dto.ChangeUs
 // Returns a string of error.
 func (u *UserUseCase) ValidateChangeProfilePictureData(data *dto.ChangeUserProfilePictureDTO) string {
 	// Check if the profile picture is empty
-	if utils.IsBlank(data.Image) {
+	if utils.IsBlank(data.Image) && data.ImageFile == nil {
 		return "Image is required"
 	}
 
@@ -235,50 +251,219 @@ func (u *UserUseCase) ValidateChangeProfilePictureData(data *dto.ChangeUserProfi
 // token: The user token.
 // data: The change profile picture dto.
 //
-// Returns an error if any.
-func (u *UserUseCase) ProcessChangeProfilePicture(token *jwt.Token, data *dto.ChangeUserProfilePictureDTO) *entities.ProcessError {
+// Returns the updated user and an error if any.
+func (u *UserUseCase) ProcessChangeProfilePicture(token *jwt.Token, data *dto.ChangeUserProfilePictureDTO) (*models.User, *entities.ProcessError) {
 	// Get the user ID from the token
 	claims := u.AuthUseCase.DecodeToken(token)
 
-	// Decode the image
-	fileBytes, err := base64.StdEncoding.DecodeString(data.Image)
+	// Get the user
+	user, err := u.UserRepository.GetUsingID(claims.Id)
 
 	// Return an error if any
 	if err != nil {
-		log.Println("Failed to decode court image: ", err)
-
-		return &entities.ProcessError{
-			Message:     "An error occurred while decoding the image",
+		return nil, &entities.ProcessError{
+			Message:     "An error occurred while getting the user",
 			ClientError: false,
 		}
 	}
 
-	// Create the user image name
-	userImageName := fmt.Sprintf("user_%d.jpg", claims.Id)
+	// Save the profile picture
+	userImageName, processErr := u.UploadUseCase.SaveFormImage(data.Image, data.ImageFile, constants.PATH_TO_USER_PROFILE_PICTURES)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
 
-	// Write the image to a file
-	err = os.WriteFile(fmt.Sprintf("%s/%s", constants.PATH_TO_USER_PROFILE_PICTURES, userImageName), fileBytes, 0644)
+	// Update the profile picture
+	err = u.UserRepository.UpdateProfilePicture(claims.Id, userImageName)
 
 	// Return an error if any
 	if err != nil {
-		log.Println("Failed to save user image: ", err)
+		return nil, &entities.ProcessError{
+			Message:     "An error occurred while updating the profile picture",
+			ClientError: false,
+		}
+	}
+
+	// Get the previous profile picture
+	previousImageName := user.ProfilePicture
+
+	// Set the new profile picture
+	user.ProfilePicture = userImageName
+
+	// Return the user if there is no previous profile picture to remove
+	if utils.IsBlank(previousImageName) || previousImageName == userImageName {
+		return user, nil
+	}
+
+	// Check if the previous profile picture is still used
+	used, err := u.UserRepository.IsProfilePictureUsed(previousImageName)
+
+	// Remove the previous profile picture if it's no longer used
+	if err == nil && !used {
+		u.UploadUseCase.RemoveImage(constants.PATH_TO_USER_PROFILE_PICTURES, previousImageName)
+	}
 
+	return user, nil
+}
+
+// CheckCurrentUserActive is a function that checks if the current user can still
+// use its token, the user may have been disabled after the token was issued.
+//
+// token: The user token.
+//
+// Returns an error if the user can't use the token.
+func (u *UserUseCase) CheckCurrentUserActive(token *jwt.Token) *entities.ProcessError {
+	// Get the token claims
+	claims := u.AuthUseCase.DecodeToken(token)
+
+	// Get the user
+	user, processErr := u.GetUser(claims.Id)
+
+	// Return an error if any
+	if processErr != nil {
+		return processErr
+	}
+
+	// Return an error if the user is disabled
+	if user.DisabledAt != nil {
 		return &entities.ProcessError{
-			Message:     "An error occurred while writing the image to a file",
+			ClientError: true,
+			Message:     "User is disabled",
+		}
+	}
+
+	return nil
+}
+
+// GetUsers is a function that returns a page of the users.
+//
+// search: The username search, empty for every user.
+// page: The page of the users.
+//
+// Returns the users, the next cursor or nil on the last page, and an error if any.
+func (u *UserUseCase) GetUsers(search string, page *types.Page) (*[]models.User, *types.Cursor, *entities.ProcessError) {
+	// Get the users
+	users, next, err := u.UserRepository.Get(strings.TrimSpace(search), page)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
 			ClientError: false,
+			Message:     "An error occurred while getting the users",
 		}
 	}
 
-	// Update the profile picture
-	err = u.UserRepository.UpdateProfilePicture(claims.Id, userImageName)
+	return users, next, nil
+}
+
+// GetUser is a function that returns the user.
+//
+// userID: The user ID.
+//
+// Returns the user and an error if any.
+func (u *UserUseCase) GetUser(userID uint) (*models.User, *entities.ProcessError) {
+	// Get the user by ID
+	user, err := u.UserRepository.GetUsingID(userID)
+
+	// Return an error if the user is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "User not found",
+		}
+	}
 
 	// Return an error if any
 	if err != nil {
-		return &entities.ProcessError{
-			Message:     "An error occurred while updating the profile picture",
+		return nil, &entities.ProcessError{
 			ClientError: false,
+			Message:     "An error occurred while getting the user",
 		}
 	}
 
-	return nil
+	return user, nil
+}
+
+// DisableUser is a function that disables the user, the disabled user can't log in
+// or use its tokens until it's enabled and its refresh tokens are revoked.
+//
+// userID: The user ID.
+//
+// Returns the disabled user and an error if any.
+func (u *UserUseCase) DisableUser(userID uint) (*models.User, *entities.ProcessError) {
+	// Get the user
+	user, processErr := u.GetUser(userID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Return an error if the user is already disabled
+	if user.DisabledAt != nil {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "User is already disabled",
+		}
+	}
+
+	// Get the disabled time
+	disabledAt := time.Now()
+
+	// Disable the user
+	if err := u.UserRepository.UpdateDisabledAt(userID, &disabledAt); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while disabling the user",
+		}
+	}
+
+	// Revoke the refresh tokens of the user
+	if err := u.RefreshTokenRepository.RevokeUsingClient(userID, int(enums.User)); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while revoking the refresh tokens",
+		}
+	}
+
+	user.DisabledAt = &disabledAt
+
+	return user, nil
+}
+
+// EnableUser is a function that enables the disabled user.
+//
+// userID: The user ID.
+//
+// Returns the enabled user and an error if any.
+func (u *UserUseCase) EnableUser(userID uint) (*models.User, *entities.ProcessError) {
+	// Get the user
+	user, processErr := u.GetUser(userID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Return an error if the user is not disabled
+	if user.DisabledAt == nil {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "User is not disabled",
+		}
+	}
+
+	// Enable the user
+	if err := u.UserRepository.UpdateDisabledAt(userID, nil); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while enabling the user",
+		}
+	}
+
+	user.DisabledAt = nil
+
+	return user, nil
 }
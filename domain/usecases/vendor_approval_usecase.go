@@ -0,0 +1,292 @@
+package usecases
+
+import (
+	"fmt"
+	"main/core/constants"
+	"main/core/enums"
+	"main/core/types"
+	"main/data/models"
+	"main/domain/entities"
+	"main/internal/dto"
+	"main/internal/repository"
+	"main/pkg/utils"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// VendorApprovalUseCase is a struct that defines the use case for the admin review
+// of the vendor registrations.
+type VendorApprovalUseCase struct {
+	VendorRepository       *repository.VendorRepository
+	RefreshTokenRepository *repository.RefreshTokenRepository
+	UploadUseCase          *UploadUseCase
+}
+
+// NewVendorApprovalUseCase is a factory function that returns a new instance of the VendorApprovalUseCase.
+//
+// v: The vendor repository.
+// r: The refresh token repository.
+// u: The upload use case.
+//
+// Returns a new instance of the VendorApprovalUseCase.
+func NewVendorApprovalUseCase(v *repository.VendorRepository, r *repository.RefreshTokenRepository, u *UploadUseCase) *VendorApprovalUseCase {
+	return &VendorApprovalUseCase{
+		VendorRepository:       v,
+		RefreshTokenRepository: r,
+		UploadUseCase:          u,
+	}
+}
+
+// GetVendorStatus is a function that returns the vendor status label of the status,
+// the status is matched case insensitively.
+//
+// status: The vendor status.
+//
+// Returns the vendor status label and whether the status is valid.
+func (v *VendorApprovalUseCase) GetVendorStatus(status string) (string, bool) {
+	// Loop through the vendor statuses
+	for _, vendorStatus := range []enums.VendorStatus{enums.VendorPending, enums.VendorApproved, enums.VendorRejected, enums.VendorSuspended} {
+		if strings.EqualFold(status, vendorStatus.Label()) {
+			return vendorStatus.Label(), true
+		}
+	}
+
+	return "", false
+}
+
+// GetVendorApplications is a function that returns a page of the vendor registrations with the status.
+//
+// status: The vendor status label.
+// page: The page of the vendors.
+//
+// Returns the vendors, the next cursor or nil on the last page, and an error if any.
+func (v *VendorApprovalUseCase) GetVendorApplications(status string, page *types.Page) (*[]models.Vendor, *types.Cursor, *entities.ProcessError) {
+	// Get the vendors by status
+	vendors, next, err := v.VendorRepository.GetApplicationsUsingStatus(status, page)
+
+	// Return an error if any
+	if err != nil {
+		return nil, nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the vendors",
+		}
+	}
+
+	return vendors, next, nil
+}
+
+// GetVendorApplication is a function that returns the vendor registration.
+//
+// vendorID: The vendor ID.
+//
+// Returns the vendor and an error if any.
+func (v *VendorApprovalUseCase) GetVendorApplication(vendorID uint) (*models.Vendor, *entities.ProcessError) {
+	// Get the vendor by ID
+	vendor, err := v.VendorRepository.GetApplicationUsingID(vendorID)
+
+	// Return an error if the vendor is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Vendor not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the vendor",
+		}
+	}
+
+	return vendor, nil
+}
+
+// GetVendorDocument is a function that returns the vendor registration document and its content.
+//
+// vendorID: The vendor ID.
+// documentID: The document ID.
+//
+// Returns the document, the document bytes and an error if any.
+func (v *VendorApprovalUseCase) GetVendorDocument(vendorID uint, documentID uint) (*models.VendorDocument, []byte, *entities.ProcessError) {
+	// Get the vendor registration
+	vendor, processErr := v.GetVendorApplication(vendorID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, nil, processErr
+	}
+
+	// Loop through the vendor documents
+	for _, document := range vendor.Documents {
+		// Skip the other documents
+		if document.ID != documentID {
+			continue
+		}
+
+		// Read the document from the storage
+		data, processErr := v.UploadUseCase.GetDocument(constants.PATH_TO_VENDOR_DOCUMENTS, document.File)
+
+		// Return an error if any
+		if processErr != nil {
+			return nil, nil, processErr
+		}
+
+		return &document, data, nil
+	}
+
+	return nil, nil, &entities.ProcessError{
+		ClientError: true,
+		Message:     "Document not found",
+	}
+}
+
+// ApproveVendor is a function that approves the vendor registration, rejected
+// vendors can be approved after a new review.
+//
+// vendorID: The vendor ID.
+//
+// Returns the approved vendor and an error if any.
+func (v *VendorApprovalUseCase) ApproveVendor(vendorID uint) (*models.Vendor, *entities.ProcessError) {
+	return v.updateVendorStatus(vendorID, []string{enums.VendorPending.Label(), enums.VendorRejected.Label()}, enums.VendorApproved.Label(), "")
+}
+
+// ValidateRejectVendorForm is a function that validates the reject vendor form.
+//
+// form: The reject vendor form.
+//
+// Returns an error message if any.
+func (v *VendorApprovalUseCase) ValidateRejectVendorForm(form *dto.RejectVendorFormDTO) string {
+	return validateReason(&form.Reason)
+}
+
+// RejectVendor is a function that rejects the vendor registration waiting for approval.
+//
+// vendorID: The vendor ID.
+// form: The reject vendor form.
+//
+// Returns the rejected vendor and an error if any.
+func (v *VendorApprovalUseCase) RejectVendor(vendorID uint, form *dto.RejectVendorFormDTO) (*models.Vendor, *entities.ProcessError) {
+	return v.updateVendorStatus(vendorID, []string{enums.VendorPending.Label()}, enums.VendorRejected.Label(), form.Reason)
+}
+
+// ValidateSuspendVendorForm is a function that validates the suspend vendor form.
+//
+// form: The suspend vendor form.
+//
+// Returns an error message if any.
+func (v *VendorApprovalUseCase) ValidateSuspendVendorForm(form *dto.SuspendVendorFormDTO) string {
+	return validateReason(&form.Reason)
+}
+
+// SuspendVendor is a function that suspends the approved vendor, the suspended vendor can't
+// log in or use its tokens and is hidden from the users. The refresh tokens of the vendor
+// and its staff are revoked.
+//
+// vendorID: The vendor ID.
+// form: The suspend vendor form.
+//
+// Returns the suspended vendor and an error if any.
+func (v *VendorApprovalUseCase) SuspendVendor(vendorID uint, form *dto.SuspendVendorFormDTO) (*models.Vendor, *entities.ProcessError) {
+	// Suspend the vendor
+	vendor, processErr := v.updateVendorStatus(vendorID, []string{enums.VendorApproved.Label()}, enums.VendorSuspended.Label(), form.Reason)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Revoke the refresh tokens of the vendor and its staff
+	if err := v.RefreshTokenRepository.RevokeUsingClient(vendorID, int(enums.Vendor)); err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while revoking the refresh tokens",
+		}
+	}
+
+	return vendor, nil
+}
+
+// ReactivateVendor is a function that reactivates the suspended vendor.
+//
+// vendorID: The vendor ID.
+//
+// Returns the reactivated vendor and an error if any.
+func (v *VendorApprovalUseCase) ReactivateVendor(vendorID uint) (*models.Vendor, *entities.ProcessError) {
+	return v.updateVendorStatus(vendorID, []string{enums.VendorSuspended.Label()}, enums.VendorApproved.Label(), "")
+}
+
+// validateReason is a helper function that trims and validates the reason of a
+// rejection or a suspension.
+//
+// reason: The reason.
+//
+// Returns an error message if any.
+func validateReason(reason *string) string {
+	// Remove the leading and trailing spaces
+	*reason = strings.TrimSpace(*reason)
+
+	// Check if the reason is blank
+	if utils.IsBlank(*reason) {
+		return "Reason is required"
+	}
+
+	// Check if the reason is too long
+	if len(*reason) > constants.MAX_REJECTION_REASON_LENGTH {
+		return fmt.Sprintf("Reason must be at most %d characters long", constants.MAX_REJECTION_REASON_LENGTH)
+	}
+
+	return ""
+}
+
+// updateVendorStatus is a helper function that updates the vendor status when
+// the current status is one of the given statuses.
+//
+// vendorID: The vendor ID.
+// fromStatuses: The statuses the vendor can be updated from.
+// status: The new status.
+// reason: The rejection or suspension reason, empty otherwise.
+//
+// Returns the updated vendor and an error if any.
+func (v *VendorApprovalUseCase) updateVendorStatus(vendorID uint, fromStatuses []string, status string, reason string) (*models.Vendor, *entities.ProcessError) {
+	// Get the vendor registration
+	vendor, processErr := v.GetVendorApplication(vendorID)
+
+	// Return an error if any
+	if processErr != nil {
+		return nil, processErr
+	}
+
+	// Return an error if the vendor status can't be updated from its current status
+	if !slices.Contains(fromStatuses, vendor.Status) {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     fmt.Sprintf("Vendor is already %s", strings.ToLower(vendor.Status)),
+		}
+	}
+
+	// Update the vendor status, only if it's not changed in between
+	updated, err := v.VendorRepository.UpdateStatus(vendorID, fromStatuses, status, reason)
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while updating the vendor status",
+		}
+	}
+
+	// Return an error if the vendor status was changed in between
+	if !updated {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Vendor status has been changed, please try again",
+		}
+	}
+
+	// Get the updated vendor registration
+	return v.GetVendorApplication(vendorID)
+}
@@ -2,33 +2,56 @@ package usecases
 
 import (
 	"fmt"
+	"log"
+	"main/core/config"
 	"main/core/constants"
+	"main/core/enums"
 	"main/core/types"
 	"main/data/models"
 	"main/domain/entities"
 	"main/internal/dto"
+	"main/internal/providers/mailer"
 	"main/internal/repository"
 	"main/pkg/utils"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
+	"gorm.io/gorm"
 )
 
 // VendorUseCase is a struct that defines the vendor use case.
 type VendorUseCase struct {
-	AuthUseCase      *AuthUseCase
-	VendorRepository *repository.VendorRepository
+	AuthUseCase            *AuthUseCase
+	VendorRepository       *repository.VendorRepository
+	CourtRepository        *repository.CourtRepository
+	ReviewRepository       *repository.ReviewRepository
+	EmailChangeRepository  *repository.VendorEmailChangeRepository
+	RefreshTokenRepository *repository.RefreshTokenRepository
+	ScheduleUseCase        *ScheduleUseCase
 }
 
 // NewVendorUseCase is a factory function that returns a new instance of the VendorUseCase.
 //
 // a: The auth use case.
 // v: The vendor repository.
+// c: The court repository.
+// r: The review repository.
+// e: The vendor email change repository.
+// rt: The refresh token repository.
+// s: The schedule use case.
 //
 // Returns a new instance of the VendorUseCase.
-func NewVendorUseCase(a *AuthUseCase, v *repository.VendorRepository) *VendorUseCase {
+func NewVendorUseCase(a *AuthUseCase, v *repository.VendorRepository, c *repository.CourtRepository, r *repository.ReviewRepository, e *repository.VendorEmailChangeRepository, rt *repository.RefreshTokenRepository, s *ScheduleUseCase) *VendorUseCase {
 	return &VendorUseCase{
-		AuthUseCase:      a,
-		VendorRepository: v,
+		AuthUseCase:            a,
+		VendorRepository:       v,
+		CourtRepository:        c,
+		ReviewRepository:       r,
+		EmailChangeRepository:  e,
+		RefreshTokenRepository: rt,
+		ScheduleUseCase:        s,
 	}
 }
 
@@ -41,8 +64,8 @@ func (v *VendorUseCase) GetCurrentVendor(token *jwt.Token) (*models.Vendor, *ent
 	// Get the token claims
 	claims := v.AuthUseCase.DecodeToken(token)
 
-	// Get the vendor by ID
-	vendor, err := v.VendorRepository.GetUsingID(claims.Id)
+	// Get the vendor by ID with its pending email change
+	vendor, err := v.VendorRepository.GetCurrentUsingID(claims.Id)
 
 	// Check if there is an error
 	if err != nil {
@@ -55,6 +78,217 @@ func (v *VendorUseCase) GetCurrentVendor(token *jwt.Token) (*models.Vendor, *ent
 	return vendor, nil
 }
 
+// CheckCurrentVendorActive is a function that checks if the current vendor can still
+// use its token, the vendor may have been suspended after the token was issued.
+//
+// token: The token.
+//
+// Returns an error if the vendor can't use the token.
+func (v *VendorUseCase) CheckCurrentVendorActive(token *jwt.Token) *entities.ProcessError {
+	// Get the token claims
+	claims := v.AuthUseCase.DecodeToken(token)
+
+	// Get the vendor status by ID
+	vendor, err := v.VendorRepository.GetStatusUsingID(claims.Id)
+
+	// Return an error if the vendor is not found
+	if err == gorm.ErrRecordNotFound {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Vendor not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the vendor",
+		}
+	}
+
+	// Return an error if the vendor is suspended
+	if vendor.Status == enums.VendorSuspended.Label() {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Vendor is suspended: " + vendor.RejectionReason,
+		}
+	}
+
+	// Return an error if the vendor is not approved
+	if vendor.Status != enums.VendorApproved.Label() {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Vendor is not approved",
+		}
+	}
+
+	return nil
+}
+
+// GetVendorProfile is a function that returns the public profile of the vendor.
+// The court types, the reviews and the opening hours of the profile are queried concurrently.
+//
+// vendorID: The vendor ID.
+//
+// Returns the vendor profile map and an error if any.
+func (v *VendorUseCase) GetVendorProfile(vendorID uint) (*types.VendorProfileMap, *entities.ProcessError) {
+	// Get the vendor by ID
+	vendor, err := v.VendorRepository.GetUsingID(vendorID)
+
+	// Return an error if the vendor is not found, the vendors waiting
+	// for approval or rejected are not public
+	if err == gorm.ErrRecordNotFound || (err == nil && vendor.Status != enums.VendorApproved.Label()) {
+		return nil, &entities.ProcessError{
+			ClientError: true,
+			Message:     "Vendor not found",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the vendor",
+		}
+	}
+
+	// Create a new wait group and the profile parts, every part is
+	// written by its own goroutine
+	var (
+		wg           sync.WaitGroup
+		courtTypes   *[]types.CourtTypeSummary
+		totalRating  float64
+		reviewsTotal int64
+		starCounts   *types.StarCountsMap
+		reviews      *[]models.Review
+		openingHours *[]types.OpeningHours
+		errs         [6]error
+	)
+
+	wg.Add(6)
+
+	go func() {
+		defer wg.Done()
+
+		// Get the court types offered by the vendor
+		courtTypes, errs[0] = v.CourtRepository.GetCourtTypeSummariesUsingVendorID(vendorID)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		// Get the total rating
+		totalRating, errs[1] = v.ReviewRepository.GetAvgRatingUsingVendorID(vendorID)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		// Get the review count
+		reviewsTotal, errs[2] = v.ReviewRepository.GetCountUsingVendorID(vendorID)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		// Get the star counts
+		starCounts, errs[3] = v.ReviewRepository.GetStarCountsUsingVendorID(vendorID)
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		// Get the recent reviews
+		reviews, _, errs[4] = v.ReviewRepository.GetUsingVendorID(vendorID, &types.ReviewsFilter{}, &types.Page{Limit: constants.RECENT_REVIEWS_LIMIT})
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		// Get the opening hours of the upcoming days
+		openingHours, errs[5] = v.ScheduleUseCase.GetOpeningHoursUsingVendor(vendor, today(), constants.PROFILE_SCHEDULE_DAYS)
+	}()
+
+	// Wait for all goroutines to finish
+	wg.Wait()
+
+	// Return an error if any
+	for _, err := range errs {
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while getting the vendor profile",
+			}
+		}
+	}
+
+	return &types.VendorProfileMap{
+		"vendor":        vendor,
+		"court_types":   courtTypes,
+		"total_rating":  totalRating,
+		"reviews_total": reviewsTotal,
+		"star_counts":   starCounts,
+		"reviews":       reviews,
+		"opening_hours": openingHours,
+	}, nil
+}
+
+// ValidateUpdateLocationForm is a function that validates the update location form.
+//
+// form: The update vendor location form dto.
+//
+// Returns a map of errors.
+func (v *VendorUseCase) ValidateUpdateLocationForm(form *dto.UpdateVendorLocationFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if the latitude is valid
+	if form.Latitude == nil {
+		errs["latitude"] = append(errs["latitude"], "Latitude is required")
+	} else if *form.Latitude < -90 || *form.Latitude > 90 {
+		errs["latitude"] = append(errs["latitude"], "Latitude must be between -90 and 90")
+	}
+
+	// Check if the longitude is valid
+	if form.Longitude == nil {
+		errs["longitude"] = append(errs["longitude"], "Longitude is required")
+	} else if *form.Longitude < -180 || *form.Longitude > 180 {
+		errs["longitude"] = append(errs["longitude"], "Longitude must be between -180 and 180")
+	}
+
+	// Check if the errors map is not empty
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ProcessUpdateLocation is a function that processes the update location use case.
+//
+// token: The vendor token.
+// form: The update vendor location form dto.
+//
+// Returns the updated vendor and an error if any.
+func (v *VendorUseCase) ProcessUpdateLocation(token *jwt.Token, form *dto.UpdateVendorLocationFormDTO) (*models.Vendor, *entities.ProcessError) {
+	// Get the vendor ID from the token
+	claims := v.AuthUseCase.DecodeToken(token)
+
+	// Update the vendor's location
+	err := v.VendorRepository.UpdateLocation(claims.Id, *form.Latitude, *form.Longitude)
+
+	// Check if there is an error
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while updating the vendor's location",
+		}
+	}
+
+	return v.GetCurrentVendor(token)
+}
+
 // ValidateChangePasswordForm is a function that validates the change password form.
 //
 // form: The change password form dto.
@@ -97,7 +331,8 @@ func (v *VendorUseCase) ValidateChangePasswordForm(form *dto.ChangePasswordFormD
 	return nil
 }
 
-// ProcessChangePassword is a function that processes the change password use case.
+// ProcessChangePassword is a function that processes the change password use case, the refresh
+// tokens of the vendor account are revoked so the other sessions have to log in again.
 //
 // token: The vendor token.
 // form: The change password form dto.
@@ -150,5 +385,356 @@ func (v *VendorUseCase) ProcessChangePassword(token *jwt.Token, form *dto.Change
 		}
 	}
 
+	// Revoke the refresh tokens of the vendor account, the staff have their own passwords
+	if err := v.RefreshTokenRepository.RevokeUsingAccount(claims.Id, int(enums.Vendor), 0); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while revoking the refresh tokens",
+		}
+	}
+
+	return nil
+}
+
+// SanitizeUpdateProfileForm is a helper function that sanitizes the update vendor profile input.
+//
+// form: The update vendor profile form dto.
+//
+// Returns void
+func (v *VendorUseCase) SanitizeUpdateProfileForm(form *dto.UpdateVendorProfileFormDTO) {
+	// Remove the leading and trailing spaces of the given fields
+	for _, field := range []*string{form.Name, form.Email, form.Address, form.OpenTime, form.CloseTime} {
+		if field != nil {
+			*field = strings.TrimSpace(*field)
+		}
+	}
+
+	// Remove the separators of the phone number
+	if form.PhoneNumber != nil {
+		*form.PhoneNumber = strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(*form.PhoneNumber))
+	}
+}
+
+// ValidateUpdateProfileForm is a function that validates the update vendor profile form.
+//
+// form: The update vendor profile form dto.
+//
+// Returns a map of errors.
+func (v *VendorUseCase) ValidateUpdateProfileForm(form *dto.UpdateVendorProfileFormDTO) types.FormErrorResponseMsg {
+	// Create an empty error map
+	errs := make(types.FormErrorResponseMsg)
+
+	// Check if there is nothing to update
+	if form.Name == nil && form.Email == nil && form.Address == nil && form.PhoneNumber == nil && form.OpenTime == nil && form.CloseTime == nil {
+		errs["profile"] = append(errs["profile"], "Nothing to update")
+
+		return errs
+	}
+
+	// Check if the name is blank
+	if form.Name != nil && utils.IsBlank(*form.Name) {
+		errs["name"] = append(errs["name"], "Name is required")
+	}
+
+	// Check if the email is valid
+	if form.Email != nil {
+		if utils.IsBlank(*form.Email) {
+			errs["email"] = append(errs["email"], "Email is required")
+		} else if !utils.IsValidEmail(*form.Email) {
+			errs["email"] = append(errs["email"], "Email is invalid")
+		}
+	}
+
+	// Check if the address is blank
+	if form.Address != nil && utils.IsBlank(*form.Address) {
+		errs["address"] = append(errs["address"], "Address is required")
+	}
+
+	// Check if the phone number is valid, an empty phone number removes it
+	if form.PhoneNumber != nil && !utils.IsBlank(*form.PhoneNumber) && !utils.IsValidPhoneNumber(*form.PhoneNumber) {
+		errs["phone_number"] = append(errs["phone_number"], "Phone number is invalid")
+	}
+
+	// Check if the opening times are valid
+	if (form.OpenTime == nil) != (form.CloseTime == nil) {
+		errs["time"] = append(errs["time"], "Open time and close time must be provided together")
+	} else if form.OpenTime != nil {
+		if _, _, errMsg := parseOpeningTimes(*form.OpenTime, *form.CloseTime); !utils.IsBlank(errMsg) {
+			errs["time"] = append(errs["time"], errMsg)
+		}
+	}
+
+	// Check if the errors map is not empty
+	if len(errs) > 0 {
+		return errs
+	}
+
+	return nil
+}
+
+// ProcessUpdateProfile is a function that processes the update vendor profile use case,
+// a new email is only applied after it's verified through the mailed verification link.
+//
+// token: The vendor token.
+// form: The update vendor profile form dto.
+//
+// Returns the updated vendor and an error if any.
+func (v *VendorUseCase) ProcessUpdateProfile(token *jwt.Token, form *dto.UpdateVendorProfileFormDTO) (*models.Vendor, *entities.ProcessError) {
+	// Get the vendor ID from the token
+	claims := v.AuthUseCase.DecodeToken(token)
+
+	// Get the vendor by ID
+	vendor, err := v.VendorRepository.GetUsingID(claims.Id)
+
+	// Check if there is an error
+	if err != nil {
+		return nil, &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the vendor",
+		}
+	}
+
+	// Create the updated fields
+	fields := make(map[string]any)
+
+	// Update the name if it's changed
+	if form.Name != nil && *form.Name != vendor.Name {
+		// Check if the name is taken
+		taken, err := v.VendorRepository.IsNameTaken(*form.Name)
+
+		// Check if there is an error
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while checking the name",
+			}
+		}
+
+		// Return an error if the name is taken
+		if taken {
+			return nil, &entities.ProcessError{
+				ClientError: true,
+				Message: types.FormErrorResponseMsg{
+					"name": []string{"Name is taken"},
+				},
+			}
+		}
+
+		fields["name"] = *form.Name
+	}
+
+	// Update the address
+	if form.Address != nil {
+		fields["address"] = *form.Address
+	}
+
+	// Update the phone number, an empty phone number removes it
+	if form.PhoneNumber != nil {
+		if utils.IsBlank(*form.PhoneNumber) {
+			fields["phone_number"] = nil
+		} else {
+			fields["phone_number"] = *form.PhoneNumber
+		}
+	}
+
+	// Update the opening times, the times are validated with the form
+	if form.OpenTime != nil {
+		fields["open_time"], fields["close_time"], _ = parseOpeningTimes(*form.OpenTime, *form.CloseTime)
+	}
+
+	// Request the email change before updating the other fields, so
+	// nothing is updated if the verification email can't be sent
+	if form.Email != nil {
+		if processErr := v.requestEmailChange(vendor, *form.Email); processErr != nil {
+			return nil, processErr
+		}
+	}
+
+	// Update the vendor's profile
+	if len(fields) > 0 {
+		err := v.VendorRepository.UpdateProfile(claims.Id, fields)
+
+		// Check if there is an error
+		if err != nil {
+			return nil, &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while updating the vendor's profile",
+			}
+		}
+	}
+
+	return v.GetCurrentVendor(token)
+}
+
+// requestEmailChange is a helper function that stores the pending email change of
+// the vendor and mails the verification link to the new email, changing back to
+// the current email cancels the pending email change.
+//
+// vendor: The vendor.
+// email: The new email.
+//
+// Returns an error if any.
+func (v *VendorUseCase) requestEmailChange(vendor *models.Vendor, email string) *entities.ProcessError {
+	// Cancel the pending email change if the email is not changed
+	if strings.EqualFold(email, vendor.Email) {
+		if err := v.EmailChangeRepository.DeleteUsingVendorID(vendor.ID); err != nil {
+			return &entities.ProcessError{
+				ClientError: false,
+				Message:     "An error occurred while cancelling the email change",
+			}
+		}
+
+		return nil
+	}
+
+	// Check if the email is taken
+	taken, err := v.VendorRepository.IsEmailTaken(email)
+
+	// Check if there is an error
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while checking the email",
+		}
+	}
+
+	// Return an error if the email is taken
+	if taken {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message: types.FormErrorResponseMsg{
+				"email": []string{"Email is taken"},
+			},
+		}
+	}
+
+	// Generate the verification token
+	verificationToken, err := v.AuthUseCase.GenerateRandomToken()
+
+	// Check if there is an error
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while generating the verification token",
+		}
+	}
+
+	// Save the email change, only the hash of the token is stored
+	err = v.EmailChangeRepository.Save(&models.VendorEmailChange{
+		VendorID:  vendor.ID,
+		Email:     email,
+		TokenHash: v.AuthUseCase.HashRandomToken(verificationToken),
+		ExpiresAt: time.Now().Add(constants.EMAIL_VERIFICATION_EXPIRY),
+		CreatedAt: time.Now(),
+	})
+
+	// Check if there is an error
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while saving the email change",
+		}
+	}
+
+	// Create the verification link, the token is sent as is when
+	// there is no verification page
+	link := verificationToken
+
+	if !utils.IsBlank(config.MailConfig.EmailVerificationURL) {
+		link = config.MailConfig.EmailVerificationURL + "?token=" + verificationToken
+	}
+
+	// Send the verification email to the new email
+	err = mailer.SendMail(email, "Verify your new email", fmt.Sprintf(
+		"Hi %s,\n\nUse the following link to verify your new email, the link expires in %d hours.\n\n%s\n\nIgnore this email if you didn't request the change.",
+		vendor.Name, int(constants.EMAIL_VERIFICATION_EXPIRY.Hours()), link,
+	))
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Error sending verification email: " + err.Error())
+
+		// Remove the email change that can't be verified
+		v.EmailChangeRepository.DeleteUsingVendorID(vendor.ID)
+
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while sending the verification email",
+		}
+	}
+
+	return nil
+}
+
+// ValidateVerifyEmailForm is a function that validates the verify email form.
+//
+// form: The verify email form dto.
+//
+// Returns an error message if any.
+func (v *VendorUseCase) ValidateVerifyEmailForm(form *dto.VerifyEmailFormDTO) string {
+	// Remove the leading and trailing spaces
+	form.Token = strings.TrimSpace(form.Token)
+
+	// Check if the token is blank
+	if utils.IsBlank(form.Token) {
+		return "Token is required"
+	}
+
+	return ""
+}
+
+// ProcessVerifyEmail is a function that processes the verify vendor email use case,
+// the pending email change of the token becomes the vendor login email.
+//
+// form: The verify email form dto.
+//
+// Returns an error if any.
+func (v *VendorUseCase) ProcessVerifyEmail(form *dto.VerifyEmailFormDTO) *entities.ProcessError {
+	// Get the email change by the token hash
+	change, err := v.EmailChangeRepository.GetUsingTokenHash(v.AuthUseCase.HashRandomToken(form.Token))
+
+	// Return an error if the token is unknown or expired
+	if err == gorm.ErrRecordNotFound || (err == nil && change.ExpiresAt.Before(time.Now())) {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Invalid or expired token",
+		}
+	}
+
+	// Return an error if any
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while getting the email change",
+		}
+	}
+
+	// Check if the email is taken in between
+	taken, err := v.VendorRepository.IsEmailTaken(change.Email)
+
+	// Check if there is an error
+	if err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while checking the email",
+		}
+	}
+
+	// Return an error if the email is taken
+	if taken {
+		return &entities.ProcessError{
+			ClientError: true,
+			Message:     "Email is taken",
+		}
+	}
+
+	// Apply the email change
+	if err := v.EmailChangeRepository.Apply(change); err != nil {
+		return &entities.ProcessError{
+			ClientError: false,
+			Message:     "An error occurred while verifying the email",
+		}
+	}
+
 	return nil
 }
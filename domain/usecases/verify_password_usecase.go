@@ -13,9 +13,10 @@ import (
 
 // VerifyPasswordUseCase is a use case that provides the business logic for verifying a user's password.
 type VerifyPasswordUseCase struct {
-	AuthUseCase      *AuthUseCase
-	UserRepository   *repository.UserRepository
-	VendorRepository *repository.VendorRepository
+	AuthUseCase           *AuthUseCase
+	UserRepository        *repository.UserRepository
+	VendorRepository      *repository.VendorRepository
+	VendorStaffRepository *repository.VendorStaffRepository
 }
 
 // VerifyPassword is a method of the VerifyPasswordUseCase that verifies a user's password.
@@ -23,13 +24,15 @@ type VerifyPasswordUseCase struct {
 // a: The AuthUseCase instance.
 // u: The UserRepository instance.
 // v: The VendorRepository instance.
+// s: The VendorStaffRepository instance.
 //
 // Retuns instance of the VerifyPasswordUseCase.
-func NewVerifyPasswordUseCase(a *AuthUseCase, u *repository.UserRepository, v *repository.VendorRepository) *VerifyPasswordUseCase {
+func NewVerifyPasswordUseCase(a *AuthUseCase, u *repository.UserRepository, v *repository.VendorRepository, s *repository.VendorStaffRepository) *VerifyPasswordUseCase {
 	return &VerifyPasswordUseCase{
-		AuthUseCase:      a,
-		UserRepository:   u,
-		VendorRepository: v,
+		AuthUseCase:           a,
+		UserRepository:        u,
+		VendorRepository:      v,
+		VendorStaffRepository: s,
 	}
 }
 
@@ -110,8 +113,26 @@ func (v *VerifyPasswordUseCase) ProcessVendor(form *dto.VerifyPasswordFormDTO, t
 		}
 	}
 
+	// The password is the vendor password when signed in with the vendor account
+	hashedPwd := vendor.Password
+
+	// Get the staff password when signed in with a staff account
+	if claims.StaffID != 0 {
+		staff, err := v.VendorStaffRepository.GetUsingID(claims.Id, claims.StaffID)
+
+		// Check if there is an error
+		if err != nil {
+			return nil, &entities.ProcessError{
+				Message:     "An error occurred while getting the staff",
+				ClientError: false,
+			}
+		}
+
+		hashedPwd = staff.Password
+	}
+
 	// Check if the password is correct
-	if !v.AuthUseCase.VerifyPassword(form.Password, vendor.Password) {
+	if !v.AuthUseCase.VerifyPassword(form.Password, hashedPwd) {
 		return nil, &entities.ProcessError{
 			Message: types.FormErrorResponseMsg{
 				"password": []string{"Password is incorrect"},
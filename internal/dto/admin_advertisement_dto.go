@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"main/core/enums"
+	"main/core/shared"
+	"main/data/models"
+	"time"
+)
+
+// AdminAdvertisementDTO is a struct that represents the advertisement campaign
+// data transfer object shown to the admins.
+type AdminAdvertisementDTO struct {
+	*AdvertisementDTO
+
+	// Name is the campaign name of the advertisement.
+	Name string `json:"name"`
+
+	// Status is the campaign status, either Active, Scheduled, Ended or Paused.
+	Status string `json:"status"`
+
+	// StartDate is the first date the advertisement is shown, null to start right away.
+	StartDate *string `json:"start_date"`
+
+	// EndDate is the last date the advertisement is shown, null to never end.
+	EndDate *string `json:"end_date"`
+
+	// Weight is the priority weight of the advertisement.
+	Weight uint `json:"weight"`
+
+	// Latitude is the latitude of the targeted area center, null to target every area.
+	Latitude *float64 `json:"latitude"`
+
+	// Longitude is the longitude of the targeted area center, null to target every area.
+	Longitude *float64 `json:"longitude"`
+
+	// RadiusKm is the radius of the targeted area in kilometers.
+	RadiusKm *float64 `json:"radius_km"`
+
+	// PausedAt is the time the advertisement was paused, null when it's running.
+	PausedAt *time.Time `json:"paused_at"`
+
+	// CreatedAt is the time the advertisement was created.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FromModel is a function that converts an advertisement model to an admin advertisement DTO.
+//
+// m: The advertisement model.
+//
+// Returns the admin advertisement DTO.
+func (a AdminAdvertisementDTO) FromModel(m *models.Advertisement) *AdminAdvertisementDTO {
+	return &AdminAdvertisementDTO{
+		AdvertisementDTO: AdvertisementDTO{}.FromModel(m),
+		Name:             m.Name,
+		Status:           advertisementStatus(m).Label(),
+		StartDate:        formatOptionalDate(m.StartDate),
+		EndDate:          formatOptionalDate(m.EndDate),
+		Weight:           m.Weight,
+		Latitude:         m.Latitude,
+		Longitude:        m.Longitude,
+		RadiusKm:         m.RadiusKm,
+		PausedAt:         m.PausedAt,
+		CreatedAt:        m.CreatedAt,
+	}
+}
+
+// advertisementStatus is a helper function that returns the campaign status of the advertisement today.
+//
+// m: The advertisement model.
+//
+// Returns the advertisement status.
+func advertisementStatus(m *models.Advertisement) enums.AdvertisementStatus {
+	// Check if the advertisement is paused
+	if m.PausedAt != nil {
+		return enums.AdvertisementPaused
+	}
+
+	// Get today's date, the dates are compared as formatted strings
+	today := time.Now().Format("2006-01-02")
+
+	// Check if the advertisement has ended
+	if endDate := formatOptionalDate(m.EndDate); endDate != nil && *endDate < today {
+		return enums.AdvertisementEnded
+	}
+
+	// Check if the advertisement hasn't started yet
+	if startDate := formatOptionalDate(m.StartDate); startDate != nil && *startDate > today {
+		return enums.AdvertisementScheduled
+	}
+
+	return enums.AdvertisementActive
+}
+
+// formatOptionalDate is a helper function that formats the optional date.
+//
+// date: The date, nil if it's not set.
+//
+// Returns the date formatted as YYYY-MM-DD, nil if it's not set.
+func formatOptionalDate(date *shared.DateOnly) *string {
+	// Return nil if the date is not set
+	if date == nil || date.IsZero() {
+		return nil
+	}
+
+	// Format the date
+	formatted := date.Format("2006-01-02")
+
+	return &formatted
+}
@@ -0,0 +1,8 @@
+package dto
+
+// AdminAdvertisementResponseDTO is a struct that represents the admin advertisement
+// response data transfer object.
+type AdminAdvertisementResponseDTO struct {
+	// Advertisement is the advertisement campaign.
+	Advertisement *AdminAdvertisementDTO `json:"ad"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "main/data/models"
+
+// AdminAdvertisementsResponseDTO is a struct that represents the admin advertisements
+// response data transfer object.
+type AdminAdvertisementsResponseDTO struct {
+	// Advertisements is the list of advertisement campaigns.
+	Advertisements []AdminAdvertisementDTO `json:"ads"`
+
+	// Pagination is the pagination of the advertisements.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
+}
+
+// FromModels is a function that converts advertisement models to an admin advertisements response DTO.
+//
+// m: The advertisement models.
+//
+// Returns the admin advertisements response DTO.
+func (a AdminAdvertisementsResponseDTO) FromModels(m *[]models.Advertisement) *AdminAdvertisementsResponseDTO {
+	// Create the admin advertisement DTOs
+	dtos := make([]AdminAdvertisementDTO, 0, len(*m))
+
+	// Loop through the advertisements
+	for _, ad := range *m {
+		dtos = append(dtos, *AdminAdvertisementDTO{}.FromModel(&ad))
+	}
+
+	return &AdminAdvertisementsResponseDTO{
+		Advertisements: dtos,
+	}
+}
@@ -0,0 +1,71 @@
+package dto
+
+import (
+	"encoding/json"
+	"main/data/models"
+	"main/pkg/utils"
+	"time"
+)
+
+// AdminAuditLogDTO is a struct that represents the admin audit log data transfer object.
+type AdminAuditLogDTO struct {
+	// ID is the ID of the audit log.
+	ID uint `json:"id"`
+
+	// AdminID is the ID of the admin who made the change.
+	AdminID uint `json:"admin_id"`
+
+	// AdminUsername is the username of the admin when the change was made.
+	AdminUsername string `json:"admin_username"`
+
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+
+	// Path is the path of the request.
+	Path string `json:"path"`
+
+	// Status is the HTTP status code of the response.
+	Status int `json:"status"`
+
+	// TargetType is the type of the changed record, like "vendors".
+	TargetType *string `json:"target_type"`
+
+	// TargetID is the ID of the changed record.
+	TargetID *uint `json:"target_id"`
+
+	// RequestBody is the request body, the long values like the encoded images are omitted.
+	RequestBody json.RawMessage `json:"request_body"`
+
+	// CreatedAt is the time when the audit log was recorded.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FromModel is a function that converts an admin audit log model to an admin audit log DTO.
+//
+// m: The admin audit log model.
+//
+// Returns the admin audit log DTO.
+func (a AdminAuditLogDTO) FromModel(m *models.AdminAuditLog) *AdminAuditLogDTO {
+	auditLog := &AdminAuditLogDTO{
+		ID:            m.ID,
+		AdminID:       m.AdminID,
+		AdminUsername: m.AdminUsername,
+		Method:        m.Method,
+		Path:          m.Path,
+		Status:        m.Status,
+		TargetID:      m.TargetID,
+		CreatedAt:     m.CreatedAt,
+	}
+
+	// Set the target type if the change has a target
+	if !utils.IsBlank(m.TargetType) {
+		auditLog.TargetType = &m.TargetType
+	}
+
+	// Set the request body if it's recorded
+	if m.RequestBody != nil {
+		auditLog.RequestBody = json.RawMessage(*m.RequestBody)
+	}
+
+	return auditLog
+}
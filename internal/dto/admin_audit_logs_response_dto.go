@@ -0,0 +1,32 @@
+package dto
+
+import "main/data/models"
+
+// AdminAuditLogsResponseDTO is a struct that represents the admin audit logs
+// response data transfer object.
+type AdminAuditLogsResponseDTO struct {
+	// AuditLogs is the list of admin audit logs.
+	AuditLogs []AdminAuditLogDTO `json:"audit_logs"`
+
+	// Pagination is the pagination of the admin audit logs.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
+}
+
+// FromModels is a function that converts admin audit log models to an admin audit logs response DTO.
+//
+// m: The admin audit log models.
+//
+// Returns the admin audit logs response DTO.
+func (a AdminAuditLogsResponseDTO) FromModels(m *[]models.AdminAuditLog) *AdminAuditLogsResponseDTO {
+	// Create the admin audit log DTOs
+	dtos := make([]AdminAuditLogDTO, 0, len(*m))
+
+	// Loop through the admin audit logs
+	for _, auditLog := range *m {
+		dtos = append(dtos, *AdminAuditLogDTO{}.FromModel(&auditLog))
+	}
+
+	return &AdminAuditLogsResponseDTO{
+		AuditLogs: dtos,
+	}
+}
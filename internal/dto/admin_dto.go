@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"main/data/models"
+	"time"
+)
+
+// AdminDTO is a struct that represents the admin data transfer object.
+type AdminDTO struct {
+	// ID is the ID of the admin.
+	ID uint `json:"id"`
+
+	// Username is the username of the admin.
+	Username string `json:"username"`
+
+	// CreatedAt is the time when the admin was created.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FromModel is a function that converts an admin model to an admin DTO.
+//
+// m: The admin model.
+//
+// Returns the admin DTO.
+func (a AdminDTO) FromModel(m *models.Admin) *AdminDTO {
+	return &AdminDTO{
+		ID:        m.ID,
+		Username:  m.Username,
+		CreatedAt: m.CreatedAt,
+	}
+}
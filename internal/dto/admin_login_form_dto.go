@@ -0,0 +1,10 @@
+package dto
+
+// AdminLoginFormDTO is a struct that defines the admin login form.
+type AdminLoginFormDTO struct {
+	// Username is the username of the admin.
+	Username string `json:"username"`
+
+	// Password is the password of the admin.
+	Password string `json:"password"`
+}
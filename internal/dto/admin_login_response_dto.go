@@ -0,0 +1,13 @@
+package dto
+
+// AdminLoginResponseDTO is a type that represents the response data of the admin login response.
+type AdminLoginResponseDTO struct {
+	// Admin is the signed in admin.
+	Admin *AdminDTO `json:"admin"`
+
+	// Token is the JWT access token.
+	Token string `json:"token"`
+
+	// RefreshToken is the refresh token used to get a new JWT token.
+	RefreshToken string `json:"refresh_token"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "main/data/models"
+
+// AdminModerationQueueResponseDTO is a struct that represents the review moderation queue
+// response data transfer object.
+type AdminModerationQueueResponseDTO struct {
+	// Reviews is the list of reviews waiting for moderation.
+	Reviews []AdminModerationReviewDTO `json:"reviews"`
+
+	// Pagination is the pagination of the reviews.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
+}
+
+// FromModels is a function that converts review models to an admin moderation queue response DTO.
+//
+// m: The review models with their unresolved reports.
+//
+// Returns the admin moderation queue response DTO.
+func (a AdminModerationQueueResponseDTO) FromModels(m *[]models.Review) *AdminModerationQueueResponseDTO {
+	// Create the admin moderation review DTOs
+	dtos := make([]AdminModerationReviewDTO, 0, len(*m))
+
+	// Loop through the reviews
+	for _, review := range *m {
+		dtos = append(dtos, *AdminModerationReviewDTO{}.FromModel(&review))
+	}
+
+	return &AdminModerationQueueResponseDTO{
+		Reviews: dtos,
+	}
+}
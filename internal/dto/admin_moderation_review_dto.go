@@ -0,0 +1,32 @@
+package dto
+
+import "main/data/models"
+
+// AdminModerationReviewDTO is a struct that represents the review waiting for moderation
+// data transfer object shown to the admins.
+type AdminModerationReviewDTO struct {
+	*AdminReviewDTO
+
+	// Reports is the unresolved reports of the review
+	Reports []ReviewReportDTO `json:"reports"`
+}
+
+// FromModel is a function that converts a review model to an admin moderation review DTO.
+//
+// m: The review model with its unresolved reports.
+//
+// Returns the admin moderation review DTO.
+func (a AdminModerationReviewDTO) FromModel(m *models.Review) *AdminModerationReviewDTO {
+	// Create the review report DTOs
+	reports := make([]ReviewReportDTO, 0, len(m.Reports))
+
+	// Loop through the review reports
+	for _, report := range m.Reports {
+		reports = append(reports, *ReviewReportDTO{}.FromModel(&report))
+	}
+
+	return &AdminModerationReviewDTO{
+		AdminReviewDTO: AdminReviewDTO{}.FromModel(m),
+		Reports:        reports,
+	}
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "main/data/models"
+
+// AdminOrderDetailDTO is a struct that represents the order detail data transfer
+// object shown to the admins.
+type AdminOrderDetailDTO struct {
+	*CurrentVendorOrderDetailDTO
+
+	// Status is the payment status of the order
+	Status string `json:"status"`
+
+	// User is the user of the order, nil for the walk-in orders
+	User *CurrentUserDTO `json:"user"`
+
+	// Vendor is the vendor of the order
+	Vendor *VendorDTO `json:"vendor"`
+}
+
+// FromModel is a function that converts an order model to an admin order detail DTO.
+//
+// m: The order model
+//
+// Returns the admin order detail DTO
+func (a AdminOrderDetailDTO) FromModel(m *models.Order) *AdminOrderDetailDTO {
+	return &AdminOrderDetailDTO{
+		CurrentVendorOrderDetailDTO: CurrentVendorOrderDetailDTO{}.FromModel(m),
+		Status:                      m.Status,
+		User:                        CurrentUserDTO{}.FromModel(m.Bookings[0].User),
+		Vendor:                      VendorDTO{}.FromModel(&m.Bookings[0].Vendor),
+	}
+}
@@ -0,0 +1,8 @@
+package dto
+
+// AdminOrderDetailResponseDTO is a struct that represents the admin order detail
+// response data transfer object.
+type AdminOrderDetailResponseDTO struct {
+	// Order is the order detail.
+	Order *AdminOrderDetailDTO `json:"order"`
+}
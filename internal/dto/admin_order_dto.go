@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"main/data/models"
+	"main/internal/providers/midtrans"
+)
+
+// AdminOrderDTO is a struct that represents the order data transfer object
+// shown to the admins.
+type AdminOrderDTO struct {
+	// ID is the ID of the order
+	ID uint `json:"id"`
+
+	// MidtransOrderID is the midtrans order ID of the order
+	MidtransOrderID string `json:"midtrans_order_id"`
+
+	// Date is the date of the order was created
+	Date string `json:"date"`
+
+	// Status is the payment status of the order
+	Status string `json:"status"`
+
+	// User is the user of the order, nil for the walk-in orders
+	User *CurrentUserDTO `json:"user"`
+
+	// CustomerName is the name of the walk-in customer, nil for the orders made by the users
+	CustomerName *string `json:"customer_name"`
+
+	// Vendor is the vendor of the order
+	Vendor *VendorDTO `json:"vendor"`
+
+	// CourtType is the court type of the order
+	CourtType string `json:"court_type"`
+
+	// Price is the price of the order
+	Price float64 `json:"price"`
+
+	// AppFee is the application fee of the order
+	AppFee float64 `json:"app_fee"`
+}
+
+// FromModel is a function that converts an order model to an admin order DTO.
+//
+// m: The order model
+//
+// Returns the admin order DTO
+func (a AdminOrderDTO) FromModel(m *models.Order) *AdminOrderDTO {
+	return &AdminOrderDTO{
+		ID:              m.ID,
+		MidtransOrderID: midtrans.CreateMidtransOrderId(m.ID),
+		Date:            m.CreatedAt.Format("2006-01-02"),
+		Status:          m.Status,
+		User:            CurrentUserDTO{}.FromModel(m.Bookings[0].User),
+		CustomerName:    m.CustomerName,
+		Vendor:          VendorDTO{}.FromModel(&m.Bookings[0].Vendor),
+		CourtType:       m.Bookings[0].CourtType.Type,
+		Price:           m.Price,
+		AppFee:          m.AppFee,
+	}
+}
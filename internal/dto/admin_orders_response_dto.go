@@ -0,0 +1,31 @@
+package dto
+
+import "main/data/models"
+
+// AdminOrdersResponseDTO is a struct that represents the admin orders response data transfer object.
+type AdminOrdersResponseDTO struct {
+	// Orders is the list of orders.
+	Orders []AdminOrderDTO `json:"orders"`
+
+	// Pagination is the pagination of the orders.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
+}
+
+// FromModels is a function that converts order models to an admin orders response DTO.
+//
+// m: The order models.
+//
+// Returns the admin orders response DTO.
+func (a AdminOrdersResponseDTO) FromModels(m *[]models.Order) *AdminOrdersResponseDTO {
+	// Create the admin order DTOs
+	dtos := make([]AdminOrderDTO, 0, len(*m))
+
+	// Loop through the orders
+	for _, order := range *m {
+		dtos = append(dtos, *AdminOrderDTO{}.FromModel(&order))
+	}
+
+	return &AdminOrdersResponseDTO{
+		Orders: dtos,
+	}
+}
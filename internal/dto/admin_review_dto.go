@@ -0,0 +1,24 @@
+package dto
+
+import "main/data/models"
+
+// AdminReviewDTO is a struct that represents the review data transfer object
+// shown to the admins.
+type AdminReviewDTO struct {
+	*ReviewDTO
+
+	// Vendor is the reviewed vendor
+	Vendor *VendorDTO `json:"vendor"`
+}
+
+// FromModel is a function that converts a review model to an admin review DTO.
+//
+// m: The review model.
+//
+// Returns the admin review DTO.
+func (a AdminReviewDTO) FromModel(m *models.Review) *AdminReviewDTO {
+	return &AdminReviewDTO{
+		ReviewDTO: ReviewDTO{}.FromModel(m),
+		Vendor:    VendorDTO{}.FromModel(&m.Vendor),
+	}
+}
@@ -0,0 +1,49 @@
+package dto
+
+import (
+	"main/data/models"
+	"time"
+)
+
+// AdminReviewHistoryResponseDTO is a struct that represents the review history
+// response data transfer object shown to the admins.
+type AdminReviewHistoryResponseDTO struct {
+	// Review is the current version of the review
+	Review *AdminReviewDTO `json:"review"`
+
+	// DeletedAt is the time the review was deleted, null if it's not deleted
+	DeletedAt *time.Time `json:"deleted_at"`
+
+	// Revisions is the previous versions of the review, newest first
+	Revisions []ReviewRevisionDTO `json:"revisions"`
+}
+
+// FromModels is a function that converts a review model and its revisions to an
+// admin review history response DTO.
+//
+// m: The review model.
+// revisions: The review revision models.
+//
+// Returns the admin review history response DTO.
+func (a AdminReviewHistoryResponseDTO) FromModels(m *models.Review, revisions *[]models.ReviewRevision) *AdminReviewHistoryResponseDTO {
+	// Create the review revision DTOs
+	dtos := make([]ReviewRevisionDTO, 0, len(*revisions))
+
+	// Loop through the review revisions
+	for _, revision := range *revisions {
+		dtos = append(dtos, *ReviewRevisionDTO{}.FromModel(&revision))
+	}
+
+	// Get the deletion time if the review is deleted
+	var deletedAt *time.Time
+
+	if m.DeletedAt.Valid {
+		deletedAt = &m.DeletedAt.Time
+	}
+
+	return &AdminReviewHistoryResponseDTO{
+		Review:    AdminReviewDTO{}.FromModel(m),
+		DeletedAt: deletedAt,
+		Revisions: dtos,
+	}
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "main/data/models"
+
+// AdminReviewsResponseDTO is a struct that represents the admin reviews response data transfer object.
+type AdminReviewsResponseDTO struct {
+	// Reviews is the list of reviews.
+	Reviews []AdminReviewDTO `json:"reviews"`
+
+	// Pagination is the pagination of the reviews.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
+}
+
+// FromModels is a function that converts review models to an admin reviews response DTO.
+//
+// m: The review models.
+//
+// Returns the admin reviews response DTO.
+func (a AdminReviewsResponseDTO) FromModels(m *[]models.Review) *AdminReviewsResponseDTO {
+	// Create the admin review DTOs
+	dtos := make([]AdminReviewDTO, 0, len(*m))
+
+	// Loop through the reviews
+	for _, review := range *m {
+		dtos = append(dtos, *AdminReviewDTO{}.FromModel(&review))
+	}
+
+	return &AdminReviewsResponseDTO{
+		Reviews: dtos,
+	}
+}
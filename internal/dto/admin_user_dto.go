@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"main/data/models"
+	"time"
+)
+
+// AdminUserDTO is a struct that represents the user data transfer object
+// shown to the admins.
+type AdminUserDTO struct {
+	*CurrentUserDTO
+
+	// DisabledAt is the time when the user was disabled, null when the user is active.
+	DisabledAt *time.Time `json:"disabled_at"`
+
+	// CreatedAt is the time when the user was created.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FromModel is a function that converts a user model to an admin user DTO.
+//
+// m: The user model.
+//
+// Returns the admin user DTO.
+func (a AdminUserDTO) FromModel(m *models.User) *AdminUserDTO {
+	return &AdminUserDTO{
+		CurrentUserDTO: CurrentUserDTO{}.FromModel(m),
+		DisabledAt:     m.DisabledAt,
+		CreatedAt:      m.CreatedAt,
+	}
+}
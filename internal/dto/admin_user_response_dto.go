@@ -0,0 +1,7 @@
+package dto
+
+// AdminUserResponseDTO is a struct that represents the admin user response data transfer object.
+type AdminUserResponseDTO struct {
+	// User is the user.
+	User *AdminUserDTO `json:"user"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "main/data/models"
+
+// AdminUsersResponseDTO is a struct that represents the admin users response data transfer object.
+type AdminUsersResponseDTO struct {
+	// Users is the list of users.
+	Users []AdminUserDTO `json:"users"`
+
+	// Pagination is the pagination of the users.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
+}
+
+// FromModels is a function that converts user models to an admin users response DTO.
+//
+// m: The user models.
+//
+// Returns the admin users response DTO.
+func (a AdminUsersResponseDTO) FromModels(m *[]models.User) *AdminUsersResponseDTO {
+	// Create the admin user DTOs
+	dtos := make([]AdminUserDTO, 0, len(*m))
+
+	// Loop through the users
+	for _, user := range *m {
+		dtos = append(dtos, *AdminUserDTO{}.FromModel(&user))
+	}
+
+	return &AdminUsersResponseDTO{
+		Users: dtos,
+	}
+}
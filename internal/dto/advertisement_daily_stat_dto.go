@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"main/data/models"
+	"math"
+)
+
+// AdvertisementDailyStatDTO is a struct that represents the advertisement stat
+// of a date data transfer object.
+type AdvertisementDailyStatDTO struct {
+	// Date is the date of the stat, formatted as YYYY-MM-DD.
+	Date string `json:"date"`
+
+	// Impressions is the number of times the advertisement was served.
+	Impressions uint `json:"impressions"`
+
+	// Clicks is the number of times the advertisement was clicked.
+	Clicks uint `json:"clicks"`
+
+	// CTR is the click-through rate, the clicks divided by the impressions.
+	CTR float64 `json:"ctr"`
+}
+
+// FromModel is a function that converts an advertisement stat model to an advertisement daily stat DTO.
+//
+// m: The advertisement stat model.
+//
+// Returns the advertisement daily stat DTO.
+func (a AdvertisementDailyStatDTO) FromModel(m *models.AdvertisementStat) *AdvertisementDailyStatDTO {
+	return &AdvertisementDailyStatDTO{
+		Date:        m.Date.Format("2006-01-02"),
+		Impressions: m.Impressions,
+		Clicks:      m.Clicks,
+		CTR:         clickThroughRate(m.Impressions, m.Clicks),
+	}
+}
+
+// clickThroughRate is a helper function that returns the click-through rate rounded
+// to 4 decimal places, zero when there is no impression.
+//
+// impressions: The number of impressions.
+// clicks: The number of clicks.
+//
+// Returns the click-through rate.
+func clickThroughRate(impressions uint, clicks uint) float64 {
+	// Return zero if there is no impression
+	if impressions == 0 {
+		return 0
+	}
+
+	return math.Round(float64(clicks)/float64(impressions)*10000) / 10000
+}
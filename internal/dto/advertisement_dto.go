@@ -2,8 +2,9 @@ package dto
 
 import (
 	"fmt"
+	"main/core/constants"
 	"main/data/models"
-	"main/delivery/http/router"
+	"main/internal/providers/storage"
 )
 
 // AdvertisementDTO is a data transfer object that represents the ad entity.
@@ -28,7 +29,7 @@ type AdvertisementDTO struct {
 // Returns an ad DTO.
 func (a AdvertisementDTO) FromModel(m *models.Advertisement) *AdvertisementDTO {
 	// adImagePath is the path to the ad image.
-	adImagePath := fmt.Sprintf("%s/%s", router.Advertisements, m.Image)
+	adImagePath := storage.Store.URL(fmt.Sprintf("%s/%s", constants.PATH_TO_ADVERTISEMENTS, m.Image))
 
 	return &AdvertisementDTO{
 		ID:        m.ID,
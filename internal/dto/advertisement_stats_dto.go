@@ -0,0 +1,65 @@
+package dto
+
+import "main/data/models"
+
+// AdvertisementStatsDTO is a struct that represents the advertisement campaign
+// stats data transfer object.
+type AdvertisementStatsDTO struct {
+	// ID is the advertisement ID.
+	ID uint `json:"id"`
+
+	// Name is the campaign name of the advertisement.
+	Name string `json:"name"`
+
+	// CourtType is the advertised court type.
+	CourtType string `json:"court_type"`
+
+	// Status is the campaign status, either Active, Scheduled, Ended or Paused.
+	Status string `json:"status"`
+
+	// Impressions is the total impressions of the date range.
+	Impressions uint `json:"impressions"`
+
+	// Clicks is the total clicks of the date range.
+	Clicks uint `json:"clicks"`
+
+	// CTR is the click-through rate of the date range.
+	CTR float64 `json:"ctr"`
+
+	// Days is the stats of every date of the date range.
+	Days []AdvertisementDailyStatDTO `json:"days"`
+}
+
+// FromModel is a function that converts an advertisement model and its daily stats
+// to an advertisement stats DTO.
+//
+// m: The advertisement model.
+// stats: The daily stats of the advertisement, ordered by the date.
+//
+// Returns the advertisement stats DTO.
+func (a AdvertisementStatsDTO) FromModel(m *models.Advertisement, stats *[]models.AdvertisementStat) *AdvertisementStatsDTO {
+	// Create the advertisement stats DTO
+	res := &AdvertisementStatsDTO{
+		ID:        m.ID,
+		Name:      m.Name,
+		CourtType: m.CourtType.Type,
+		Status:    advertisementStatus(m).Label(),
+		Days:      []AdvertisementDailyStatDTO{},
+	}
+
+	// Loop through the daily stats of the advertisement
+	for _, stat := range *stats {
+		if stat.AdvertisementID != m.ID {
+			continue
+		}
+
+		res.Impressions += stat.Impressions
+		res.Clicks += stat.Clicks
+		res.Days = append(res.Days, *AdvertisementDailyStatDTO{}.FromModel(&stat))
+	}
+
+	// Get the click-through rate of the date range
+	res.CTR = clickThroughRate(res.Impressions, res.Clicks)
+
+	return res
+}
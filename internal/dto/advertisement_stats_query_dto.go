@@ -0,0 +1,11 @@
+package dto
+
+// AdvertisementStatsQueryDTO is a struct that represents the query parameters
+// to get the daily advertisement stats.
+type AdvertisementStatsQueryDTO struct {
+	// StartDate is the first date of the stats, formatted as YYYY-MM-DD.
+	StartDate string `query:"start_date"`
+
+	// EndDate is the last date of the stats, formatted as YYYY-MM-DD.
+	EndDate string `query:"end_date"`
+}
@@ -0,0 +1,8 @@
+package dto
+
+// AdvertisementStatsResponseDTO is a struct that represents the advertisement stats
+// response data transfer object.
+type AdvertisementStatsResponseDTO struct {
+	// Advertisement is the advertisement campaign stats.
+	Advertisement *AdvertisementStatsDTO `json:"ad"`
+}
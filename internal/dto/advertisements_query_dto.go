@@ -0,0 +1,14 @@
+package dto
+
+// AdvertisementsQueryDTO is a struct that represents the query parameters
+// to get the advertisements shown to the caller.
+type AdvertisementsQueryDTO struct {
+	// CourtType is the court type the caller is looking for.
+	CourtType string `query:"court_type"`
+
+	// Latitude is the latitude of the caller location.
+	Latitude *float64 `query:"lat"`
+
+	// Longitude is the longitude of the caller location.
+	Longitude *float64 `query:"lng"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import "main/data/models"
+
+// AdvertisementsStatsResponseDTO is a struct that represents the advertisements stats
+// response data transfer object.
+type AdvertisementsStatsResponseDTO struct {
+	// Advertisements is the list of advertisement campaign stats.
+	Advertisements []AdvertisementStatsDTO `json:"ads"`
+}
+
+// FromModels is a function that converts advertisement models and their daily stats
+// to an advertisements stats response DTO.
+//
+// m: The advertisement models.
+// stats: The daily stats of the advertisements, ordered by the date.
+//
+// Returns the advertisements stats response DTO.
+func (a AdvertisementsStatsResponseDTO) FromModels(m *[]models.Advertisement, stats *[]models.AdvertisementStat) *AdvertisementsStatsResponseDTO {
+	// Create the advertisement stats DTOs
+	dtos := make([]AdvertisementStatsDTO, 0, len(*m))
+
+	// Loop through the advertisements
+	for _, ad := range *m {
+		dtos = append(dtos, *AdvertisementStatsDTO{}.FromModel(&ad, stats))
+	}
+
+	return &AdvertisementsStatsResponseDTO{
+		Advertisements: dtos,
+	}
+}
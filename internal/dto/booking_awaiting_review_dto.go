@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"main/data/models"
+)
+
+// BookingAwaitingReviewDTO is a data transfer object that represents a completed booking
+// of the current user waiting for a review.
+type BookingAwaitingReviewDTO struct {
+	// ID is the ID of the booking
+	ID uint `json:"id"`
+
+	// OrderID is the ID of the order of the booking
+	OrderID uint `json:"order_id"`
+
+	// Court is the court of the booking
+	Court *UserCourtDTO `json:"court"`
+
+	// CourtType is the court type the court was booked as
+	CourtType string `json:"court_type"`
+
+	// Date is the date of the booking
+	Date string `json:"date"`
+
+	// BookStartTime is the start time of the booking
+	BookStartTime string `json:"book_start_time"`
+
+	// BookEndTime is the end time of the booking
+	BookEndTime string `json:"book_end_time"`
+}
+
+// FromModel is a function that converts a booking model to a booking awaiting review DTO.
+//
+// m: The booking model.
+//
+// Returns the booking awaiting review DTO.
+func (b BookingAwaitingReviewDTO) FromModel(m *models.Booking) *BookingAwaitingReviewDTO {
+	// Get the date
+	date, _ := m.Date.Value()
+
+	// Get the start time
+	startTime, _ := m.BookStartTime.Value()
+
+	// Get the end time
+	endTime, _ := m.BookEndTime.Value()
+
+	return &BookingAwaitingReviewDTO{
+		ID:            m.ID,
+		OrderID:       m.OrderID,
+		Court:         UserCourtDTO{}.FromModel(&m.Court),
+		CourtType:     m.CourtType.Type,
+		Date:          date.(string),
+		BookStartTime: startTime.(string),
+		BookEndTime:   endTime.(string),
+	}
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "main/data/models"
+
+// BookingsAwaitingReviewResponseDTO is a struct that represents the response data transfer object
+// of the current user bookings waiting for a review.
+type BookingsAwaitingReviewResponseDTO struct {
+	// Bookings is the list of bookings waiting for a review.
+	Bookings []BookingAwaitingReviewDTO `json:"bookings"`
+
+	// Pagination is the pagination of the bookings.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
+}
+
+// FromModels is a function that converts booking models to a bookings awaiting review response DTO.
+//
+// m: The booking models.
+//
+// Returns the bookings awaiting review response DTO.
+func (b BookingsAwaitingReviewResponseDTO) FromModels(m *[]models.Booking) *BookingsAwaitingReviewResponseDTO {
+	// Create the booking awaiting review DTOs
+	dtos := make([]BookingAwaitingReviewDTO, 0, len(*m))
+
+	// Loop through the bookings
+	for _, booking := range *m {
+		dtos = append(dtos, *BookingAwaitingReviewDTO{}.FromModel(&booking))
+	}
+
+	return &BookingsAwaitingReviewResponseDTO{
+		Bookings: dtos,
+	}
+}
@@ -0,0 +1,8 @@
+package dto
+
+// ChangeCourtTypeIconDTO is a struct that represents the
+// data transfer object for changing the court type icon.
+type ChangeCourtTypeIconDTO struct {
+	// Image is the base64 encoded icon of the court type.
+	Image string `json:"image"`
+}
@@ -1,8 +1,13 @@
 package dto
 
+import "mime/multipart"
+
 // ChangeUserProfilePictureDTO is a struct that represents the 
 // data transfer object for changing the user profile picture.
 type ChangeUserProfilePictureDTO struct {
 	// ProfilePicture is the profile picture of the user.
-	Image string `json:"image"`
+	Image string `json:"image" form:"image"`
+
+	// ImageFile is the uploaded image file of a multipart request.
+	ImageFile *multipart.FileHeader `json:"-" form:"-"`
 }
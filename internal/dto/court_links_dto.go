@@ -0,0 +1,8 @@
+package dto
+
+// CourtLinksDTO is a data transfer object that represents the data to link or unlink
+// courts to a court type.
+type CourtLinksDTO struct {
+	// CourtIDs is the list of court IDs to be linked or unlinked.
+	CourtIDs []uint `json:"court_ids"`
+}
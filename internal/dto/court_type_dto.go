@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"fmt"
+	"main/core/constants"
+	"main/data/models"
+	"main/internal/providers/storage"
+	"main/pkg/utils"
+	"time"
+)
+
+// CourtTypeDTO is a struct that defines the court type data transfer object.
+type CourtTypeDTO struct {
+	// ID is the primary key of the court type.
+	ID uint `json:"id"`
+
+	// Type is the type of the court.
+	Type string `json:"type"`
+
+	// IconUrl is the icon URL of the court type.
+	IconUrl *string `json:"icon_url"`
+
+	// RetiredAt is the time the court type was retired.
+	RetiredAt *time.Time `json:"retired_at,omitempty"`
+}
+
+// FromModel is a function that converts a court type model to a court type DTO.
+//
+// m: The court type model.
+//
+// Returns the court type DTO.
+func (c CourtTypeDTO) FromModel(m *models.CourtType) *CourtTypeDTO {
+	// Create a new court type DTO
+	courtType := &CourtTypeDTO{
+		ID:        m.ID,
+		Type:      m.Type,
+		IconUrl:   nil,
+		RetiredAt: m.RetiredAt,
+	}
+
+	// Set the icon url if the court type has an icon
+	if !utils.IsBlank(m.Icon) {
+		// iconPath is the path to the court type icon.
+		iconPath := storage.Store.URL(fmt.Sprintf("%s/%s", constants.PATH_TO_COURT_TYPE_ICONS, m.Icon))
+
+		courtType.IconUrl = &iconPath
+	}
+
+	return courtType
+}
@@ -0,0 +1,11 @@
+package dto
+
+// CourtTypeFormDTO is a struct that defines the data transfer object for
+// creating or renaming a court type.
+type CourtTypeFormDTO struct {
+	// Type is the name of the court type.
+	Type string `json:"type"`
+
+	// Icon is the base64 encoded icon of the court type.
+	Icon string `json:"icon"`
+}
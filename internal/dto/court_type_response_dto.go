@@ -0,0 +1,7 @@
+package dto
+
+// CourtTypeResponseDTO is a struct that defines the court type response data transfer object.
+type CourtTypeResponseDTO struct {
+	// CourtType is the court type.
+	CourtType *CourtTypeDTO `json:"court_type"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import "main/data/models"
+
+// CourtTypesResponseDTO is a struct that defines the court types response data transfer object.
+type CourtTypesResponseDTO struct {
+	// CourtTypes is the list of court types.
+	CourtTypes *[]CourtTypeDTO `json:"court_types"`
+}
+
+// FromModels is a function that converts court type models to court types response DTO.
+//
+// m: The court type models.
+//
+// Returns the court types response DTO.
+func (c CourtTypesResponseDTO) FromModels(m *[]models.CourtType) *CourtTypesResponseDTO {
+	// Create a new court type DTO slice
+	courtTypes := []CourtTypeDTO{}
+
+	// Loop through the court type models
+	for _, courtType := range *m {
+		courtTypes = append(courtTypes, *CourtTypeDTO{}.FromModel(&courtType))
+	}
+
+	return &CourtTypesResponseDTO{
+		CourtTypes: &courtTypes,
+	}
+}
@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"main/pkg/utils"
+	"strings"
+)
+
+// CourtsQueryDTO is a struct that represents the query parameters
+// to filter, sort and paginate the courts catalogue.
+type CourtsQueryDTO struct {
+	// Type is the comma separated court types to filter.
+	Type string `query:"type"`
+
+	// Search is the vendor name to search.
+	Search string `query:"search"`
+
+	// MinPrice is the minimum price of the courts.
+	MinPrice *float64 `query:"min_price"`
+
+	// MaxPrice is the maximum price of the courts.
+	MaxPrice *float64 `query:"max_price"`
+
+	// MinRating is the minimum rating of the courts.
+	MinRating *float64 `query:"min_rating"`
+
+	// OpenAt is the time the vendors should be open at, formatted as HH:MM.
+	OpenAt string `query:"open_at"`
+
+	// Latitude is the latitude of the location.
+	Latitude *float64 `query:"lat"`
+
+	// Longitude is the longitude of the location.
+	Longitude *float64 `query:"lng"`
+
+	// RadiusKm is the search radius from the location in kilometers.
+	RadiusKm *float64 `query:"radius_km"`
+
+	// Sort is the sort order of the courts, either rating, reviews, price, newest or distance.
+	Sort string `query:"sort"`
+
+	// Order is the direction of the sort order, either asc or desc.
+	Order string `query:"order"`
+
+	// PaginationQueryDTO is the pagination of the courts.
+	PaginationQueryDTO
+}
+
+// GetCourtTypes is a function that returns the court types to filter.
+//
+// Returns the court types.
+func (q *CourtsQueryDTO) GetCourtTypes() []string {
+	// Create court types slice
+	courtTypes := []string{}
+
+	// Loop through the comma separated court types
+	for _, courtType := range strings.Split(q.Type, ",") {
+		// Trim the court type
+		courtType = strings.TrimSpace(courtType)
+
+		// Skip the blank court types
+		if utils.IsBlank(courtType) {
+			continue
+		}
+
+		courtTypes = append(courtTypes, courtType)
+	}
+
+	return courtTypes
+}
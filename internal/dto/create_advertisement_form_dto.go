@@ -0,0 +1,40 @@
+package dto
+
+import "mime/multipart"
+
+// CreateAdvertisementFormDTO is a struct that represents the data transfer object
+// for creating an advertisement campaign.
+type CreateAdvertisementFormDTO struct {
+	// Name is the campaign name of the advertisement.
+	Name string `json:"name" form:"name"`
+
+	// VendorID is the ID of the advertised vendor.
+	VendorID uint `json:"vendor_id" form:"vendor_id"`
+
+	// CourtType is the advertised and targeted court type.
+	CourtType string `json:"court_type" form:"court_type"`
+
+	// Image is the base64 encoded image.
+	Image string `json:"image" form:"image"`
+
+	// ImageFile is the uploaded image file of a multipart request.
+	ImageFile *multipart.FileHeader `json:"-" form:"-"`
+
+	// StartDate is the first date the advertisement is shown, formatted as YYYY-MM-DD.
+	StartDate string `json:"start_date" form:"start_date"`
+
+	// EndDate is the last date the advertisement is shown, formatted as YYYY-MM-DD.
+	EndDate string `json:"end_date" form:"end_date"`
+
+	// Weight is the priority weight of the advertisement.
+	Weight *uint `json:"weight" form:"weight"`
+
+	// Latitude is the latitude of the targeted area center.
+	Latitude *float64 `json:"latitude" form:"latitude"`
+
+	// Longitude is the longitude of the targeted area center.
+	Longitude *float64 `json:"longitude" form:"longitude"`
+
+	// RadiusKm is the radius of the targeted area in kilometers.
+	RadiusKm *float64 `json:"radius_km" form:"radius_km"`
+}
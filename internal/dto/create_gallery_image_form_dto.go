@@ -0,0 +1,22 @@
+package dto
+
+import "mime/multipart"
+
+// CreateGalleryImageFormDTO is a struct that represents the data transfer object
+// for uploading a gallery image.
+type CreateGalleryImageFormDTO struct {
+	// CourtID is the court ID of the gallery image, empty for venue images.
+	CourtID *uint `json:"court_id" form:"court_id"`
+
+	// Image is the base64 encoded image.
+	Image string `json:"image" form:"image"`
+
+	// ImageFile is the uploaded image file of a multipart request.
+	ImageFile *multipart.FileHeader `json:"-" form:"-"`
+
+	// Caption is the caption of the gallery image.
+	Caption string `json:"caption" form:"caption"`
+
+	// IsCover is the flag whether the gallery image is the cover image.
+	IsCover bool `json:"is_cover" form:"is_cover"`
+}
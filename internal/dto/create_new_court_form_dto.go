@@ -1,10 +1,15 @@
 package dto
 
+import "mime/multipart"
+
 // CreateNewCourtFormDTO is a struct that defines the create new court form data transfer object.
 type CreateNewCourtFormDTO struct {
 	// PricePerHour is the price per hour of the court.
-	PricePerHour float64 `json:"price_per_hour"`
+	PricePerHour float64 `json:"price_per_hour" form:"price_per_hour"`
 
 	// CourtImage is the image of the court.
-	CourtImage string `json:"court_image"`
+	CourtImage string `json:"court_image" form:"court_image"`
+
+	// CourtImageFile is the uploaded court image file of a multipart request.
+	CourtImageFile *multipart.FileHeader `json:"-" form:"-"`
 }
@@ -5,6 +5,10 @@ type CreateOrderDTO struct {
 	// VendorID is the vendor ID.
 	VendorID uint `json:"vendor_id"`
 
+	// CourtType is the court type the courts are booked as, it's optional and
+	// defaults to the court type the first court was created in.
+	CourtType string `json:"court_type"`
+
 	// Date is the book date.
 	Date string `json:"date"`
 
@@ -1,11 +1,22 @@
 package dto
 
+import "mime/multipart"
+
 // CreateReviewFormDTO is a struct that defines the data transfer object 
 // for creating a review.
 type CreateReviewFormDTO struct {
+	// BookingID is the ID of the completed booking the review is written for
+	BookingID uint `json:"booking_id" form:"booking_id"`
+
 	// Rating is the rating of the review
-	Rating int8 `json:"rating"`
+	Rating int8 `json:"rating" form:"rating"`
 
 	// Review is the review content
-	Review string `json:"review"`
+	Review string `json:"review" form:"review"`
+
+	// Images is the base64 encoded photos of the review
+	Images []string `json:"images" form:"images"`
+
+	// ImageFiles is the uploaded photo files of a multipart request
+	ImageFiles []*multipart.FileHeader `json:"-" form:"-"`
 }
@@ -0,0 +1,19 @@
+package dto
+
+// CreateVendorStaffFormDTO is a struct that represents the create vendor staff form data transfer object.
+type CreateVendorStaffFormDTO struct {
+	// Name is the name of the staff.
+	Name string `json:"name"`
+
+	// Email is the login email of the staff.
+	Email string `json:"email"`
+
+	// Password is the password of the staff.
+	Password string `json:"password"`
+
+	// ConfirmPassword is the confirmation of the password.
+	ConfirmPassword string `json:"confirm_password"`
+
+	// Role is the role of the staff.
+	Role string `json:"role"`
+}
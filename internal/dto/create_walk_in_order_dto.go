@@ -0,0 +1,10 @@
+package dto
+
+// CreateWalkInOrderDTO is a type that defines the create walk-in order DTO,
+// the vendor ID is taken from the vendor token.
+type CreateWalkInOrderDTO struct {
+	CreateOrderDTO
+
+	// CustomerName is the name of the walk-in customer.
+	CustomerName string `json:"customer_name"`
+}
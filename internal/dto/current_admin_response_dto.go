@@ -0,0 +1,7 @@
+package dto
+
+// CurrentAdminResponseDTO is a struct that represents the current admin response data transfer object.
+type CurrentAdminResponseDTO struct {
+	// Admin is the signed in admin.
+	Admin *AdminDTO `json:"admin"`
+}
@@ -7,6 +7,9 @@ import "main/data/models"
 type CurrentUserCourtBookingsResponseDTO struct {
 	// Bookings is the bookings of the current user.
 	Bookings *[]CurrentUserBookingDTO `json:"bookings"`
+
+	// OpeningHours is the opening hours of the vendor on the booking date.
+	OpeningHours *OpeningHoursDTO `json:"opening_hours,omitempty"`
 }
 
 // FromModels is a function that converts booking models to a current 
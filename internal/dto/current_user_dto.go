@@ -2,8 +2,9 @@ package dto
 
 import (
 	"fmt"
+	"main/core/constants"
 	"main/data/models"
-	"main/delivery/http/router"
+	"main/internal/providers/storage"
 	"main/pkg/utils"
 )
 
@@ -20,14 +21,22 @@ type CurrentUserDTO struct {
 
 	// ProfilePictureUrl is the profile picture of the user.
 	ProfilePictureUrl string `json:"profile_picture_url"`
+
+	// ProfilePictureUrls is the profile picture URLs of the user for each image size.
+	ProfilePictureUrls *ImageUrlsDTO `json:"profile_picture_urls"`
 }
 
 // FromModel creates a CurrentUser DTO from a User model.
 //
 // m: The user model.
 //
-// Returns a CurrentUser DTO.
+// Returns a CurrentUser DTO, nil if the user is nil.
 func (c CurrentUserDTO) FromModel(m *models.User) *CurrentUserDTO {
+	// Return nil if there is no user, like the user of a walk-in order.
+	if m == nil {
+		return nil
+	}
+
 	// If the profile picture is blank, return the CurrentUserDTO DTO without the profile picture.
 	if utils.IsBlank(m.ProfilePicture) {
 		return &CurrentUserDTO{
@@ -39,12 +48,13 @@ func (c CurrentUserDTO) FromModel(m *models.User) *CurrentUserDTO {
 	}
 
 	// profilePicturePath is the path to the profile picture.
-	profilePicturePath := fmt.Sprintf("%s/%s", router.UserProfiles, m.ProfilePicture)
+	profilePicturePath := storage.Store.URL(fmt.Sprintf("%s/%s", constants.PATH_TO_USER_PROFILE_PICTURES, m.ProfilePicture))
 
 	return &CurrentUserDTO{
-		ID:                m.ID,
-		Username:          m.Username,
-		PhoneNumber:       m.PhoneNumber,
-		ProfilePictureUrl: profilePicturePath,
+		ID:                 m.ID,
+		Username:           m.Username,
+		PhoneNumber:        m.PhoneNumber,
+		ProfilePictureUrl:  profilePicturePath,
+		ProfilePictureUrls: ImageUrlsDTO{}.FromFileName(constants.PATH_TO_USER_PROFILE_PICTURES, m.ProfilePicture),
 	}
 }
@@ -53,7 +53,7 @@ func (o CurrentUserOrderDTO) FromModel(m *models.Order, reviewed *bool) *Current
 		ID:           m.ID,
 		Date:         m.CreatedAt.Format("2006-01-02"),
 		Vendor:       VendorDTO{}.FromModel(&m.Bookings[0].Vendor),
-		CourtType:    m.Bookings[0].Court.CourtType.Type,
+		CourtType:    m.Bookings[0].CourtType.Type,
 		Price:        m.Price,
 		AppFee:       m.AppFee,
 		PaymentToken: m.PaymentToken,
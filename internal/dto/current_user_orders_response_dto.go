@@ -6,6 +6,9 @@ import "main/data/models"
 // data transfer object.
 type CurrentUserOrdersResponseDTO struct {
 	Orders *[]CurrentUserOrderDTO `json:"orders"`
+
+	// Pagination is the pagination of the orders.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
 }
 
 // FromModels is a function that converts a slice of order models to a 
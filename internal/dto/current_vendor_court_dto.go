@@ -2,9 +2,9 @@ package dto
 
 import (
 	"fmt"
-	"main/core/config"
+	"main/core/constants"
 	"main/data/models"
-	"main/delivery/http/router"
+	"main/internal/providers/storage"
 )
 
 // CurrentVendorCourtDTO is a struct that defines the current vendor court data transfer object.
@@ -18,11 +18,20 @@ type CurrentVendorCourtDTO struct {
 	// CourtType is the type of the court.
 	Type string `json:"type"`
 
+	// Types is the list of court types the court can be booked as.
+	Types []string `json:"types,omitempty"`
+
 	// Name is the name of the court.
 	Price float64 `json:"price"`
 
 	// ImageUrl is the image URL of the court.
 	ImageUrl string `json:"image_url"`
+
+	// ImageUrls is the image URLs of the court for each image size.
+	ImageUrls *ImageUrlsDTO `json:"image_urls"`
+
+	// Images is the gallery images of the court.
+	Images []GalleryImageDTO `json:"images,omitempty"`
 }
 
 // FromModel is a function that converts a court model to a current vendor court DTO.
@@ -32,13 +41,16 @@ type CurrentVendorCourtDTO struct {
 // Returns the current vendor court DTO.
 func (c CurrentVendorCourtDTO) FromModel(m *models.Court) *CurrentVendorCourtDTO {
 	// courtImagePath is the path to the court image.
-	courtImagePath := fmt.Sprintf("%s:%d%s/%s", config.ServerConfig.Host, config.ServerConfig.Port, router.CourtImages, m.Image)
+	courtImagePath := storage.Store.URL(fmt.Sprintf("%s/%s", constants.PATH_TO_COURT_IMAGES, m.Image))
 
 	return &CurrentVendorCourtDTO{
-		ID:       m.ID,
-		Name:     m.Name,
-		Type:     m.CourtType.Type,
-		Price:    m.Price,
-		ImageUrl: courtImagePath,
+		ID:        m.ID,
+		Name:      m.Name,
+		Type:      m.CourtType.Type,
+		Types:     courtTypeNames(m),
+		Price:     m.Price,
+		ImageUrl:  courtImagePath,
+		ImageUrls: ImageUrlsDTO{}.FromFileName(constants.PATH_TO_COURT_IMAGES, m.Image),
+		Images:    GalleryImageDTO{}.FromModels(m.GalleryImages),
 	}
 }
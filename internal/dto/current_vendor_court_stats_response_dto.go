@@ -1,46 +1,27 @@
 package dto
 
 import (
-	"main/core/enums"
 	"main/core/types"
 )
 
 // CurrentVendorCourtStatsResponseDTO is a struct that defines the current vendor court stats response DTO.
 type CurrentVendorCourtStatsResponseDTO struct {
-	// TotalCourtCount is the total court count.
+	// TotalCourtCount is the total physical court count, a court linked to
+	// several court types is only counted once.
 	TotalCourtCount int64 `json:"total_court_count"`
 
-	// FootballCourtCount is the football court count.
-	FootballCourtCount int64 `json:"football_court_count"`
-
-	// BasketballCourtCount is the basketball court count.
-	BasketballCourtCount int64 `json:"basketball_court_count"`
-
-	// TennisCourtCount is the tennis court count.
-	TennisCourtCount int64 `json:"tennis_court_count"`
-
-	// VolleyballCourtCount is the volleyball court count.
-	VolleyballCourtCount int64 `json:"volleyball_court_count"`
-
-	// BadmintonCourtCount is the badminton court count.
-	BadmintonCourtCount int64 `json:"badminton_court_count"`
+	// CourtCounts is the court count for each court type.
+	CourtCounts types.CourtCountsMap `json:"court_counts"`
 }
 
-// FromMap is a function that converts a court counts map to a current vendor court stats response DTO.
+// FromMap is a function that converts a court stats map to a current vendor court stats response DTO.
 //
-// m: The court counts map.
+// m: The court stats map.
 //
 // Returns the current vendor court stats response DTO.
-func (c CurrentVendorCourtStatsResponseDTO) FromMap(m *types.CourtCountsMap) *CurrentVendorCourtStatsResponseDTO {
-	// Calculate the total court count
-	total := (*m)[enums.Football.Label()] + (*m)[enums.Basketball.Label()] + (*m)[enums.Tennis.Label()] + (*m)[enums.Volleyball.Label()] + (*m)[enums.Badminton.Label()]
-
+func (c CurrentVendorCourtStatsResponseDTO) FromMap(m *types.CourtStatsMap) *CurrentVendorCourtStatsResponseDTO {
 	return &CurrentVendorCourtStatsResponseDTO{
-		TotalCourtCount:      total,
-		FootballCourtCount:   (*m)[enums.Football.Label()],
-		BasketballCourtCount: (*m)[enums.Basketball.Label()],
-		TennisCourtCount:     (*m)[enums.Tennis.Label()],
-		VolleyballCourtCount: (*m)[enums.Volleyball.Label()],
-		BadmintonCourtCount:  (*m)[enums.Badminton.Label()],
+		TotalCourtCount: *(*m)["total_court_count"].(*int64),
+		CourtCounts:     *(*m)["court_counts"].(*types.CourtCountsMap),
 	}
 }
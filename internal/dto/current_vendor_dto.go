@@ -15,14 +15,29 @@ type CurrentVendorDTO struct {
 	// Username is the username of the vendor.
 	Email string `json:"email"`
 
+	// PendingEmail is the new email of the vendor waiting for verification.
+	PendingEmail *string `json:"pending_email,omitempty"`
+
 	// Username is the username of the vendor.
 	Address string `json:"address"`
 
+	// PhoneNumber is the contact phone number of the vendor.
+	PhoneNumber *string `json:"phone_number"`
+
+	// Latitude is the latitude of the vendor location.
+	Latitude *float64 `json:"latitude"`
+
+	// Longitude is the longitude of the vendor location.
+	Longitude *float64 `json:"longitude"`
+
 	// OpenTime is the open time of the vendor.
 	OpenTime string `json:"open_time"`
 
 	// CloseTime is the close time of the vendor.
 	CloseTime string `json:"close_time"`
+
+	// Images is the gallery images of the venue.
+	Images []GalleryImageDTO `json:"images,omitempty"`
 }
 
 // FromModel creates a CurrentVendor DTO from a Vendor model.
@@ -37,12 +52,24 @@ func (c CurrentVendorDTO) FromModel(m *models.Vendor) *CurrentVendorDTO {
 	// Get the close time
 	closeTime, _ := m.CloseTime.Value()
 
+	// Get the pending email if any
+	var pendingEmail *string
+
+	if m.EmailChange != nil {
+		pendingEmail = &m.EmailChange.Email
+	}
+
 	return &CurrentVendorDTO{
-		ID:        m.ID,
-		Name:      m.Name,
-		Email:     m.Email,
-		Address:   m.Address,
-		OpenTime:  openTime.(string),
-		CloseTime: closeTime.(string),
+		ID:           m.ID,
+		Name:         m.Name,
+		Email:        m.Email,
+		PendingEmail: pendingEmail,
+		Address:      m.Address,
+		PhoneNumber:  m.PhoneNumber,
+		Latitude:     m.Latitude,
+		Longitude:    m.Longitude,
+		OpenTime:     openTime.(string),
+		CloseTime:    closeTime.(string),
+		Images:       GalleryImageDTO{}.FromModels(m.GalleryImages),
 	}
 }
@@ -19,8 +19,11 @@ type CurrentVendorOrderDetailDTO struct {
 	// CreatedDate is the created date of the order
 	CreatedDate string `json:"created_date"`
 
-	// Price is the price of the order
-	Price float64 `json:"price"`
+	// CustomerName is the name of the walk-in customer, nil for the orders made by the users
+	CustomerName *string `json:"customer_name"`
+
+	// Price is the price of the order, omitted for the staff who can't view the revenue
+	Price *float64 `json:"price,omitempty"`
 
 	// AppFee is the application fee of the order
 	AppFee float64 `json:"app_fee"`
@@ -49,8 +52,19 @@ func (c CurrentVendorOrderDetailDTO) FromModel(m *models.Order) *CurrentVendorOr
 		MidtransOrderID: midtrans.CreateMidtransOrderId(m.ID),
 		OrderDate:       m.Bookings[0].Date.Format("2006-01-02"),
 		CreatedDate:     m.CreatedAt.Format("2006-01-02"),
-		Price:           m.Price,
+		CustomerName:    m.CustomerName,
+		Price:           &m.Price,
 		AppFee:          m.AppFee,
 		Bookings:        &bookingDtos,
 	}
 }
+
+// HidePrice is a method that removes the price of the order, for the staff
+// who can't view the revenue
+//
+// Returns the DTO
+func (c *CurrentVendorOrderDetailDTO) HidePrice() *CurrentVendorOrderDetailDTO {
+	c.Price = nil
+
+	return c
+}
@@ -10,14 +10,17 @@ type CurrentVendorOrderDTO struct {
 	// Date is the date of the order was created
 	Date string `json:"date"`
 
-	// User is the user of the order
+	// User is the user of the order, nil for the walk-in orders
 	User *CurrentUserDTO `json:"user"`
 
+	// CustomerName is the name of the walk-in customer, nil for the orders made by the users
+	CustomerName *string `json:"customer_name"`
+
 	// CourtType is the court type of the order
 	CourtType string `json:"court_type"`
 
-	// Price is the price of the order
-	Price float64 `json:"price"`
+	// Price is the price of the order, omitted for the staff who can't view the revenue
+	Price *float64 `json:"price,omitempty"`
 
 	// AppFee is the application fee of the order
 	AppFee float64 `json:"app_fee"`
@@ -30,12 +33,13 @@ type CurrentVendorOrderDTO struct {
 // Returns a CurrentVendorOrdersResponseDTO
 func (c CurrentVendorOrderDTO) FromModel(m *models.Order) *CurrentVendorOrderDTO {
 	return &CurrentVendorOrderDTO{
-		ID:        m.ID,
-		Date:      m.CreatedAt.Format("2006-01-02"),
-		User:      CurrentUserDTO{}.FromModel(&m.Bookings[0].User),
-		CourtType: m.Bookings[0].Court.CourtType.Type,
-		Price:     m.Price,
-		AppFee:    m.AppFee,
+		ID:           m.ID,
+		Date:         m.CreatedAt.Format("2006-01-02"),
+		User:         CurrentUserDTO{}.FromModel(m.Bookings[0].User),
+		CustomerName: m.CustomerName,
+		CourtType:    m.Bookings[0].CourtType.Type,
+		Price:        &m.Price,
+		AppFee:       m.AppFee,
 	}
 }
 
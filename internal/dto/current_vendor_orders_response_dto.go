@@ -5,6 +5,9 @@ import "main/data/models"
 // CurrentVendorOrdersResponseDTO is a data transfer object that represents the response
 type CurrentVendorOrdersResponseDTO struct {
 	Orders *[]CurrentVendorOrderDTO `json:"orders"`
+
+	// Pagination is the pagination of the orders.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
 }
 
 // FromModels is a function that converts a slice of order models to a current vendor orders response DTO.
@@ -25,3 +28,16 @@ func (c CurrentVendorOrdersResponseDTO) FromModels(m *[]models.Order) *CurrentVe
 		Orders: &orders,
 	}
 }
+
+// HidePrices is a function that removes the prices of the orders, for the staff
+// who can't view the revenue.
+//
+// Returns the current vendor orders response DTO.
+func (c *CurrentVendorOrdersResponseDTO) HidePrices() *CurrentVendorOrdersResponseDTO {
+	// Iterate over the orders
+	for i := range *c.Orders {
+		(*c.Orders)[i].Price = nil
+	}
+
+	return c
+}
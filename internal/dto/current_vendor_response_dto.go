@@ -4,4 +4,7 @@ package dto
 type CurrentVendorResponseDTO struct {
 	// Vendor is the current vendor.
 	Vendor *CurrentVendorDTO `json:"vendor"`
+
+	// Staff is the signed in staff, omitted when signed in with the vendor account.
+	Staff *VendorStaffDTO `json:"staff,omitempty"`
 }
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"main/data/models"
+
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 )
@@ -12,4 +14,15 @@ type CustomContext struct {
 
 	// Token is the JWT token extracted from the request.
 	Token *jwt.Token
+
+	// Staff is the vendor staff signed in, nil when the vendor is
+	// signed in with the vendor account.
+	Staff *models.VendorStaff
+
+	// Admin is the admin signed in, nil outside the admin endpoints.
+	Admin *models.Admin
+
+	// AuditTargetID is the ID of the record created through the admin endpoints,
+	// recorded in the audit trail since the path has no ID.
+	AuditTargetID *uint
 }
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"fmt"
+	"main/core/constants"
+	"main/data/models"
+	"main/internal/providers/storage"
+)
+
+// GalleryImageDTO is a struct that defines the gallery image data transfer object.
+type GalleryImageDTO struct {
+	// ID is the primary key of the gallery image.
+	ID uint `json:"id"`
+
+	// CourtID is the court ID of the gallery image, nil for venue images.
+	CourtID *uint `json:"court_id"`
+
+	// ImageUrl is the image URL of the gallery image.
+	ImageUrl string `json:"image_url"`
+
+	// ImageUrls is the image URLs of the gallery image for each image size.
+	ImageUrls *ImageUrlsDTO `json:"image_urls"`
+
+	// Caption is the caption of the gallery image.
+	Caption string `json:"caption"`
+
+	// Position is the order of the gallery image in the gallery.
+	Position int `json:"position"`
+
+	// IsCover is the flag whether the gallery image is the cover image.
+	IsCover bool `json:"is_cover"`
+}
+
+// FromModel is a function that converts a gallery image model to a gallery image DTO.
+//
+// m: The gallery image model.
+//
+// Returns the gallery image DTO.
+func (g GalleryImageDTO) FromModel(m *models.GalleryImage) *GalleryImageDTO {
+	// imagePath is the path to the gallery image.
+	imagePath := storage.Store.URL(fmt.Sprintf("%s/%s", constants.PATH_TO_GALLERY_IMAGES, m.Image))
+
+	return &GalleryImageDTO{
+		ID:        m.ID,
+		CourtID:   m.CourtID,
+		ImageUrl:  imagePath,
+		ImageUrls: ImageUrlsDTO{}.FromFileName(constants.PATH_TO_GALLERY_IMAGES, m.Image),
+		Caption:   m.Caption,
+		Position:  m.Position,
+		IsCover:   m.IsCover,
+	}
+}
+
+// FromModels is a function that converts a slice of gallery image models to
+// a slice of gallery image DTOs.
+//
+// m: The slice of gallery image models.
+//
+// Returns the slice of gallery image DTOs.
+func (g GalleryImageDTO) FromModels(m []models.GalleryImage) []GalleryImageDTO {
+	// Create a slice of gallery image DTOs
+	images := []GalleryImageDTO{}
+
+	// Loop through the gallery image models
+	for _, image := range m {
+		images = append(images, *g.FromModel(&image))
+	}
+
+	return images
+}
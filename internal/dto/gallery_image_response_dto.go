@@ -0,0 +1,7 @@
+package dto
+
+// GalleryImageResponseDTO is a struct that defines the gallery image response data transfer object.
+type GalleryImageResponseDTO struct {
+	// Image is the gallery image.
+	Image *GalleryImageDTO `json:"image"`
+}
@@ -0,0 +1,20 @@
+package dto
+
+import "main/data/models"
+
+// GalleryImagesResponseDTO is a struct that defines the gallery images response data transfer object.
+type GalleryImagesResponseDTO struct {
+	// Images is the list of gallery images.
+	Images []GalleryImageDTO `json:"images"`
+}
+
+// FromModels is a function that converts gallery image models to gallery images response DTO.
+//
+// m: The gallery image models.
+//
+// Returns the gallery images response DTO.
+func (g GalleryImagesResponseDTO) FromModels(m *[]models.GalleryImage) *GalleryImagesResponseDTO {
+	return &GalleryImagesResponseDTO{
+		Images: GalleryImageDTO{}.FromModels(*m),
+	}
+}
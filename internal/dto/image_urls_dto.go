@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"fmt"
+	"main/internal/providers/storage"
+	"main/pkg/utils"
+)
+
+// ImageUrlsDTO is a struct that defines the image URLs data transfer object
+// for each of the image sizes.
+type ImageUrlsDTO struct {
+	// Original is the URL of the original size image.
+	Original string `json:"original"`
+
+	// Large is the URL of the large size image.
+	Large string `json:"large"`
+
+	// Medium is the URL of the medium size image.
+	Medium string `json:"medium"`
+
+	// Small is the URL of the small size image.
+	Small string `json:"small"`
+}
+
+// FromFileName is a function that creates the image URLs from the image file name.
+// Images uploaded before the thumbnail sizes existed use the original URL for every size.
+//
+// path: The storage path to the image directory.
+// name: The image file name.
+//
+// Returns the image URLs DTO.
+func (i ImageUrlsDTO) FromFileName(path string, name string) *ImageUrlsDTO {
+	// originalPath is the path to the original size image.
+	originalPath := storage.Store.URL(fmt.Sprintf("%s/%s", path, name))
+
+	// Use the original size for every size if the image has no thumbnail sizes
+	if !utils.IsHashedImageName(name) {
+		return &ImageUrlsDTO{
+			Original: originalPath,
+			Large:    originalPath,
+			Medium:   originalPath,
+			Small:    originalPath,
+		}
+	}
+
+	return &ImageUrlsDTO{
+		Original: originalPath,
+		Large:    storage.Store.URL(fmt.Sprintf("%s/%s", path, utils.ImageVariantName(name, "large"))),
+		Medium:   storage.Store.URL(fmt.Sprintf("%s/%s", path, utils.ImageVariantName(name, "medium"))),
+		Small:    storage.Store.URL(fmt.Sprintf("%s/%s", path, utils.ImageVariantName(name, "small"))),
+	}
+}
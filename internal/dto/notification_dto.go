@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"main/data/models"
+	"time"
+)
+
+// NotificationDTO is a struct that represents the notification data transfer object.
+type NotificationDTO struct {
+	// ID is the ID of the notification.
+	ID uint `json:"id"`
+
+	// Type is the type of the notification.
+	Type string `json:"type"`
+
+	// Message is the message of the notification.
+	Message string `json:"message"`
+
+	// ReferenceID is the id of the data the notification is about.
+	ReferenceID *uint `json:"reference_id"`
+
+	// ReadAt is the time when the notification was read, null if it's unread.
+	ReadAt *time.Time `json:"read_at"`
+
+	// CreatedAt is the time when the notification was created.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FromModel is a function that converts a notification model to a notification DTO.
+//
+// m: The notification model.
+//
+// Returns the notification DTO.
+func (n NotificationDTO) FromModel(m *models.Notification) *NotificationDTO {
+	return &NotificationDTO{
+		ID:          m.ID,
+		Type:        m.Type,
+		Message:     m.Message,
+		ReferenceID: m.ReferenceID,
+		ReadAt:      m.ReadAt,
+		CreatedAt:   m.CreatedAt,
+	}
+}
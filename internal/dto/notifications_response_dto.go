@@ -0,0 +1,37 @@
+package dto
+
+import "main/data/models"
+
+// NotificationsResponseDTO is a struct that represents the notifications
+// response data transfer object.
+type NotificationsResponseDTO struct {
+	// Unread is the count of the unread notifications.
+	Unread int64 `json:"unread"`
+
+	// Notifications is the list of notifications.
+	Notifications []NotificationDTO `json:"notifications"`
+
+	// Pagination is the pagination of the notifications.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
+}
+
+// FromModels is a function that converts notification models to a notifications response DTO.
+//
+// unread: The count of the unread notifications.
+// m: The notification models.
+//
+// Returns the notifications response DTO.
+func (n NotificationsResponseDTO) FromModels(unread int64, m *[]models.Notification) *NotificationsResponseDTO {
+	// Create the notification DTOs
+	dtos := make([]NotificationDTO, 0, len(*m))
+
+	// Loop through the notifications
+	for _, notification := range *m {
+		dtos = append(dtos, *NotificationDTO{}.FromModel(&notification))
+	}
+
+	return &NotificationsResponseDTO{
+		Unread:        unread,
+		Notifications: dtos,
+	}
+}
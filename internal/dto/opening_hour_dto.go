@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"main/core/shared"
+	"main/data/models"
+	"time"
+)
+
+// OpeningHourDTO is a struct that represents the opening hour of a day of the week
+// data transfer object.
+type OpeningHourDTO struct {
+	// Weekday is the day of the week, 0 is Sunday.
+	Weekday int `json:"weekday"`
+
+	// Day is the name of the day of the week.
+	Day string `json:"day"`
+
+	// IsClosed is whether the vendor is closed on the day.
+	IsClosed bool `json:"is_closed"`
+
+	// OpenTime is the opening time of the day.
+	OpenTime *string `json:"open_time"`
+
+	// CloseTime is the closing time of the day.
+	CloseTime *string `json:"close_time"`
+}
+
+// openingTime is a helper function that formats an opening time, the time
+// is nil when the vendor is closed.
+//
+// isClosed: Whether the vendor is closed.
+// t: The opening time.
+//
+// Returns the formatted opening time.
+func openingTime(isClosed bool, t shared.TimeOnly) *string {
+	// Return nil if the vendor is closed
+	if isClosed {
+		return nil
+	}
+
+	// Format the time
+	formatted := t.Format("15:04")
+
+	return &formatted
+}
+
+// FromModel is a function that converts an opening hour model to an opening hour DTO.
+//
+// m: The opening hour model.
+//
+// Returns the opening hour DTO.
+func (o OpeningHourDTO) FromModel(m *models.OpeningHour) *OpeningHourDTO {
+	return &OpeningHourDTO{
+		Weekday:   m.Weekday,
+		Day:       time.Weekday(m.Weekday).String(),
+		IsClosed:  m.IsClosed,
+		OpenTime:  openingTime(m.IsClosed, m.OpenTime),
+		CloseTime: openingTime(m.IsClosed, m.CloseTime),
+	}
+}
@@ -0,0 +1,62 @@
+package dto
+
+import "main/core/types"
+
+// OpeningHoursDTO is a struct that represents the effective opening hours of a date
+// data transfer object.
+type OpeningHoursDTO struct {
+	// Date is the date of the opening hours.
+	Date string `json:"date"`
+
+	// Day is the name of the day of the week.
+	Day string `json:"day"`
+
+	// IsClosed is whether the vendor is closed on the date.
+	IsClosed bool `json:"is_closed"`
+
+	// OpenTime is the opening time on the date.
+	OpenTime *string `json:"open_time"`
+
+	// CloseTime is the closing time on the date.
+	CloseTime *string `json:"close_time"`
+
+	// IsSpecial is whether the opening hours come from a special day.
+	IsSpecial bool `json:"is_special"`
+
+	// Note is the note of the special day.
+	Note string `json:"note,omitempty"`
+}
+
+// FromType is a function that converts the opening hours to an opening hours DTO.
+//
+// t: The opening hours.
+//
+// Returns the opening hours DTO.
+func (o OpeningHoursDTO) FromType(t *types.OpeningHours) *OpeningHoursDTO {
+	return &OpeningHoursDTO{
+		Date:      t.Date.Format("2006-01-02"),
+		Day:       t.Date.Weekday().String(),
+		IsClosed:  t.IsClosed,
+		OpenTime:  openingTime(t.IsClosed, t.OpenTime),
+		CloseTime: openingTime(t.IsClosed, t.CloseTime),
+		IsSpecial: t.IsSpecial,
+		Note:      t.Note,
+	}
+}
+
+// FromTypes is a function that converts a list of opening hours to opening hours DTOs.
+//
+// t: The list of opening hours.
+//
+// Returns the opening hours DTOs.
+func (o OpeningHoursDTO) FromTypes(t *[]types.OpeningHours) *[]OpeningHoursDTO {
+	// Create a slice of opening hours DTOs
+	hours := []OpeningHoursDTO{}
+
+	// Convert the opening hours to opening hours DTOs
+	for _, h := range *t {
+		hours = append(hours, *OpeningHoursDTO{}.FromType(&h))
+	}
+
+	return &hours
+}
@@ -0,0 +1,23 @@
+package dto
+
+// OpeningHoursFormDTO is a struct that represents the opening hours form data transfer object.
+type OpeningHoursFormDTO struct {
+	// Days is the opening hours of the days of the week.
+	Days *[]OpeningHourFormDTO `json:"days"`
+}
+
+// OpeningHourFormDTO is a struct that represents the opening hour of a day
+// form data transfer object.
+type OpeningHourFormDTO struct {
+	// Weekday is the day of the week, 0 is Sunday.
+	Weekday *int `json:"weekday"`
+
+	// IsClosed is whether the vendor is closed on the day.
+	IsClosed bool `json:"is_closed"`
+
+	// OpenTime is the opening time of the day, formatted as HH:MM.
+	OpenTime string `json:"open_time"`
+
+	// CloseTime is the closing time of the day, formatted as HH:MM.
+	CloseTime string `json:"close_time"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"main/core/types"
+	"main/pkg/utils"
+)
+
+// PaginationDTO is a struct that defines the cursor pagination data transfer object.
+type PaginationDTO struct {
+	// NextCursor is the cursor to get the next page, null on the last page.
+	NextCursor *string `json:"next_cursor"`
+
+	// HasMore is whether there is a next page.
+	HasMore bool `json:"has_more"`
+}
+
+// FromCursor is a function that converts the next cursor to a pagination DTO.
+//
+// next: The next cursor, nil on the last page.
+//
+// Returns the pagination DTO.
+func (p PaginationDTO) FromCursor(next *types.Cursor) *PaginationDTO {
+	// Return the last page if there is no next cursor
+	if next == nil {
+		return &PaginationDTO{
+			NextCursor: nil,
+			HasMore:    false,
+		}
+	}
+
+	// Encode the next cursor
+	nextCursor := utils.EncodeCursor(next)
+
+	return &PaginationDTO{
+		NextCursor: &nextCursor,
+		HasMore:    true,
+	}
+}
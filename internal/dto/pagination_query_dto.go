@@ -0,0 +1,11 @@
+package dto
+
+// PaginationQueryDTO is a struct that represents the query parameters
+// to paginate a list.
+type PaginationQueryDTO struct {
+	// Limit is the maximum number of items in a page.
+	Limit *int `query:"limit"`
+
+	// Cursor is the next cursor of the previous page.
+	Cursor string `query:"cursor"`
+}
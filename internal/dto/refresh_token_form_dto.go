@@ -0,0 +1,7 @@
+package dto
+
+// RefreshTokenFormDTO is a struct that represents the refresh token form data transfer object.
+type RefreshTokenFormDTO struct {
+	// RefreshToken is the refresh token issued on login or on the last refresh.
+	RefreshToken string `json:"refresh_token"`
+}
@@ -0,0 +1,10 @@
+package dto
+
+// RefreshTokenResponseDTO is a struct that represents the response dto for the token refresh.
+type RefreshTokenResponseDTO struct {
+	// Token is the new JWT access token.
+	Token string `json:"token"`
+
+	// RefreshToken is the new refresh token, the used one can't be used again.
+	RefreshToken string `json:"refresh_token"`
+}
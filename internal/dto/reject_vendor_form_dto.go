@@ -0,0 +1,8 @@
+package dto
+
+// RejectVendorFormDTO is a struct that represents the reject vendor registration
+// form data transfer object.
+type RejectVendorFormDTO struct {
+	// Reason is the reason the vendor registration is rejected.
+	Reason string `json:"reason"`
+}
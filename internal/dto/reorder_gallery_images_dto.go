@@ -0,0 +1,11 @@
+package dto
+
+// ReorderGalleryImagesDTO is a struct that represents the data transfer object
+// for reordering the gallery images of a court or a venue.
+type ReorderGalleryImagesDTO struct {
+	// CourtID is the court ID of the gallery, empty for the venue gallery.
+	CourtID *uint `json:"court_id"`
+
+	// ImageIDs is the list of gallery image IDs in the new order.
+	ImageIDs []uint `json:"image_ids"`
+}
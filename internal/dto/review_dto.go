@@ -1,6 +1,9 @@
 package dto
 
-import "main/data/models"
+import (
+	"main/data/models"
+	"time"
+)
 
 // ReviewDTO is a struct that defines the review DTO.
 type ReviewDTO struct {
@@ -21,6 +24,24 @@ type ReviewDTO struct {
 
 	// Date is the date of the review
 	Date string `json:"date"`
+
+	// EditedAt is the time the review was last edited, null if it's never edited
+	EditedAt *time.Time `json:"edited_at"`
+
+	// VerifiedBooking is whether the review is written for a completed booking
+	VerifiedBooking bool `json:"verified_booking"`
+
+	// HelpfulCount is the number of users who marked the review as helpful
+	HelpfulCount int `json:"helpful_count"`
+
+	// Images is the photos attached to the review
+	Images []ReviewImageDTO `json:"images"`
+
+	// Reply is the reply of the vendor, null if the vendor hasn't replied
+	Reply *ReviewReplyDTO `json:"reply"`
+
+	// Status is the moderation status of the review
+	Status string `json:"status"`
 }
 
 // FromModel is a function that converts a review model to a review DTO.
@@ -33,11 +54,17 @@ func (r ReviewDTO) FromModel(m *models.Review) *ReviewDTO {
 	date, _ := m.Date.Value()
 
 	return &ReviewDTO{
-		ID:        m.ID,
-		User:      UserDTO{}.FromModel(&m.User),
-		CourtType: m.CourtType.Type,
-		Rating:    m.Rating,
-		Review:    m.Review,
-		Date:      date.(string),
+		ID:              m.ID,
+		User:            UserDTO{}.FromModel(&m.User),
+		CourtType:       m.CourtType.Type,
+		Rating:          m.Rating,
+		Review:          m.Review,
+		Date:            date.(string),
+		EditedAt:        m.EditedAt,
+		HelpfulCount:    m.HelpfulCount,
+		VerifiedBooking: m.BookingID != nil,
+		Images:          ReviewImageDTO{}.FromModels(m.Images),
+		Reply:           ReviewReplyDTO{}.FromModel(m.Reply),
+		Status:          m.Status,
 	}
 }
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"fmt"
+	"main/core/constants"
+	"main/data/models"
+	"main/internal/providers/storage"
+)
+
+// ReviewImageDTO is a struct that defines the review image data transfer object.
+type ReviewImageDTO struct {
+	// ID is the primary key of the review image.
+	ID uint `json:"id"`
+
+	// ImageUrl is the image URL of the review image.
+	ImageUrl string `json:"image_url"`
+
+	// ImageUrls is the image URLs of the review image for each image size.
+	ImageUrls *ImageUrlsDTO `json:"image_urls"`
+
+	// Position is the order of the image in the review.
+	Position int `json:"position"`
+}
+
+// FromModel is a function that converts a review image model to a review image DTO.
+//
+// m: The review image model.
+//
+// Returns the review image DTO.
+func (r ReviewImageDTO) FromModel(m *models.ReviewImage) *ReviewImageDTO {
+	// imagePath is the path to the review image.
+	imagePath := storage.Store.URL(fmt.Sprintf("%s/%s", constants.PATH_TO_REVIEW_IMAGES, m.Image))
+
+	return &ReviewImageDTO{
+		ID:        m.ID,
+		ImageUrl:  imagePath,
+		ImageUrls: ImageUrlsDTO{}.FromFileName(constants.PATH_TO_REVIEW_IMAGES, m.Image),
+		Position:  m.Position,
+	}
+}
+
+// FromModels is a function that converts a slice of review image models to
+// a slice of review image DTOs.
+//
+// m: The slice of review image models.
+//
+// Returns the slice of review image DTOs.
+func (r ReviewImageDTO) FromModels(m []models.ReviewImage) []ReviewImageDTO {
+	// Create a slice of review image DTOs
+	images := []ReviewImageDTO{}
+
+	// Loop through the review image models
+	for _, image := range m {
+		images = append(images, *r.FromModel(&image))
+	}
+
+	return images
+}
@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"main/data/models"
+	"time"
+)
+
+// ReviewReplyDTO is a struct that defines the review reply DTO.
+type ReviewReplyDTO struct {
+	// Reply is the reply of the vendor
+	Reply string `json:"reply"`
+
+	// CreatedAt is the time the reply was posted
+	CreatedAt time.Time `json:"created_at"`
+
+	// UpdatedAt is the time the reply was last updated
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// FromModel is a function that converts a review reply model to a review reply DTO.
+//
+// m: The review reply model.
+//
+// Returns the review reply DTO, nil if there is no reply.
+func (r ReviewReplyDTO) FromModel(m *models.ReviewReply) *ReviewReplyDTO {
+	// Return nil if there is no reply
+	if m == nil {
+		return nil
+	}
+
+	return &ReviewReplyDTO{
+		Reply:     m.Reply,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
@@ -0,0 +1,8 @@
+package dto
+
+// ReviewReplyFormDTO is a struct that represents the review reply form
+// that is sent by the vendor.
+type ReviewReplyFormDTO struct {
+	// Reply is the reply to the review.
+	Reply string `json:"reply"`
+}
@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"main/data/models"
+	"time"
+)
+
+// ReviewReportDTO is a struct that represents the review report data transfer object.
+type ReviewReportDTO struct {
+	// ID is the ID of the report.
+	ID uint `json:"id"`
+
+	// ReporterType is the client type of the reporter, either User or Vendor.
+	ReporterType string `json:"reporter_type"`
+
+	// ReporterID is the ID of the reporting user or vendor.
+	ReporterID uint `json:"reporter_id"`
+
+	// Reason is the reason of the report.
+	Reason string `json:"reason"`
+
+	// CreatedAt is the time when the review was reported.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FromModel is a function that converts a review report model to a review report DTO.
+//
+// m: The review report model.
+//
+// Returns the review report DTO.
+func (r ReviewReportDTO) FromModel(m *models.ReviewReport) *ReviewReportDTO {
+	return &ReviewReportDTO{
+		ID:           m.ID,
+		ReporterType: m.ReporterType,
+		ReporterID:   m.ReporterID,
+		Reason:       m.Reason,
+		CreatedAt:    m.CreatedAt,
+	}
+}
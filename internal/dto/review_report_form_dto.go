@@ -0,0 +1,8 @@
+package dto
+
+// ReviewReportFormDTO is a struct that represents the review report form
+// that is sent by the user or the vendor.
+type ReviewReportFormDTO struct {
+	// Reason is the reason of the report.
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"main/data/models"
+	"time"
+)
+
+// ReviewRevisionDTO is a struct that defines the previous version of a review DTO.
+type ReviewRevisionDTO struct {
+	// Rating is the rating of the previous version
+	Rating int8 `json:"rating"`
+
+	// Review is the review of the previous version
+	Review string `json:"review"`
+
+	// WrittenAt is the time the previous version was written
+	WrittenAt time.Time `json:"written_at"`
+
+	// ReplacedAt is the time the previous version was replaced
+	ReplacedAt time.Time `json:"replaced_at"`
+}
+
+// FromModel is a function that converts a review revision model to a review revision DTO.
+//
+// m: The review revision model.
+//
+// Returns the review revision DTO.
+func (r ReviewRevisionDTO) FromModel(m *models.ReviewRevision) *ReviewRevisionDTO {
+	return &ReviewRevisionDTO{
+		Rating:     m.Rating,
+		Review:     m.Review,
+		WrittenAt:  m.WrittenAt,
+		ReplacedAt: m.CreatedAt,
+	}
+}
@@ -18,6 +18,9 @@ type ReviewsResponseDTO struct {
 
 	// Reviews is a slice of review DTOs
 	Reviews *[]ReviewDTO `json:"reviews"`
+
+	// Pagination is the pagination of the reviews
+	Pagination *PaginationDTO `json:"pagination"`
 }
 
 // FromMap is a function that converts a reviews map to a reviews response DTO.
@@ -39,5 +42,6 @@ func (r ReviewsResponseDTO) FromMap(m *types.CourtReviewsMap) *ReviewsResponseDT
 		ReviewsTotal: (*m)["reviews_total"].(int64),
 		Stars:        ReviewsStarsDTO{}.FromMap((*m)["star_counts"].(*types.StarCountsMap)),
 		Reviews:      &dtos,
+		Pagination:   PaginationDTO{}.FromCursor((*m)["next_cursor"].(*types.Cursor)),
 	}
 }
@@ -0,0 +1,36 @@
+package dto
+
+import "main/data/models"
+
+// SpecialDayDTO is a struct that represents the special day data transfer object.
+type SpecialDayDTO struct {
+	// Date is the date of the special day.
+	Date string `json:"date"`
+
+	// IsClosed is whether the vendor is closed on the date.
+	IsClosed bool `json:"is_closed"`
+
+	// OpenTime is the opening time of the date.
+	OpenTime *string `json:"open_time"`
+
+	// CloseTime is the closing time of the date.
+	CloseTime *string `json:"close_time"`
+
+	// Note is the note of the special day.
+	Note string `json:"note"`
+}
+
+// FromModel is a function that converts a special day model to a special day DTO.
+//
+// m: The special day model.
+//
+// Returns the special day DTO.
+func (s SpecialDayDTO) FromModel(m *models.SpecialDay) *SpecialDayDTO {
+	return &SpecialDayDTO{
+		Date:      m.Date.Format("2006-01-02"),
+		IsClosed:  m.IsClosed,
+		OpenTime:  openingTime(m.IsClosed, m.OpenTime),
+		CloseTime: openingTime(m.IsClosed, m.CloseTime),
+		Note:      m.Note,
+	}
+}
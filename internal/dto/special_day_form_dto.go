@@ -0,0 +1,19 @@
+package dto
+
+// SpecialDayFormDTO is a struct that represents the special day form data transfer object.
+type SpecialDayFormDTO struct {
+	// Date is the date of the special day, formatted as YYYY-MM-DD.
+	Date string `json:"date"`
+
+	// IsClosed is whether the vendor is closed on the date.
+	IsClosed bool `json:"is_closed"`
+
+	// OpenTime is the opening time of the date, formatted as HH:MM.
+	OpenTime string `json:"open_time"`
+
+	// CloseTime is the closing time of the date, formatted as HH:MM.
+	CloseTime string `json:"close_time"`
+
+	// Note is the note of the special day.
+	Note string `json:"note"`
+}
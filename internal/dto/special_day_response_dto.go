@@ -0,0 +1,7 @@
+package dto
+
+// SpecialDayResponseDTO is a struct that defines the special day response data transfer object.
+type SpecialDayResponseDTO struct {
+	// SpecialDay is the special day.
+	SpecialDay *SpecialDayDTO `json:"special_day"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+import "main/data/models"
+
+// StaffActivitiesResponseDTO is a struct that represents the staff activities
+// response data transfer object.
+type StaffActivitiesResponseDTO struct {
+	// Activities is the list of staff activities.
+	Activities []StaffActivityDTO `json:"activities"`
+
+	// Pagination is the pagination of the staff activities.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
+}
+
+// FromModels is a function that converts staff activity models to a staff activities response DTO.
+//
+// m: The staff activity models.
+//
+// Returns the staff activities response DTO.
+func (s StaffActivitiesResponseDTO) FromModels(m *[]models.StaffActivity) *StaffActivitiesResponseDTO {
+	// Create the staff activity DTOs
+	dtos := make([]StaffActivityDTO, 0, len(*m))
+
+	// Loop through the staff activities
+	for _, activity := range *m {
+		dtos = append(dtos, *StaffActivityDTO{}.FromModel(&activity))
+	}
+
+	return &StaffActivitiesResponseDTO{
+		Activities: dtos,
+	}
+}
@@ -0,0 +1,48 @@
+package dto
+
+import (
+	"main/data/models"
+	"time"
+)
+
+// StaffActivityDTO is a struct that represents the staff activity data transfer object.
+type StaffActivityDTO struct {
+	// ID is the ID of the activity.
+	ID uint `json:"id"`
+
+	// StaffID is the ID of the staff who made the change, nil when
+	// the change is made with the vendor account.
+	StaffID *uint `json:"staff_id"`
+
+	// StaffName is the name of the staff when the change was made.
+	StaffName *string `json:"staff_name"`
+
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+
+	// Path is the path of the request.
+	Path string `json:"path"`
+
+	// Status is the HTTP status code of the response.
+	Status int `json:"status"`
+
+	// CreatedAt is the time when the activity was recorded.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FromModel is a function that converts a staff activity model to a staff activity DTO.
+//
+// m: The staff activity model.
+//
+// Returns the staff activity DTO.
+func (s StaffActivityDTO) FromModel(m *models.StaffActivity) *StaffActivityDTO {
+	return &StaffActivityDTO{
+		ID:        m.ID,
+		StaffID:   m.StaffID,
+		StaffName: m.StaffName,
+		Method:    m.Method,
+		Path:      m.Path,
+		Status:    m.Status,
+		CreatedAt: m.CreatedAt,
+	}
+}
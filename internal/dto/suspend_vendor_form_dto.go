@@ -0,0 +1,8 @@
+package dto
+
+// SuspendVendorFormDTO is a struct that defines the data transfer object
+// for suspending a vendor.
+type SuspendVendorFormDTO struct {
+	// Reason is the reason the vendor is suspended.
+	Reason string `json:"reason"`
+}
@@ -0,0 +1,32 @@
+package dto
+
+// UpdateAdvertisementFormDTO is a struct that represents the update advertisement
+// campaign form data transfer object, only the given fields are updated.
+type UpdateAdvertisementFormDTO struct {
+	// Name is the campaign name of the advertisement.
+	Name *string `json:"name"`
+
+	// CourtType is the advertised and targeted court type.
+	CourtType *string `json:"court_type"`
+
+	// StartDate is the first date the advertisement is shown, empty to start right away.
+	StartDate *string `json:"start_date"`
+
+	// EndDate is the last date the advertisement is shown, empty to never end.
+	EndDate *string `json:"end_date"`
+
+	// Weight is the priority weight of the advertisement.
+	Weight *uint `json:"weight"`
+
+	// Latitude is the latitude of the targeted area center.
+	Latitude *float64 `json:"latitude"`
+
+	// Longitude is the longitude of the targeted area center.
+	Longitude *float64 `json:"longitude"`
+
+	// RadiusKm is the radius of the targeted area in kilometers.
+	RadiusKm *float64 `json:"radius_km"`
+
+	// RemoveArea is whether to remove the targeted area, so every area is targeted.
+	RemoveArea bool `json:"remove_area"`
+}
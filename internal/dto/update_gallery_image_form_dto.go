@@ -0,0 +1,11 @@
+package dto
+
+// UpdateGalleryImageFormDTO is a struct that represents the data transfer object
+// for updating a gallery image.
+type UpdateGalleryImageFormDTO struct {
+	// Caption is the new caption of the gallery image.
+	Caption *string `json:"caption"`
+
+	// IsCover is the flag to set the gallery image as the cover image.
+	IsCover *bool `json:"is_cover"`
+}
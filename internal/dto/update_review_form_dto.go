@@ -0,0 +1,11 @@
+package dto
+
+// UpdateReviewFormDTO is a struct that defines the data transfer object
+// for updating a review, only the given fields are updated.
+type UpdateReviewFormDTO struct {
+	// Rating is the rating of the review
+	Rating *int8 `json:"rating"`
+
+	// Review is the review content
+	Review *string `json:"review"`
+}
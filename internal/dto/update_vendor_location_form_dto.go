@@ -0,0 +1,10 @@
+package dto
+
+// UpdateVendorLocationFormDTO is a struct that represents the update vendor location form data transfer object.
+type UpdateVendorLocationFormDTO struct {
+	// Latitude is the latitude of the vendor location.
+	Latitude *float64 `json:"latitude"`
+
+	// Longitude is the longitude of the vendor location.
+	Longitude *float64 `json:"longitude"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+// UpdateVendorProfileFormDTO is a struct that represents the update vendor profile form
+// data transfer object, only the given fields are updated.
+type UpdateVendorProfileFormDTO struct {
+	// Name is the name of the vendor.
+	Name *string `json:"name"`
+
+	// Email is the new login email of the vendor, it's applied after it's verified.
+	Email *string `json:"email"`
+
+	// Address is the address of the vendor.
+	Address *string `json:"address"`
+
+	// PhoneNumber is the contact phone number of the vendor, empty to remove it.
+	PhoneNumber *string `json:"phone_number"`
+
+	// OpenTime is the default opening time of the vendor, formatted as HH:MM.
+	OpenTime *string `json:"open_time"`
+
+	// CloseTime is the default closing time of the vendor, formatted as HH:MM.
+	CloseTime *string `json:"close_time"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+// UpdateVendorStaffFormDTO is a struct that represents the update vendor staff form
+// data transfer object, only the given fields are updated.
+type UpdateVendorStaffFormDTO struct {
+	// Name is the name of the staff.
+	Name *string `json:"name"`
+
+	// Role is the role of the staff.
+	Role *string `json:"role"`
+
+	// Password is the new password of the staff.
+	Password *string `json:"password"`
+}
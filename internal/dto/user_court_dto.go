@@ -2,9 +2,10 @@ package dto
 
 import (
 	"fmt"
+	"main/core/constants"
 	"main/core/types"
 	"main/data/models"
-	"main/delivery/http/router"
+	"main/internal/providers/storage"
 )
 
 // UserCourtDTO is a struct that defines the court data transfer object
@@ -22,14 +23,33 @@ type UserCourtDTO struct {
 	// CourtType is the type of the court.
 	Type string `json:"type"`
 
-	// Name is the name of the court.
+	// Types is the list of court types the court can be booked as.
+	Types []string `json:"types,omitempty"`
+
+	// Price is the price of the court, the cheapest price of the vendor court type in the courts catalogue.
 	Price float64 `json:"price"`
 
+	// MaxPrice is the most expensive price of the vendor court type, only set in the courts catalogue.
+	MaxPrice *float64 `json:"max_price,omitempty"`
+
 	// Rating is the rating of the court.
 	Rating *float64 `json:"rating,omitempty"`
 
+	// TotalReviews is the number of reviews of the court, only set in the courts catalogue.
+	TotalReviews *int64 `json:"total_reviews,omitempty"`
+
+	// Distance is the distance to the court in kilometers, only set when
+	// the courts are searched near a location.
+	Distance *float64 `json:"distance_km,omitempty"`
+
 	// ImageUrl is the image URL of the court.
 	ImageUrl string `json:"image_url"`
+
+	// ImageUrls is the image URLs of the court for each image size.
+	ImageUrls *ImageUrlsDTO `json:"image_urls"`
+
+	// Images is the gallery images of the court.
+	Images []GalleryImageDTO `json:"images,omitempty"`
 }
 
 // FromModel is a function that converts a court model to a court DTO.
@@ -39,16 +59,19 @@ type UserCourtDTO struct {
 // Returns the user court DTO.
 func (c UserCourtDTO) FromModel(m *models.Court) *UserCourtDTO {
 	// courtImagePath is the path to the court image.
-	courtImagePath := fmt.Sprintf("%s/%s", router.CourtImages, m.Image)
+	courtImagePath := storage.Store.URL(fmt.Sprintf("%s/%s", constants.PATH_TO_COURT_IMAGES, m.Image))
 
 	return &UserCourtDTO{
-		ID:       m.ID,
-		Name:     m.Name,
-		Vendor:   VendorDTO{}.FromModel(&m.Vendor),
-		Type:     m.CourtType.Type,
-		Price:    m.Price,
-		Rating:   nil,
-		ImageUrl: courtImagePath,
+		ID:        m.ID,
+		Name:      m.Name,
+		Vendor:    VendorDTO{}.FromModel(&m.Vendor),
+		Type:      m.CourtType.Type,
+		Types:     courtTypeNames(m),
+		Price:     m.Price,
+		Rating:    nil,
+		ImageUrl:  courtImagePath,
+		ImageUrls: ImageUrlsDTO{}.FromFileName(constants.PATH_TO_COURT_IMAGES, m.Image),
+		Images:    GalleryImageDTO{}.FromModels(m.GalleryImages),
 	}
 }
 
@@ -62,18 +85,49 @@ func (c UserCourtDTO) FromCourtMap(m *types.CourtMap) *UserCourtDTO {
 	court := m.GetCourt()
 
 	// courtImagePath is the path to the court image.
-	courtImagePath := fmt.Sprintf("%s/%s", router.CourtImages, court.Image)
+	courtImagePath := storage.Store.URL(fmt.Sprintf("%s/%s", constants.PATH_TO_COURT_IMAGES, court.Image))
 
 	// Get the rating
 	rating := m.GetTotalRating()
 
+	// Get the price range of the vendor court type, default to the court price
+	price, maxPrice := m.GetPriceRange()
+
+	if price == nil {
+		price = &court.Price
+	}
+
 	return &UserCourtDTO{
-		ID:       court.ID,
-		Name:     court.Name,
-		Vendor:   VendorDTO{}.FromModel(&court.Vendor),
-		Type:     court.CourtType.Type,
-		Price:    court.Price,
-		ImageUrl: courtImagePath,
-		Rating:   &rating,
+		ID:           court.ID,
+		Name:         court.Name,
+		Vendor:       VendorDTO{}.FromModel(&court.Vendor),
+		Type:         court.CourtType.Type,
+		Types:        courtTypeNames(&court),
+		Price:        *price,
+		MaxPrice:     maxPrice,
+		ImageUrl:     courtImagePath,
+		ImageUrls:    ImageUrlsDTO{}.FromFileName(constants.PATH_TO_COURT_IMAGES, court.Image),
+		Rating:       &rating,
+		TotalReviews: m.GetTotalReviews(),
+		Distance:     m.GetDistance(),
+		Images:       GalleryImageDTO{}.FromModels(court.GalleryImages),
 	}
 }
+
+// courtTypeNames is a helper function that returns the names of the court types
+// the court is linked to.
+//
+// m: The court model.
+//
+// Returns the court type names.
+func courtTypeNames(m *models.Court) []string {
+	// Create court type names slice
+	names := make([]string, 0, len(m.CourtTypeLinks))
+
+	// Loop through the court type links
+	for _, link := range m.CourtTypeLinks {
+		names = append(names, link.CourtType.Type)
+	}
+
+	return names
+}
@@ -10,6 +10,9 @@ import (
 type UserCourtsResponseDTO struct {
 	// Courts is the list of courts.
 	Courts *[]UserCourtDTO `json:"courts"`
+
+	// Pagination is the pagination of the courts, only set when the courts are paginated.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
 }
 
 // FromCourtMaps is a function that converts court maps to user court response DTOs.
@@ -2,8 +2,9 @@ package dto
 
 import (
 	"fmt"
+	"main/core/constants"
 	"main/data/models"
-	"main/delivery/http/router"
+	"main/internal/providers/storage"
 	"main/pkg/utils"
 )
 
@@ -17,6 +18,9 @@ type UserDTO struct {
 
 	// ProfilePictureUrl is the url of ther user profile picture.
 	ProfilePictureUrl string `json:"profile_picture_url"`
+
+	// ProfilePictureUrls is the profile picture URLs of the user for each image size.
+	ProfilePictureUrls *ImageUrlsDTO `json:"profile_picture_urls"`
 }
 
 // FromModel creates a User DTO from a User model.
@@ -35,11 +39,12 @@ func (p UserDTO) FromModel(m *models.User) *UserDTO {
 	}
 
 	// profilePicturePath is the path to the profile picture.
-	profilePicturePath := fmt.Sprintf("%s/%s", router.UserProfiles, m.ProfilePicture)
+	profilePicturePath := storage.Store.URL(fmt.Sprintf("%s/%s", constants.PATH_TO_USER_PROFILE_PICTURES, m.ProfilePicture))
 
 	return &UserDTO{
-		ID:                m.ID,
-		Username:          m.Username,
-		ProfilePictureUrl: profilePicturePath,
+		ID:                 m.ID,
+		Username:           m.Username,
+		ProfilePictureUrl:  profilePicturePath,
+		ProfilePictureUrls: ImageUrlsDTO{}.FromFileName(constants.PATH_TO_USER_PROFILE_PICTURES, m.ProfilePicture),
 	}
 }
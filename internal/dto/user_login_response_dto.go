@@ -5,6 +5,9 @@ type UserLoginResponseDTO struct {
 	// User is the current user.
 	User *CurrentUserDTO `json:"user"`
 
-	// Token is the JWT token.
+	// Token is the JWT access token.
 	Token string `json:"token"`
+
+	// RefreshToken is the refresh token used to get a new JWT token.
+	RefreshToken string `json:"refresh_token"`
 }
@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"main/data/models"
+	"time"
+)
+
+// VendorApplicationDTO is a struct that represents the vendor registration
+// application data transfer object.
+type VendorApplicationDTO struct {
+	// ID is the ID of the vendor.
+	ID uint `json:"id"`
+
+	// Name is the name of the vendor.
+	Name string `json:"name"`
+
+	// Email is the email of the vendor.
+	Email string `json:"email"`
+
+	// Address is the address of the vendor.
+	Address string `json:"address"`
+
+	// Latitude is the latitude of the vendor location.
+	Latitude *float64 `json:"latitude"`
+
+	// Longitude is the longitude of the vendor location.
+	Longitude *float64 `json:"longitude"`
+
+	// OpenTime is the open time of the vendor.
+	OpenTime string `json:"open_time"`
+
+	// CloseTime is the close time of the vendor.
+	CloseTime string `json:"close_time"`
+
+	// Status is the approval status of the vendor.
+	Status string `json:"status"`
+
+	// RejectionReason is the reason the vendor registration was rejected
+	// or the vendor was suspended.
+	RejectionReason *string `json:"rejection_reason"`
+
+	// ReviewedAt is the time when the vendor registration was reviewed.
+	ReviewedAt *time.Time `json:"reviewed_at"`
+
+	// CreatedAt is the time when the vendor registered.
+	CreatedAt time.Time `json:"created_at"`
+
+	// Documents is the business documents of the vendor registration.
+	Documents []VendorDocumentDTO `json:"documents"`
+
+	// BankAccount is the payout bank account of the vendor.
+	BankAccount *VendorBankAccountDTO `json:"bank_account"`
+}
+
+// FromModel is a function that converts a vendor model to a vendor application DTO.
+//
+// m: The vendor model, preloaded with its documents and bank account.
+//
+// Returns the vendor application DTO.
+func (v VendorApplicationDTO) FromModel(m *models.Vendor) *VendorApplicationDTO {
+	// Get the open time
+	openTime, _ := m.OpenTime.Value()
+
+	// Get the close time
+	closeTime, _ := m.CloseTime.Value()
+
+	// Get the rejection reason if any
+	var rejectionReason *string
+
+	if m.RejectionReason != "" {
+		rejectionReason = &m.RejectionReason
+	}
+
+	return &VendorApplicationDTO{
+		ID:              m.ID,
+		Name:            m.Name,
+		Email:           m.Email,
+		Address:         m.Address,
+		Latitude:        m.Latitude,
+		Longitude:       m.Longitude,
+		OpenTime:        openTime.(string),
+		CloseTime:       closeTime.(string),
+		Status:          m.Status,
+		RejectionReason: rejectionReason,
+		ReviewedAt:      m.ReviewedAt,
+		CreatedAt:       m.CreatedAt,
+		Documents:       VendorDocumentDTO{}.FromModels(m.Documents),
+		BankAccount:     VendorBankAccountDTO{}.FromModel(m.BankAccount),
+	}
+}
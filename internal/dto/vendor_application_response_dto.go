@@ -0,0 +1,8 @@
+package dto
+
+// VendorApplicationResponseDTO is a struct that represents the vendor application
+// response data transfer object.
+type VendorApplicationResponseDTO struct {
+	// Vendor is the vendor application.
+	Vendor *VendorApplicationDTO `json:"vendor"`
+}
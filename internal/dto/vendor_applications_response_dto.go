@@ -0,0 +1,32 @@
+package dto
+
+import "main/data/models"
+
+// VendorApplicationsResponseDTO is a struct that represents the vendor applications
+// response data transfer object.
+type VendorApplicationsResponseDTO struct {
+	// Vendors is the list of vendor applications.
+	Vendors []VendorApplicationDTO `json:"vendors"`
+
+	// Pagination is the pagination of the vendor applications.
+	Pagination *PaginationDTO `json:"pagination,omitempty"`
+}
+
+// FromModels is a function that converts vendor models to a vendor applications response DTO.
+//
+// m: The vendor models.
+//
+// Returns the vendor applications response DTO.
+func (v VendorApplicationsResponseDTO) FromModels(m *[]models.Vendor) *VendorApplicationsResponseDTO {
+	// Create the vendor application DTOs
+	dtos := make([]VendorApplicationDTO, 0, len(*m))
+
+	// Loop through the vendors
+	for _, vendor := range *m {
+		dtos = append(dtos, *VendorApplicationDTO{}.FromModel(&vendor))
+	}
+
+	return &VendorApplicationsResponseDTO{
+		Vendors: dtos,
+	}
+}
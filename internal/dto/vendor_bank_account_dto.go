@@ -0,0 +1,33 @@
+package dto
+
+import "main/data/models"
+
+// VendorBankAccountDTO is a struct that represents the vendor payout bank account data transfer object.
+type VendorBankAccountDTO struct {
+	// BankName is the name of the bank.
+	BankName string `json:"bank_name"`
+
+	// AccountHolder is the name of the account holder.
+	AccountHolder string `json:"account_holder"`
+
+	// AccountNumber is the number of the bank account.
+	AccountNumber string `json:"account_number"`
+}
+
+// FromModel is a function that converts a vendor bank account model to a vendor bank account DTO.
+//
+// m: The vendor bank account model, nil if the vendor has no bank account.
+//
+// Returns the vendor bank account DTO, nil if the vendor has no bank account.
+func (v VendorBankAccountDTO) FromModel(m *models.VendorBankAccount) *VendorBankAccountDTO {
+	// Return nil if the vendor has no bank account
+	if m == nil {
+		return nil
+	}
+
+	return &VendorBankAccountDTO{
+		BankName:      m.BankName,
+		AccountHolder: m.AccountHolder,
+		AccountNumber: m.AccountNumber,
+	}
+}
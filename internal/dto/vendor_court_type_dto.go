@@ -0,0 +1,29 @@
+package dto
+
+import "main/core/types"
+
+// VendorCourtTypeDTO is a struct that represents a court type offered by a vendor
+// data transfer object.
+type VendorCourtTypeDTO struct {
+	// Type is the court type.
+	Type string `json:"type"`
+
+	// CourtCount is the number of courts in the court type.
+	CourtCount int64 `json:"court_count"`
+
+	// StartingPrice is the lowest price of the courts in the court type.
+	StartingPrice float64 `json:"starting_price"`
+}
+
+// FromSummary is a function that converts a court type summary to a vendor court type DTO.
+//
+// s: The court type summary.
+//
+// Returns the vendor court type DTO.
+func (v VendorCourtTypeDTO) FromSummary(s *types.CourtTypeSummary) *VendorCourtTypeDTO {
+	return &VendorCourtTypeDTO{
+		Type:          s.Type,
+		CourtCount:    s.CourtCount,
+		StartingPrice: s.StartingPrice,
+	}
+}
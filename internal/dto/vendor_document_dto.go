@@ -0,0 +1,52 @@
+package dto
+
+import (
+	"main/data/models"
+	"time"
+)
+
+// VendorDocumentDTO is a struct that represents the vendor registration document data transfer object.
+type VendorDocumentDTO struct {
+	// ID is the ID of the document.
+	ID uint `json:"id"`
+
+	// Type is the type of the document.
+	Type string `json:"type"`
+
+	// ContentType is the MIME type of the document.
+	ContentType string `json:"content_type"`
+
+	// CreatedAt is the time when the document was uploaded.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FromModel is a function that converts a vendor document model to a vendor document DTO.
+//
+// m: The vendor document model.
+//
+// Returns the vendor document DTO.
+func (v VendorDocumentDTO) FromModel(m *models.VendorDocument) *VendorDocumentDTO {
+	return &VendorDocumentDTO{
+		ID:          m.ID,
+		Type:        m.Type,
+		ContentType: m.ContentType,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+// FromModels is a function that converts vendor document models to vendor document DTOs.
+//
+// m: The vendor document models.
+//
+// Returns the vendor document DTOs.
+func (v VendorDocumentDTO) FromModels(m []models.VendorDocument) []VendorDocumentDTO {
+	// Create the vendor document DTOs
+	dtos := make([]VendorDocumentDTO, 0, len(m))
+
+	// Loop through the vendor documents
+	for _, document := range m {
+		dtos = append(dtos, *VendorDocumentDTO{}.FromModel(&document))
+	}
+
+	return dtos
+}
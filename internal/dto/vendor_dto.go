@@ -13,11 +13,23 @@ type VendorDTO struct {
 	// Address is the address of the vendor
 	Address string `json:"address"`
 
+	// PhoneNumber is the contact phone number of the vendor.
+	PhoneNumber *string `json:"phone_number"`
+
+	// Latitude is the latitude of the vendor location.
+	Latitude *float64 `json:"latitude"`
+
+	// Longitude is the longitude of the vendor location.
+	Longitude *float64 `json:"longitude"`
+
 	// OpenTime is the open time of the vendor
 	OpenTime string `json:"open_time"`
 
 	// CloseTime is the close time of the vendor
 	CloseTime string `json:"close_time"`
+
+	// Images is the gallery images of the venue.
+	Images []GalleryImageDTO `json:"images,omitempty"`
 }
 
 // FromModel creates a CurrentVendor DTO from a Vendor model.
@@ -33,10 +45,14 @@ func (c VendorDTO) FromModel(m *models.Vendor) *VendorDTO {
 	closeTime, _ := m.CloseTime.Value()
 
 	return &VendorDTO{
-		ID:        m.ID,
-		Name:      m.Name,
-		Address:   m.Address,
-		OpenTime:  openTime.(string),
-		CloseTime: closeTime.(string),
+		ID:          m.ID,
+		Name:        m.Name,
+		Address:     m.Address,
+		PhoneNumber: m.PhoneNumber,
+		Latitude:    m.Latitude,
+		Longitude:   m.Longitude,
+		OpenTime:    openTime.(string),
+		CloseTime:   closeTime.(string),
+		Images:      GalleryImageDTO{}.FromModels(m.GalleryImages),
 	}
 }
@@ -5,6 +5,12 @@ type VendorLoginResponseDTO struct {
 	// Vendor is the current vendor.
 	Vendor *CurrentVendorDTO `json:"vendor"`
 
-	// Token is the JWT token.
+	// Staff is the signed in staff, omitted when signed in with the vendor account.
+	Staff *VendorStaffDTO `json:"staff,omitempty"`
+
+	// Token is the JWT access token.
 	Token string `json:"token"`
+
+	// RefreshToken is the refresh token used to get a new JWT token.
+	RefreshToken string `json:"refresh_token"`
 }
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"main/core/types"
+	"main/data/models"
+)
+
+// VendorProfileResponseDTO is a struct that represents the public vendor profile
+// response data transfer object.
+type VendorProfileResponseDTO struct {
+	// Vendor is the vendor, including the venue gallery images.
+	Vendor *VendorDTO `json:"vendor"`
+
+	// CourtTypes is the court types offered by the vendor.
+	CourtTypes *[]VendorCourtTypeDTO `json:"court_types"`
+
+	// TotalRating is the average rating of the vendor reviews.
+	TotalRating float64 `json:"total_rating"`
+
+	// ReviewsTotal is the total number of the vendor reviews.
+	ReviewsTotal int64 `json:"reviews_total"`
+
+	// Stars is the star distribution of the vendor reviews.
+	Stars *ReviewsStarsDTO `json:"stars"`
+
+	// RecentReviews is the most recent reviews of the vendor.
+	RecentReviews *[]ReviewDTO `json:"recent_reviews"`
+
+	// OpeningHours is the opening hours of the vendor on the upcoming days.
+	OpeningHours *[]OpeningHoursDTO `json:"opening_hours"`
+}
+
+// FromMap is a function that converts a vendor profile map to a vendor profile response DTO.
+//
+// m: The vendor profile map.
+//
+// Returns the vendor profile response DTO.
+func (v VendorProfileResponseDTO) FromMap(m *types.VendorProfileMap) *VendorProfileResponseDTO {
+	// Create a slice of vendor court type DTOs
+	courtTypes := []VendorCourtTypeDTO{}
+
+	// Convert the court type summaries to vendor court type DTOs
+	for _, summary := range *(*m)["court_types"].(*[]types.CourtTypeSummary) {
+		courtTypes = append(courtTypes, *VendorCourtTypeDTO{}.FromSummary(&summary))
+	}
+
+	// Create a slice of review DTOs
+	reviews := []ReviewDTO{}
+
+	// Convert the reviews to review DTOs
+	for _, review := range *(*m)["reviews"].(*[]models.Review) {
+		reviews = append(reviews, *ReviewDTO{}.FromModel(&review))
+	}
+
+	return &VendorProfileResponseDTO{
+		Vendor:        VendorDTO{}.FromModel((*m)["vendor"].(*models.Vendor)),
+		CourtTypes:    &courtTypes,
+		TotalRating:   (*m)["total_rating"].(float64),
+		ReviewsTotal:  (*m)["reviews_total"].(int64),
+		Stars:         ReviewsStarsDTO{}.FromMap((*m)["star_counts"].(*types.StarCountsMap)),
+		RecentReviews: &reviews,
+		OpeningHours:  OpeningHoursDTO{}.FromTypes((*m)["opening_hours"].(*[]types.OpeningHours)),
+	}
+}
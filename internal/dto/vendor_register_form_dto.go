@@ -0,0 +1,50 @@
+package dto
+
+import "mime/multipart"
+
+// VendorRegisterFormDTO is a struct that represents the register form
+// that is sent by the vendor.
+type VendorRegisterFormDTO struct {
+	// Name is the name of the vendor.
+	Name string `json:"name" form:"name"`
+
+	// Email is the email of the vendor.
+	Email string `json:"email" form:"email"`
+
+	// Password is the password of the vendor.
+	Password string `json:"password" form:"password"`
+
+	// ConfirmPassword is the confirmation password of the vendor.
+	ConfirmPassword string `json:"confirm_password" form:"confirm_password"`
+
+	// Address is the address of the vendor.
+	Address string `json:"address" form:"address"`
+
+	// Latitude is the latitude of the vendor location.
+	Latitude *float64 `json:"latitude" form:"latitude"`
+
+	// Longitude is the longitude of the vendor location.
+	Longitude *float64 `json:"longitude" form:"longitude"`
+
+	// OpenTime is the opening time of the vendor, formatted as HH:MM.
+	OpenTime string `json:"open_time" form:"open_time"`
+
+	// CloseTime is the closing time of the vendor, formatted as HH:MM.
+	CloseTime string `json:"close_time" form:"close_time"`
+
+	// BankName is the bank name of the payout bank account.
+	BankName string `json:"bank_name" form:"bank_name"`
+
+	// BankAccountHolder is the account holder name of the payout bank account.
+	BankAccountHolder string `json:"bank_account_holder" form:"bank_account_holder"`
+
+	// BankAccountNumber is the account number of the payout bank account.
+	BankAccountNumber string `json:"bank_account_number" form:"bank_account_number"`
+
+	// Documents is the base64 encoded business documents keyed by the document type.
+	Documents map[string]string `json:"documents" form:"-"`
+
+	// DocumentFiles is the uploaded business document files of a multipart request
+	// keyed by the document type.
+	DocumentFiles map[string]*multipart.FileHeader `json:"-" form:"-"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+import "main/data/models"
+
+// VendorScheduleResponseDTO is a struct that defines the vendor schedule response
+// data transfer object.
+type VendorScheduleResponseDTO struct {
+	// OpeningHours is the opening hours of the days of the week.
+	OpeningHours *[]OpeningHourDTO `json:"opening_hours"`
+
+	// SpecialDays is the upcoming special days.
+	SpecialDays *[]SpecialDayDTO `json:"special_days"`
+}
+
+// FromModels is a function that converts the opening hour and special day models
+// to a vendor schedule response DTO.
+//
+// h: The opening hour models.
+// d: The special day models.
+//
+// Returns the vendor schedule response DTO.
+func (v VendorScheduleResponseDTO) FromModels(h *[]models.OpeningHour, d *[]models.SpecialDay) *VendorScheduleResponseDTO {
+	// Create a slice of opening hour DTOs
+	hours := []OpeningHourDTO{}
+
+	// Convert the opening hours to opening hour DTOs
+	for _, hour := range *h {
+		hours = append(hours, *OpeningHourDTO{}.FromModel(&hour))
+	}
+
+	// Create a slice of special day DTOs
+	days := []SpecialDayDTO{}
+
+	// Convert the special days to special day DTOs
+	for _, day := range *d {
+		days = append(days, *SpecialDayDTO{}.FromModel(&day))
+	}
+
+	return &VendorScheduleResponseDTO{
+		OpeningHours: &hours,
+		SpecialDays:  &days,
+	}
+}
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"main/core/enums"
+	"main/data/models"
+	"time"
+)
+
+// VendorStaffDTO is a struct that represents the vendor staff data transfer object.
+type VendorStaffDTO struct {
+	// ID is the ID of the staff.
+	ID uint `json:"id"`
+
+	// Name is the name of the staff.
+	Name string `json:"name"`
+
+	// Email is the login email of the staff.
+	Email string `json:"email"`
+
+	// Role is the role of the staff.
+	Role string `json:"role"`
+
+	// Permissions is the list of permissions granted to the staff role.
+	Permissions []string `json:"permissions"`
+
+	// CreatedAt is the time when the staff was created.
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FromModel is a function that converts a vendor staff model to a vendor staff DTO.
+//
+// m: The vendor staff model.
+//
+// Returns the vendor staff DTO.
+func (v VendorStaffDTO) FromModel(m *models.VendorStaff) *VendorStaffDTO {
+	// Get the staff role permissions
+	permissions := []string{}
+
+	if role, ok := enums.ParseStaffRole(m.Role); ok {
+		for _, permission := range role.Permissions() {
+			permissions = append(permissions, permission.Label())
+		}
+	}
+
+	return &VendorStaffDTO{
+		ID:          m.ID,
+		Name:        m.Name,
+		Email:       m.Email,
+		Role:        m.Role,
+		Permissions: permissions,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+// FromModels is a function that converts vendor staff models to vendor staff DTOs.
+//
+// m: The vendor staff models.
+//
+// Returns the vendor staff DTOs.
+func (v VendorStaffDTO) FromModels(m *[]models.VendorStaff) []VendorStaffDTO {
+	// Create the vendor staff DTOs
+	dtos := make([]VendorStaffDTO, 0, len(*m))
+
+	// Loop through the vendor staff
+	for _, staff := range *m {
+		dtos = append(dtos, *VendorStaffDTO{}.FromModel(&staff))
+	}
+
+	return dtos
+}
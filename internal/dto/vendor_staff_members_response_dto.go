@@ -0,0 +1,8 @@
+package dto
+
+// VendorStaffMembersResponseDTO is a struct that represents the vendor staff members
+// response data transfer object.
+type VendorStaffMembersResponseDTO struct {
+	// Staff is the list of the vendor staff.
+	Staff []VendorStaffDTO `json:"staff"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+// VendorStaffResponseDTO is a struct that represents the vendor staff response data transfer object.
+type VendorStaffResponseDTO struct {
+	// Staff is the vendor staff.
+	Staff *VendorStaffDTO `json:"staff"`
+}
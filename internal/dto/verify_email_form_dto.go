@@ -0,0 +1,7 @@
+package dto
+
+// VerifyEmailFormDTO is a struct that represents the verify email form data transfer object.
+type VerifyEmailFormDTO struct {
+	// Token is the verification token sent to the new email.
+	Token string `json:"token"`
+}
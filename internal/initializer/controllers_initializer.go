@@ -16,6 +16,13 @@ type Controllers struct {
 	OrderController          *controllers.OrderController
 	AdvertisementController  *controllers.AdvertisementController
 	MidtransController       *controllers.MidtransController
+	CourtTypeController      *controllers.CourtTypeController
+	GalleryController        *controllers.GalleryController
+	ScheduleController       *controllers.ScheduleController
+	VendorApprovalController *controllers.VendorApprovalController
+	StaffController          *controllers.StaffController
+	AdminController          *controllers.AdminController
+	NotificationController   *controllers.NotificationController
 }
 
 // InitControllers is a function that initializes all the controllers.
@@ -26,16 +33,23 @@ type Controllers struct {
 func InitControllers(usecase *UseCases) *Controllers {
 	return &Controllers{
 		FeesController:           controllers.NewFeesController(),
-		LoginController:          controllers.NewLoginController(usecase.LoginUseCase, usecase.AuthUseCase),
+		LoginController:          controllers.NewLoginController(usecase.LoginUseCase, usecase.RefreshTokenUseCase),
 		RegisterController:       controllers.NewRegisterController(usecase.RegisterUseCase),
-		LogoutController:         controllers.NewLogoutController(usecase.LogoutUseCase),
+		LogoutController:         controllers.NewLogoutController(usecase.LogoutUseCase, usecase.RefreshTokenUseCase),
 		VerifyPasswordController: controllers.NewVerifyPasswordController(usecase.VerifyPasswordUseCase),
-		UserController:           controllers.NewUserController(usecase.UserUseCase, usecase.AuthUseCase),
+		UserController:           controllers.NewUserController(usecase.UserUseCase, usecase.AuthUseCase, usecase.PaginationUseCase),
 		VendorController:         controllers.NewVendorController(usecase.VendorUseCase),
-		CourtController:          controllers.NewCourtController(usecase.CourtUseCase, usecase.BookingUseCase),
-		ReviewController:         controllers.NewReviewController(usecase.ReviewUseCase),
-		OrderController:          controllers.NewOrderController(usecase.OrderUseCase, usecase.ReviewUseCase),
-		AdvertisementController:  controllers.NewAdvertisementController(usecase.AdvertisementUseCase),
+		CourtController:          controllers.NewCourtController(usecase.CourtUseCase, usecase.BookingUseCase, usecase.CourtTypeUseCase, usecase.ScheduleUseCase),
+		ReviewController:         controllers.NewReviewController(usecase.ReviewUseCase, usecase.CourtTypeUseCase, usecase.PaginationUseCase),
+		OrderController:          controllers.NewOrderController(usecase.OrderUseCase, usecase.ReviewUseCase, usecase.CourtTypeUseCase, usecase.StaffUseCase, usecase.PaginationUseCase),
+		AdvertisementController:  controllers.NewAdvertisementController(usecase.AdvertisementUseCase, usecase.AdvertisementStatsUseCase, usecase.PaginationUseCase),
 		MidtransController:       controllers.NewMidtransController(),
+		CourtTypeController:      controllers.NewCourtTypeController(usecase.CourtTypeUseCase),
+		GalleryController:        controllers.NewGalleryController(usecase.GalleryUseCase),
+		ScheduleController:       controllers.NewScheduleController(usecase.ScheduleUseCase),
+		VendorApprovalController: controllers.NewVendorApprovalController(usecase.VendorApprovalUseCase, usecase.PaginationUseCase),
+		StaffController:          controllers.NewStaffController(usecase.StaffUseCase, usecase.PaginationUseCase),
+		AdminController:          controllers.NewAdminController(usecase.AdminUseCase, usecase.PaginationUseCase),
+		NotificationController:   controllers.NewNotificationController(usecase.NotificationUseCase, usecase.PaginationUseCase),
 	}
 }
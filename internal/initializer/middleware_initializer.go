@@ -8,6 +8,9 @@ type Middlewares struct {
 	BlacklistedTokenMiddleware *middlewares.BlacklistTokenMiddleware
 	UserMiddleware             *middlewares.UserMiddleware
 	VendorMiddleware           *middlewares.VendorMiddleware
+	AdminMiddleware            *middlewares.AdminMiddleware
+	UploadMiddleware           *middlewares.UploadMiddleware
+	StaffMiddleware            *middlewares.StaffMiddleware
 }
 
 // InitMiddlewares is a function that initializes all the middlewares.
@@ -19,7 +22,10 @@ func InitMiddlewares(usecase *UseCases) *Middlewares {
 	return &Middlewares{
 		AuthMiddleware:             middlewares.NewAuthMiddleware(usecase.AuthUseCase),
 		BlacklistedTokenMiddleware: middlewares.NewBlacklistTokenMiddleware(usecase.BlacklistedTokenUseCase),
-		UserMiddleware:             middlewares.NewUserMiddleware(usecase.AuthUseCase),
-		VendorMiddleware:           middlewares.NewVendorMiddleware(usecase.AuthUseCase),
+		UserMiddleware:             middlewares.NewUserMiddleware(usecase.AuthUseCase, usecase.UserUseCase),
+		VendorMiddleware:           middlewares.NewVendorMiddleware(usecase.AuthUseCase, usecase.VendorUseCase),
+		AdminMiddleware:            middlewares.NewAdminMiddleware(usecase.AuthUseCase, usecase.AdminUseCase),
+		UploadMiddleware:           middlewares.NewUploadMiddleware(),
+		StaffMiddleware:            middlewares.NewStaffMiddleware(usecase.StaffUseCase),
 	}
 }
@@ -4,14 +4,32 @@ import "main/internal/repository"
 
 // Repositories is a struct that holds all the repositories.
 type Repositories struct {
-	UserRepository             *repository.UserRepository
-	BlacklistedTokenRepository *repository.BlacklistedTokenRepository
-	VendorRepository           *repository.VendorRepository
-	CourtRepository            *repository.CourtRepository
-	ReviewRepository           *repository.ReviewRepository
-	BookingRepository          *repository.BookingRepository
-	OrderRepository            *repository.OrderRepository
-	AdvertisementRepository    *repository.AdvertisementRepository
+	UserRepository              *repository.UserRepository
+	BlacklistedTokenRepository  *repository.BlacklistedTokenRepository
+	RefreshTokenRepository      *repository.RefreshTokenRepository
+	VendorRepository            *repository.VendorRepository
+	CourtRepository             *repository.CourtRepository
+	ReviewRepository            *repository.ReviewRepository
+	ReviewRevisionRepository    *repository.ReviewRevisionRepository
+	ReviewReplyRepository       *repository.ReviewReplyRepository
+	ReviewReportRepository      *repository.ReviewReportRepository
+	ReviewImageRepository       *repository.ReviewImageRepository
+	ReviewVoteRepository        *repository.ReviewVoteRepository
+	BookingRepository           *repository.BookingRepository
+	OrderRepository             *repository.OrderRepository
+	AdvertisementRepository     *repository.AdvertisementRepository
+	AdvertisementStatRepository *repository.AdvertisementStatRepository
+	CourtTypeRepository         *repository.CourtTypeRepository
+	CourtTypeLinkRepository     *repository.CourtTypeLinkRepository
+	GalleryImageRepository      *repository.GalleryImageRepository
+	OpeningHourRepository       *repository.OpeningHourRepository
+	SpecialDayRepository        *repository.SpecialDayRepository
+	EmailChangeRepository       *repository.VendorEmailChangeRepository
+	VendorStaffRepository       *repository.VendorStaffRepository
+	StaffActivityRepository     *repository.StaffActivityRepository
+	AdminRepository             *repository.AdminRepository
+	AdminAuditLogRepository     *repository.AdminAuditLogRepository
+	NotificationRepository      *repository.NotificationRepository
 }
 
 // InitRepositories is a function that initializes all the repositories.
@@ -19,13 +37,31 @@ type Repositories struct {
 // Returns a pointer to the Repositories struct.
 func InitRepositories() *Repositories {
 	return &Repositories{
-		UserRepository:             repository.NewUserRepository(),
-		BlacklistedTokenRepository: repository.NewBlacklistedTokenRepository(),
-		VendorRepository:           repository.NewVendorRepository(),
-		CourtRepository:            repository.NewCourtRepository(),
-		ReviewRepository:           repository.NewReviewRepository(),
-		BookingRepository:          repository.NewBookingRepository(),
-		OrderRepository:            repository.NewOrderRepository(),
-		AdvertisementRepository:    repository.NewAdvertisementRepository(),
+		UserRepository:              repository.NewUserRepository(),
+		BlacklistedTokenRepository:  repository.NewBlacklistedTokenRepository(),
+		RefreshTokenRepository:      repository.NewRefreshTokenRepository(),
+		VendorRepository:            repository.NewVendorRepository(),
+		CourtRepository:             repository.NewCourtRepository(),
+		ReviewRepository:            repository.NewReviewRepository(),
+		ReviewRevisionRepository:    repository.NewReviewRevisionRepository(),
+		ReviewReplyRepository:       repository.NewReviewReplyRepository(),
+		ReviewReportRepository:      repository.NewReviewReportRepository(),
+		ReviewImageRepository:       repository.NewReviewImageRepository(),
+		ReviewVoteRepository:        repository.NewReviewVoteRepository(),
+		BookingRepository:           repository.NewBookingRepository(),
+		OrderRepository:             repository.NewOrderRepository(),
+		AdvertisementRepository:     repository.NewAdvertisementRepository(),
+		AdvertisementStatRepository: repository.NewAdvertisementStatRepository(),
+		CourtTypeRepository:         repository.NewCourtTypeRepository(),
+		CourtTypeLinkRepository:     repository.NewCourtTypeLinkRepository(),
+		GalleryImageRepository:      repository.NewGalleryImageRepository(),
+		OpeningHourRepository:       repository.NewOpeningHourRepository(),
+		SpecialDayRepository:        repository.NewSpecialDayRepository(),
+		EmailChangeRepository:       repository.NewVendorEmailChangeRepository(),
+		VendorStaffRepository:       repository.NewVendorStaffRepository(),
+		StaffActivityRepository:     repository.NewStaffActivityRepository(),
+		AdminRepository:             repository.NewAdminRepository(),
+		AdminAuditLogRepository:     repository.NewAdminAuditLogRepository(),
+		NotificationRepository:      repository.NewNotificationRepository(),
 	}
 }
@@ -6,19 +6,30 @@ import (
 
 // UseCases is a struct that holds all the use cases.
 type UseCases struct {
-	AuthUseCase             *usecases.AuthUseCase
-	VerifyPasswordUseCase   *usecases.VerifyPasswordUseCase
-	RegisterUseCase         *usecases.RegisterUseCase
-	LoginUseCase            *usecases.LoginUseCase
-	LogoutUseCase           *usecases.LogoutUseCase
-	UserUseCase             *usecases.UserUseCase
-	BlacklistedTokenUseCase *usecases.BlacklistedTokenUseCase
-	VendorUseCase           *usecases.VendorUseCase
-	CourtUseCase            *usecases.CourtUseCase
-	ReviewUseCase           *usecases.ReviewUseCase
-	BookingUseCase          *usecases.BookingUseCase
-	OrderUseCase            *usecases.OrderUseCase
-	AdvertisementUseCase    *usecases.AdvertisementUseCase
+	AuthUseCase               *usecases.AuthUseCase
+	VerifyPasswordUseCase     *usecases.VerifyPasswordUseCase
+	RegisterUseCase           *usecases.RegisterUseCase
+	LoginUseCase              *usecases.LoginUseCase
+	LogoutUseCase             *usecases.LogoutUseCase
+	RefreshTokenUseCase       *usecases.RefreshTokenUseCase
+	UserUseCase               *usecases.UserUseCase
+	BlacklistedTokenUseCase   *usecases.BlacklistedTokenUseCase
+	VendorUseCase             *usecases.VendorUseCase
+	CourtUseCase              *usecases.CourtUseCase
+	ReviewUseCase             *usecases.ReviewUseCase
+	BookingUseCase            *usecases.BookingUseCase
+	OrderUseCase              *usecases.OrderUseCase
+	AdvertisementUseCase      *usecases.AdvertisementUseCase
+	AdvertisementStatsUseCase *usecases.AdvertisementStatsUseCase
+	CourtTypeUseCase          *usecases.CourtTypeUseCase
+	UploadUseCase             *usecases.UploadUseCase
+	GalleryUseCase            *usecases.GalleryUseCase
+	PaginationUseCase         *usecases.PaginationUseCase
+	ScheduleUseCase           *usecases.ScheduleUseCase
+	VendorApprovalUseCase     *usecases.VendorApprovalUseCase
+	StaffUseCase              *usecases.StaffUseCase
+	AdminUseCase              *usecases.AdminUseCase
+	NotificationUseCase       *usecases.NotificationUseCase
 }
 
 // InitUseCases is a function that initializes all the use cases.
@@ -31,29 +42,51 @@ func InitUseCases(repos *Repositories) *UseCases {
 
 	u.AuthUseCase = usecases.NewAuthUseCase()
 
-	u.VerifyPasswordUseCase = usecases.NewVerifyPasswordUseCase(u.AuthUseCase, repos.UserRepository, repos.VendorRepository)
+	u.UploadUseCase = usecases.NewUploadUseCase()
 
-	u.RegisterUseCase = usecases.NewRegisterUseCase(u.AuthUseCase, repos.UserRepository)
+	u.PaginationUseCase = usecases.NewPaginationUseCase()
 
-	u.LoginUseCase = usecases.NewLoginUseCase(u.AuthUseCase, repos.UserRepository, repos.VendorRepository)
+	u.VerifyPasswordUseCase = usecases.NewVerifyPasswordUseCase(u.AuthUseCase, repos.UserRepository, repos.VendorRepository, repos.VendorStaffRepository)
+
+	u.RegisterUseCase = usecases.NewRegisterUseCase(u.AuthUseCase, repos.UserRepository, repos.VendorRepository, u.UploadUseCase)
+
+	u.LoginUseCase = usecases.NewLoginUseCase(u.AuthUseCase, repos.UserRepository, repos.VendorRepository, repos.VendorStaffRepository, repos.AdminRepository)
 
 	u.LogoutUseCase = usecases.NewLogoutUseCase(u.AuthUseCase, repos.BlacklistedTokenRepository)
 
-	u.UserUseCase = usecases.NewUserUseCase(u.AuthUseCase, repos.UserRepository)
+	u.RefreshTokenUseCase = usecases.NewRefreshTokenUseCase(u.AuthUseCase, repos.RefreshTokenRepository, repos.UserRepository, repos.VendorRepository, repos.VendorStaffRepository, repos.AdminRepository)
+
+	u.UserUseCase = usecases.NewUserUseCase(u.AuthUseCase, repos.UserRepository, repos.RefreshTokenRepository, u.UploadUseCase)
 
 	u.BlacklistedTokenUseCase = usecases.NewBlacklistedTokenUseCase(repos.BlacklistedTokenRepository)
 
-	u.VendorUseCase = usecases.NewVendorUseCase(u.AuthUseCase, repos.VendorRepository)
+	u.ScheduleUseCase = usecases.NewScheduleUseCase(u.AuthUseCase, repos.VendorRepository, repos.OpeningHourRepository, repos.SpecialDayRepository)
 
-	u.CourtUseCase = usecases.NewCourtUseCase(u.AuthUseCase, repos.CourtRepository, repos.ReviewRepository)
+	u.VendorUseCase = usecases.NewVendorUseCase(u.AuthUseCase, repos.VendorRepository, repos.CourtRepository, repos.ReviewRepository, repos.EmailChangeRepository, repos.RefreshTokenRepository, u.ScheduleUseCase)
 
-	u.ReviewUseCase = usecases.NewReviewUseCase(u.AuthUseCase, repos.ReviewRepository, repos.BookingRepository, repos.CourtRepository)
+	u.CourtUseCase = usecases.NewCourtUseCase(u.AuthUseCase, repos.CourtRepository, repos.ReviewRepository, repos.CourtTypeRepository, repos.CourtTypeLinkRepository, repos.GalleryImageRepository, u.UploadUseCase)
+
+	u.NotificationUseCase = usecases.NewNotificationUseCase(u.AuthUseCase, repos.NotificationRepository)
+
+	u.ReviewUseCase = usecases.NewReviewUseCase(u.AuthUseCase, repos.ReviewRepository, repos.ReviewRevisionRepository, repos.ReviewReplyRepository, repos.ReviewReportRepository, repos.ReviewImageRepository, repos.ReviewVoteRepository, repos.BookingRepository, repos.CourtRepository, repos.CourtTypeRepository, u.NotificationUseCase, u.UploadUseCase)
 
 	u.BookingUseCase = usecases.NewBookingUseCase(u.AuthUseCase, repos.BookingRepository)
 
-	u.OrderUseCase = usecases.NewOrderUseCase(u.AuthUseCase, repos.OrderRepository, repos.BookingRepository, repos.CourtRepository)
+	u.OrderUseCase = usecases.NewOrderUseCase(u.AuthUseCase, repos.OrderRepository, repos.BookingRepository, repos.CourtRepository, repos.CourtTypeRepository, u.ScheduleUseCase)
+
+	u.AdvertisementUseCase = usecases.NewAdvertisementUseCase(repos.AdvertisementRepository, repos.VendorRepository, repos.CourtTypeRepository, u.UploadUseCase)
+
+	u.AdvertisementStatsUseCase = usecases.NewAdvertisementStatsUseCase(u.AuthUseCase, repos.AdvertisementStatRepository, repos.AdvertisementRepository)
+
+	u.CourtTypeUseCase = usecases.NewCourtTypeUseCase(repos.CourtTypeRepository, u.UploadUseCase)
+
+	u.GalleryUseCase = usecases.NewGalleryUseCase(u.AuthUseCase, repos.GalleryImageRepository, repos.CourtRepository, u.UploadUseCase)
+
+	u.VendorApprovalUseCase = usecases.NewVendorApprovalUseCase(repos.VendorRepository, repos.RefreshTokenRepository, u.UploadUseCase)
+
+	u.StaffUseCase = usecases.NewStaffUseCase(u.AuthUseCase, repos.VendorStaffRepository, repos.StaffActivityRepository, repos.RefreshTokenRepository)
 
-	u.AdvertisementUseCase = usecases.NewAdvertisementUseCase(repos.AdvertisementRepository)
+	u.AdminUseCase = usecases.NewAdminUseCase(u.AuthUseCase, repos.AdminRepository, repos.AdminAuditLogRepository)
 
 	return u
 }
@@ -0,0 +1,48 @@
+package mailer
+
+import (
+	"fmt"
+	"log"
+	"main/core/config"
+	"net/smtp"
+	"strings"
+)
+
+// SendMail is a function that sends a plain text mail through the SMTP server,
+// the mail is only logged when there is no SMTP server configured.
+//
+// to: The recipient address.
+// subject: The mail subject.
+// body: The plain text mail body.
+//
+// Returns an error if any.
+func SendMail(to string, subject string, body string) error {
+	cfg := config.MailConfig
+
+	// Log the mail if there is no SMTP server
+	if cfg.SMTPHost == "" {
+		log.Printf("Mail to %s: %s\n%s", to, subject, body)
+
+		return nil
+	}
+
+	// Create the mail message
+	message := strings.Join([]string{
+		"From: " + cfg.From,
+		"To: " + to,
+		"Subject: " + subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+		"",
+		body,
+	}, "\r\n")
+
+	// Authenticate to the SMTP server if there is a username
+	var auth smtp.Auth
+
+	if cfg.SMTPUsername != "" {
+		auth = smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)
+	}
+
+	return smtp.SendMail(fmt.Sprintf("%s:%d", cfg.SMTPHost, cfg.SMTPPort), auth, cfg.From, []string{to}, []byte(message))
+}
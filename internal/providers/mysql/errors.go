@@ -0,0 +1,22 @@
+package mysql
+
+import (
+	"errors"
+
+	driver "github.com/go-sql-driver/mysql"
+)
+
+// duplicateEntryErrorNumber is the MySQL error number of a duplicate value of a unique index.
+const duplicateEntryErrorNumber = 1062
+
+// IsDuplicateKeyError is a function that checks if the error is caused by a duplicate
+// value of a unique index.
+//
+// err: The error.
+//
+// Returns true if the error is a duplicate key error.
+func IsDuplicateKeyError(err error) bool {
+	var mysqlErr *driver.MySQLError
+
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == duplicateEntryErrorNumber
+}
@@ -1,6 +1,9 @@
 package mysql
 
-import "main/data/models"
+import (
+	"log"
+	"main/data/models"
+)
 
 // Migrate is a function that migrates the database
 // It creates the tables if they do not exist
@@ -8,14 +11,118 @@ import "main/data/models"
 // Returns an error if any
 func Migrate() error {
 	// Migrate the database
-	return Conn.AutoMigrate(
+	err := Conn.AutoMigrate(
 		&models.User{},
+		&models.Notification{},
 		&models.BlacklistedToken{},
+		&models.RefreshToken{},
 		&models.Vendor{},
 		&models.CourtType{},
 		&models.Court{},
+		&models.CourtTypeLink{},
 		&models.Review{},
+		&models.ReviewRevision{},
+		&models.ReviewReply{},
+		&models.ReviewReport{},
+		&models.ReviewImage{},
+		&models.ReviewVote{},
+		&models.ReviewSummary{},
 		&models.Booking{},
 		&models.Order{},
-		&models.Advertisement{})
+		&models.Advertisement{},
+		&models.AdvertisementStat{},
+		&models.GalleryImage{},
+		&models.OpeningHour{},
+		&models.SpecialDay{},
+		&models.VendorDocument{},
+		&models.VendorBankAccount{},
+		&models.VendorEmailChange{},
+		&models.VendorStaff{},
+		&models.StaffActivity{},
+		&models.Admin{},
+		&models.AdminAuditLog{})
+
+	// Return an error if any
+	if err != nil {
+		return err
+	}
+
+	// Migrate the existing data
+	return migrateData()
+}
+
+// migrateData is a function that fills the columns and tables added
+// to the existing data, it's safe to run on every start up.
+//
+// Returns an error if any
+func migrateData() error {
+	// Link every court to its primary court type
+	err := Conn.Exec(`INSERT IGNORE INTO court_type_links (court_id, court_type_id, created_at)
+		SELECT id, court_type_id, created_at FROM courts`).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to migrate court type links: " + err.Error())
+
+		return err
+	}
+
+	// Set the booked court type of the bookings made before the court type links
+	err = Conn.Exec(`UPDATE bookings JOIN courts ON courts.id = bookings.court_id
+		SET bookings.court_type_id = courts.court_type_id
+		WHERE bookings.court_type_id IS NULL`).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to migrate bookings court type: " + err.Error())
+
+		return err
+	}
+
+	// Set the creation time of the reviews made before it's recorded
+	err = Conn.Exec(`UPDATE reviews SET created_at = date WHERE created_at IS NULL`).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to migrate reviews creation time: " + err.Error())
+
+		return err
+	}
+
+	// Summarize the reviews made before the review summaries are recorded
+	err = Conn.Exec(`INSERT IGNORE INTO review_summaries
+		(vendor_id, court_type_id, review_count, rating_sum, one_star, two_star, three_star, four_star, five_star, updated_at)
+		SELECT vendor_id, court_type_id, COUNT(id), SUM(rating),
+			COUNT(CASE WHEN rating = 1 THEN 1 END), COUNT(CASE WHEN rating = 2 THEN 1 END),
+			COUNT(CASE WHEN rating = 3 THEN 1 END), COUNT(CASE WHEN rating = 4 THEN 1 END),
+			COUNT(CASE WHEN rating = 5 THEN 1 END), NOW()
+		FROM reviews
+		WHERE status = 'Published' AND deleted_at IS NULL
+		GROUP BY vendor_id, court_type_id`).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to migrate review summaries: " + err.Error())
+
+		return err
+	}
+
+	// Tie the reviews made before the reviews are tied to a booking to the latest completed booking of the court
+	err = Conn.Exec(`UPDATE reviews SET booking_id = (
+			SELECT MAX(bookings.id) FROM bookings
+			JOIN orders ON orders.id = bookings.order_id
+			WHERE bookings.user_id = reviews.user_id AND bookings.vendor_id = reviews.vendor_id
+				AND bookings.court_type_id = reviews.court_type_id AND orders.status = 'Success'
+				AND (bookings.date < CURDATE() OR (bookings.date = CURDATE()
+					AND bookings.book_end_time > bookings.book_start_time AND bookings.book_end_time <= CURTIME())))
+		WHERE booking_id IS NULL`).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Failed to migrate reviews booking: " + err.Error())
+
+		return err
+	}
+
+	return nil
 }
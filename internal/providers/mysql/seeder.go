@@ -2,13 +2,23 @@ package mysql
 
 import (
 	"log"
-	"main/core/enums"
 	"main/data/models"
 	"sync"
 
 	"gorm.io/gorm/clause"
 )
 
+// defaultCourtTypes is the list of court types seeded into a new database.
+// Court types are managed data, so these are only inserted when the rows
+// with the same ids don't exist yet.
+var defaultCourtTypes = []string{
+	"Football",
+	"Basketball",
+	"Tennis",
+	"Volleyball",
+	"Badminton",
+}
+
 // Seed is a function that seeds the database
 // It creates the initial data if it does not exist
 //
@@ -29,8 +39,8 @@ func Seed() error {
 
 		courtTypes := make([]models.CourtType, 0)
 
-		// Loop through the court types enum
-		for i, v := range enums.CourtTypes() {
+		// Loop through the default court types
+		for i, v := range defaultCourtTypes {
 			courtTypes = append(courtTypes, models.CourtType{
 				ID:   uint(i + 1),
 				Type: v,
@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"fmt"
+	"main/core/config"
+	"mime"
+	"path"
+)
+
+// Store is a global variable that holds the storage the uploaded assets are stored in.
+var Store Storage
+
+// PrivateStore is a global variable that holds the storage the private uploaded assets are
+// stored in, like the vendor registration documents, it's never served publicly.
+var PrivateStore Storage
+
+// New is a factory function that returns the storage of the storage driver.
+//
+// driver: The storage driver, either local or s3.
+// cfg: The storage configuration.
+//
+// Returns the storage and an error if any.
+func New(driver string, cfg config.Storage) (Storage, error) {
+	switch driver {
+	case "local":
+		return NewLocalStorage(cfg.LocalDir, cfg.PublicURL), nil
+	case "s3":
+		return NewS3Storage(cfg)
+	}
+
+	return nil, fmt.Errorf("unknown storage driver: %s", driver)
+}
+
+// NewPrivate is a factory function that returns the private storage of the storage driver,
+// the private local directory or the private bucket without a public URL.
+//
+// driver: The storage driver, either local or s3.
+// cfg: The storage configuration.
+//
+// Returns the private storage and an error if any.
+func NewPrivate(driver string, cfg config.Storage) (Storage, error) {
+	// Use the private directory and bucket
+	cfg.LocalDir = cfg.PrivateLocalDir
+	cfg.S3Bucket = cfg.S3PrivateBucket
+	cfg.PublicURL = ""
+
+	return New(driver, cfg)
+}
+
+// MoveToPrivate is a function that moves the assets under the prefixes from the public
+// storage to the private storage, it's safe to run on every start up.
+//
+// prefixes: The storage paths of the private assets.
+//
+// Returns an error if any.
+func MoveToPrivate(prefixes []string) error {
+	// Loop through the storage paths
+	for _, prefix := range prefixes {
+		// Get the keys of the public assets
+		keys, err := Store.List(prefix + "/")
+
+		// Return an error if any
+		if err != nil {
+			return err
+		}
+
+		// Loop through the keys
+		for _, key := range keys {
+			// Read the asset
+			data, err := Store.Get(key)
+
+			// Return an error if any
+			if err != nil {
+				return err
+			}
+
+			// Write the asset to the private storage
+			if err = PrivateStore.Put(key, data, mime.TypeByExtension(path.Ext(key))); err != nil {
+				return err
+			}
+
+			// Remove the public asset
+			if err = Store.Delete(key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Connect is a helper function that connects to the configured storage.
+//
+// Returns an error if any.
+func Connect() error {
+	var err error
+
+	// Create the configured storage
+	Store, err = New(config.StorageConfig.Driver, config.StorageConfig)
+
+	// Return an error if any
+	if err != nil {
+		return err
+	}
+
+	// Create the configured private storage
+	PrivateStore, err = NewPrivate(config.StorageConfig.Driver, config.StorageConfig)
+
+	return err
+}
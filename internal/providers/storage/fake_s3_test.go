@@ -0,0 +1,261 @@
+package storage
+
+import (
+	"bufio"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3Object is a struct that defines an object stored in the fake S3 server.
+type fakeS3Object struct {
+	// Data is the data of the object.
+	Data []byte
+
+	// ContentType is the content type of the object.
+	ContentType string
+
+	// ModifiedAt is the time the object was last written.
+	ModifiedAt time.Time
+}
+
+// fakeS3 is a struct that defines an in-memory S3 compatible server, it only implements
+// the bucket and object requests the S3 storage makes, without checking the signatures.
+type fakeS3 struct {
+	// mu is the mutex of the buckets and objects.
+	mu sync.Mutex
+
+	// buckets is the set of the created buckets.
+	buckets map[string]bool
+
+	// objects is the objects keyed by the bucket and the object key.
+	objects map[string]map[string]fakeS3Object
+}
+
+// fakeS3ListEntry is an object of the ListObjectsV2 response of the fake S3 server.
+type fakeS3ListEntry struct {
+	Key          string `xml:"Key"`
+	Size         int    `xml:"Size"`
+	LastModified string `xml:"LastModified"`
+}
+
+// fakeS3ListResult is the ListObjectsV2 response of the fake S3 server.
+type fakeS3ListResult struct {
+	XMLName     xml.Name          `xml:"ListBucketResult"`
+	Name        string            `xml:"Name"`
+	Prefix      string            `xml:"Prefix"`
+	KeyCount    int               `xml:"KeyCount"`
+	IsTruncated bool              `xml:"IsTruncated"`
+	Contents    []fakeS3ListEntry `xml:"Contents"`
+}
+
+// newFakeS3Server is a helper function that starts a fake S3 server for the test,
+// the server is closed after the test.
+//
+// t: The test.
+//
+// Returns the host and port of the server.
+func newFakeS3Server(t *testing.T) string {
+	f := &fakeS3{
+		buckets: make(map[string]bool),
+		objects: make(map[string]map[string]fakeS3Object),
+	}
+
+	server := httptest.NewServer(f)
+
+	t.Cleanup(server.Close)
+
+	return strings.TrimPrefix(server.URL, "http://")
+}
+
+// ServeHTTP is a function that handles the path style S3 requests.
+//
+// w: The response writer.
+// r: The request.
+//
+// Returns nothing.
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// Split the path into the bucket and the object key
+	bucket, key, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, "/"), "/")
+
+	// Handle the bucket requests
+	if key == "" {
+		f.serveBucket(w, r, bucket)
+
+		return
+	}
+
+	// Return not found if the bucket doesn't exist
+	if !f.buckets[bucket] {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	// Handle the object requests
+	switch r.Method {
+	case http.MethodPut:
+		// Read the object data
+		data, err := readFakeS3Body(r)
+
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		f.objects[bucket][key] = fakeS3Object{
+			Data:        data,
+			ContentType: r.Header.Get("Content-Type"),
+			ModifiedAt:  time.Now().UTC(),
+		}
+
+		w.Header().Set("ETag", fakeS3ETag(data))
+	case http.MethodGet, http.MethodHead:
+		object, ok := f.objects[bucket][key]
+
+		// Return not found if the object doesn't exist
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", object.ContentType)
+		w.Header().Set("Content-Length", strconv.Itoa(len(object.Data)))
+		w.Header().Set("Last-Modified", object.ModifiedAt.Format(http.TimeFormat))
+		w.Header().Set("ETag", fakeS3ETag(object.Data))
+
+		if r.Method == http.MethodGet {
+			w.Write(object.Data)
+		}
+	case http.MethodDelete:
+		delete(f.objects[bucket], key)
+
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// serveBucket is a helper function that handles the bucket requests.
+//
+// w: The response writer.
+// r: The request.
+// bucket: The bucket name.
+//
+// Returns nothing.
+func (f *fakeS3) serveBucket(w http.ResponseWriter, r *http.Request, bucket string) {
+	switch {
+	case r.Method == http.MethodPut:
+		// Create the bucket
+		if !f.buckets[bucket] {
+			f.buckets[bucket] = true
+			f.objects[bucket] = make(map[string]fakeS3Object)
+		}
+	case !f.buckets[bucket]:
+		w.WriteHeader(http.StatusNotFound)
+	case r.Method == http.MethodHead:
+		w.WriteHeader(http.StatusOK)
+	case r.Method == http.MethodGet && r.URL.Query().Get("list-type") == "2":
+		// List the objects under the prefix
+		result := fakeS3ListResult{Name: bucket, Prefix: r.URL.Query().Get("prefix")}
+
+		keys := []string{}
+
+		for key := range f.objects[bucket] {
+			if strings.HasPrefix(key, result.Prefix) {
+				keys = append(keys, key)
+			}
+		}
+
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			object := f.objects[bucket][key]
+
+			result.Contents = append(result.Contents, fakeS3ListEntry{
+				Key:          key,
+				Size:         len(object.Data),
+				LastModified: object.ModifiedAt.Format(time.RFC3339),
+			})
+		}
+
+		result.KeyCount = len(keys)
+
+		w.Header().Set("Content-Type", "application/xml")
+		xml.NewEncoder(w).Encode(result)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// readFakeS3Body is a helper function that reads the object data of a PUT request,
+// decoding the aws-chunked body the client sends over plain HTTP.
+//
+// r: The request.
+//
+// Returns the object data and an error if any.
+func readFakeS3Body(r *http.Request) ([]byte, error) {
+	// Read the plain body
+	if !strings.HasPrefix(r.Header.Get("X-Amz-Content-Sha256"), "STREAMING-") {
+		return io.ReadAll(r.Body)
+	}
+
+	// Read the chunks, each one is "<hex size>;chunk-signature=<signature>\r\n<data>\r\n"
+	reader := bufio.NewReader(r.Body)
+	data := []byte{}
+
+	for {
+		line, err := reader.ReadString('\n')
+
+		if err != nil {
+			return nil, err
+		}
+
+		sizeHex, _, _ := strings.Cut(strings.TrimSpace(line), ";")
+
+		size, err := strconv.ParseInt(sizeHex, 16, 64)
+
+		if err != nil {
+			return nil, fmt.Errorf("invalid chunk size %q", sizeHex)
+		}
+
+		// Stop at the last chunk
+		if size == 0 {
+			return data, nil
+		}
+
+		chunk := make([]byte, size+2)
+
+		if _, err = io.ReadFull(reader, chunk); err != nil {
+			return nil, err
+		}
+
+		data = append(data, chunk[:size]...)
+	}
+}
+
+// fakeS3ETag is a helper function that returns the quoted MD5 ETag of the data.
+//
+// data: The data.
+//
+// Returns the ETag.
+func fakeS3ETag(data []byte) string {
+	sum := md5.Sum(data)
+
+	return `"` + hex.EncodeToString(sum[:]) + `"`
+}
@@ -0,0 +1,196 @@
+package storage
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// LocalStorage is a struct that defines the storage backed by a local directory.
+type LocalStorage struct {
+	// Dir is the root directory of the storage.
+	Dir string
+
+	// PublicURL is the base URL the directory is served from.
+	PublicURL string
+}
+
+// NewLocalStorage is a factory function that returns a new instance of the LocalStorage.
+//
+// dir: The root directory of the storage.
+// publicURL: The base URL the directory is served from.
+//
+// Returns a new instance of the LocalStorage.
+func NewLocalStorage(dir string, publicURL string) *LocalStorage {
+	return &LocalStorage{
+		Dir:       dir,
+		PublicURL: strings.TrimSuffix(publicURL, "/"),
+	}
+}
+
+// path is a helper function that returns the file path of the key.
+//
+// key: The key.
+//
+// Returns the file path.
+func (l *LocalStorage) path(key string) string {
+	return filepath.Join(l.Dir, filepath.FromSlash(path.Clean("/"+key)))
+}
+
+// Put is a function that writes the data to the file of the key.
+//
+// key: The key.
+// data: The data.
+// contentType: The content type of the data.
+//
+// Returns an error if any.
+func (l *LocalStorage) Put(key string, data []byte, contentType string) error {
+	// Get the file path
+	filePath := l.path(key)
+
+	// Create the directory of the file
+	err := os.MkdirAll(filepath.Dir(filePath), 0755)
+
+	// Return an error if any
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filePath, data, 0644)
+}
+
+// PutReader is a function that copies the data of the reader to the file of the key.
+//
+// key: The key.
+// reader: The reader of the data.
+// size: The size of the data.
+// contentType: The content type of the data.
+//
+// Returns an error if any.
+func (l *LocalStorage) PutReader(key string, reader io.Reader, size int64, contentType string) error {
+	// Get the file path
+	filePath := l.path(key)
+
+	// Create the directory of the file
+	err := os.MkdirAll(filepath.Dir(filePath), 0755)
+
+	// Return an error if any
+	if err != nil {
+		return err
+	}
+
+	// Create the file
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+
+	// Return an error if any
+	if err != nil {
+		return err
+	}
+
+	// Copy the data to the file
+	_, err = io.Copy(file, reader)
+
+	// Close the file, keeping the copy error if any
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	return err
+}
+
+// Get is a function that reads the file of the key.
+//
+// key: The key.
+//
+// Returns the data and an error if any.
+func (l *LocalStorage) Get(key string) ([]byte, error) {
+	return os.ReadFile(l.path(key))
+}
+
+// Delete is a function that removes the file of the key.
+//
+// key: The key.
+//
+// Returns an error if any.
+func (l *LocalStorage) Delete(key string) error {
+	// Remove the file
+	err := os.Remove(l.path(key))
+
+	// Ignore the missing file
+	if os.IsNotExist(err) {
+		return nil
+	}
+
+	return err
+}
+
+// Exists is a function that checks if the file of the key exists.
+//
+// key: The key.
+//
+// Returns a boolean indicates the file exists and an error if any.
+func (l *LocalStorage) Exists(key string) (bool, error) {
+	// Get the file info
+	_, err := os.Stat(l.path(key))
+
+	// Return false if the file doesn't exist
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// List is a function that returns the keys of the files under the prefix.
+//
+// prefix: The key prefix.
+//
+// Returns the keys and an error if any.
+func (l *LocalStorage) List(prefix string) ([]string, error) {
+	// Create the keys slice
+	keys := []string{}
+
+	// Walk through the directory of the prefix
+	err := filepath.WalkDir(l.path(prefix), func(filePath string, d fs.DirEntry, err error) error {
+		// Skip the missing directory
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		// Return an error if any
+		if err != nil {
+			return err
+		}
+
+		// Skip the directories
+		if d.IsDir() {
+			return nil
+		}
+
+		// Get the key of the file
+		key, err := filepath.Rel(l.Dir, filePath)
+
+		// Return an error if any
+		if err != nil {
+			return err
+		}
+
+		keys = append(keys, filepath.ToSlash(key))
+
+		return nil
+	})
+
+	return keys, err
+}
+
+// URL is a function that returns the public URL of the key.
+//
+// key: The key.
+//
+// Returns the public URL.
+func (l *LocalStorage) URL(key string) string {
+	return l.PublicURL + "/" + key
+}
@@ -0,0 +1,174 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"main/core/config"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3Storage is a struct that defines the storage backed by an S3 compatible bucket.
+type S3Storage struct {
+	// Client is the S3 client.
+	Client *minio.Client
+
+	// Bucket is the bucket the data is stored in.
+	Bucket string
+
+	// PublicURL is the base URL the bucket is served from.
+	PublicURL string
+}
+
+// NewS3Storage is a factory function that returns a new instance of the S3Storage,
+// the bucket is created if it doesn't exist.
+//
+// cfg: The storage configuration.
+//
+// Returns a new instance of the S3Storage and an error if any.
+func NewS3Storage(cfg config.Storage) (*S3Storage, error) {
+	// Create the S3 client
+	client, err := minio.New(cfg.S3Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(cfg.S3AccessKey, cfg.S3SecretKey, ""),
+		Secure: cfg.S3UseSSL,
+		Region: cfg.S3Region,
+	})
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the bucket exists
+	exists, err := client.BucketExists(context.Background(), cfg.S3Bucket)
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	// Create the bucket if it doesn't exist
+	if !exists {
+		err = client.MakeBucket(context.Background(), cfg.S3Bucket, minio.MakeBucketOptions{Region: cfg.S3Region})
+
+		// Return an error if any
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &S3Storage{
+		Client:    client,
+		Bucket:    cfg.S3Bucket,
+		PublicURL: strings.TrimSuffix(cfg.PublicURL, "/"),
+	}, nil
+}
+
+// Put is a function that uploads the data to the object of the key.
+//
+// key: The key.
+// data: The data.
+// contentType: The content type of the data.
+//
+// Returns an error if any.
+func (s *S3Storage) Put(key string, data []byte, contentType string) error {
+	return s.PutReader(key, bytes.NewReader(data), int64(len(data)), contentType)
+}
+
+// PutReader is a function that uploads the data of the reader to the object of the key.
+//
+// key: The key.
+// reader: The reader of the data.
+// size: The size of the data.
+// contentType: The content type of the data.
+//
+// Returns an error if any.
+func (s *S3Storage) PutReader(key string, reader io.Reader, size int64, contentType string) error {
+	_, err := s.Client.PutObject(context.Background(), s.Bucket, key, reader, size, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+
+	return err
+}
+
+// Get is a function that downloads the object of the key.
+//
+// key: The key.
+//
+// Returns the data and an error if any.
+func (s *S3Storage) Get(key string) ([]byte, error) {
+	// Get the object
+	object, err := s.Client.GetObject(context.Background(), s.Bucket, key, minio.GetObjectOptions{})
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	defer object.Close()
+
+	return io.ReadAll(object)
+}
+
+// Delete is a function that removes the object of the key.
+//
+// key: The key.
+//
+// Returns an error if any.
+func (s *S3Storage) Delete(key string) error {
+	return s.Client.RemoveObject(context.Background(), s.Bucket, key, minio.RemoveObjectOptions{})
+}
+
+// Exists is a function that checks if the object of the key exists.
+//
+// key: The key.
+//
+// Returns a boolean indicates the object exists and an error if any.
+func (s *S3Storage) Exists(key string) (bool, error) {
+	// Get the object info
+	_, err := s.Client.StatObject(context.Background(), s.Bucket, key, minio.StatObjectOptions{})
+
+	// Return false if the object doesn't exist
+	if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+		return false, nil
+	}
+
+	return err == nil, err
+}
+
+// List is a function that returns the keys of the objects under the prefix.
+//
+// prefix: The key prefix.
+//
+// Returns the keys and an error if any.
+func (s *S3Storage) List(prefix string) ([]string, error) {
+	// Create the keys slice
+	keys := []string{}
+
+	// Loop through the objects under the prefix
+	for object := range s.Client.ListObjects(context.Background(), s.Bucket, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	}) {
+		// Return an error if any
+		if object.Err != nil {
+			return nil, object.Err
+		}
+
+		keys = append(keys, object.Key)
+	}
+
+	return keys, nil
+}
+
+// URL is a function that returns the public URL of the key.
+//
+// key: The key.
+//
+// Returns the public URL.
+func (s *S3Storage) URL(key string) string {
+	return s.PublicURL + "/" + key
+}
@@ -0,0 +1,29 @@
+package storage
+
+import "io"
+
+// Storage is an interface that defines the backend the uploaded assets are stored in.
+// Keys are slash separated paths relative to the storage root, like "court_images/abc.jpg".
+type Storage interface {
+	// Put stores the data under the key, replacing the existing data if any.
+	Put(key string, data []byte, contentType string) error
+
+	// PutReader stores the data read from the reader under the key without buffering it,
+	// replacing the existing data if any.
+	PutReader(key string, reader io.Reader, size int64, contentType string) error
+
+	// Get returns the data stored under the key.
+	Get(key string) ([]byte, error)
+
+	// Delete removes the data stored under the key, missing keys are not an error.
+	Delete(key string) error
+
+	// Exists checks if there is data stored under the key.
+	Exists(key string) (bool, error)
+
+	// List returns the keys stored under the prefix.
+	List(prefix string) ([]string, error)
+
+	// URL returns the public URL of the key.
+	URL(key string) string
+}
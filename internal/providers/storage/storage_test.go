@@ -0,0 +1,155 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"main/core/config"
+	"main/pkg/utils"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// testStorageContract is a helper function that checks the storage behaves as
+// the Storage interface describes.
+//
+// t: The test.
+// s: The storage.
+// publicURL: The base URL the storage is served from.
+//
+// Returns nothing.
+func testStorageContract(t *testing.T, s Storage, publicURL string) {
+	// Create the keys under a unique prefix, so runs against a shared bucket don't collide
+	prefix := fmt.Sprintf("storage_test/%d", time.Now().UnixNano())
+	key := prefix + "/image.jpg"
+	otherKey := prefix + "/nested/other.jpg"
+
+	// Remove the keys after the test
+	t.Cleanup(func() {
+		s.Delete(key)
+		s.Delete(otherKey)
+	})
+
+	// Check the missing key
+	exists, err := s.Exists(key)
+
+	if err != nil || exists {
+		t.Fatalf("Exists(missing) = %v, %v, want false, nil", exists, err)
+	}
+
+	if _, err = s.Get(key); err == nil {
+		t.Fatal("Get(missing) returned no error")
+	}
+
+	if err = s.Delete(key); err != nil {
+		t.Fatalf("Delete(missing) = %v, want nil", err)
+	}
+
+	// Put the data
+	if err = s.Put(key, []byte("first"), "image/jpeg"); err != nil {
+		t.Fatalf("Put = %v, want nil", err)
+	}
+
+	exists, err = s.Exists(key)
+
+	if err != nil || !exists {
+		t.Fatalf("Exists(put) = %v, %v, want true, nil", exists, err)
+	}
+
+	// Replace the data
+	if err = s.Put(key, []byte("second"), "image/jpeg"); err != nil {
+		t.Fatalf("Put(replace) = %v, want nil", err)
+	}
+
+	data, err := s.Get(key)
+
+	if err != nil || !bytes.Equal(data, []byte("second")) {
+		t.Fatalf("Get = %q, %v, want %q, nil", data, err, "second")
+	}
+
+	// Put the data of a reader
+	if err = s.PutReader(otherKey, bytes.NewReader([]byte("streamed")), 8, "image/jpeg"); err != nil {
+		t.Fatalf("PutReader = %v, want nil", err)
+	}
+
+	data, err = s.Get(otherKey)
+
+	if err != nil || !bytes.Equal(data, []byte("streamed")) {
+		t.Fatalf("Get(streamed) = %q, %v, want %q, nil", data, err, "streamed")
+	}
+
+	// List the keys under the prefix
+	if err = s.Put(otherKey, []byte("other"), "image/jpeg"); err != nil {
+		t.Fatalf("Put(other) = %v, want nil", err)
+	}
+
+	keys, err := s.List(prefix)
+
+	if err != nil || len(keys) != 2 || !slices.Contains(keys, key) || !slices.Contains(keys, otherKey) {
+		t.Fatalf("List = %v, %v, want [%s %s], nil", keys, err, key, otherKey)
+	}
+
+	// Check the public URL
+	if url := s.URL(key); url != publicURL+"/"+key {
+		t.Fatalf("URL = %q, want %q", url, publicURL+"/"+key)
+	}
+
+	// Delete the data
+	if err = s.Delete(key); err != nil {
+		t.Fatalf("Delete = %v, want nil", err)
+	}
+
+	exists, err = s.Exists(key)
+
+	if err != nil || exists {
+		t.Fatalf("Exists(deleted) = %v, %v, want false, nil", exists, err)
+	}
+
+	if _, err = s.Get(key); err == nil {
+		t.Fatal("Get(deleted) returned no error")
+	}
+}
+
+// TestLocalStorage checks the local storage against the Storage contract.
+func TestLocalStorage(t *testing.T) {
+	testStorageContract(t, NewLocalStorage(t.TempDir(), "static/"), "static")
+}
+
+// TestS3Storage checks the S3 storage against the Storage contract, it runs against
+// an in-process fake S3 server unless STORAGE_TEST_S3_ENDPOINT is set to a real
+// S3 compatible storage. The credentials are read from the S3_ACCESS_KEY and
+// S3_SECRET_KEY environment variables.
+func TestS3Storage(t *testing.T) {
+	// Get the S3 compatible storage endpoint
+	endpoint := utils.GetEnv("STORAGE_TEST_S3_ENDPOINT", "")
+
+	// Start the fake S3 server if there is no endpoint
+	if utils.IsBlank(endpoint) {
+		endpoint = newFakeS3Server(t)
+	}
+
+	// Get the S3 SSL flag
+	useSSL, err := strconv.ParseBool(utils.GetEnv("S3_USE_SSL", "false"))
+
+	if err != nil {
+		t.Fatal("Invalid S3 use SSL flag")
+	}
+
+	// Create the S3 storage
+	s, err := NewS3Storage(config.Storage{
+		PublicURL:   "http://assets.test/",
+		S3Endpoint:  endpoint,
+		S3Region:    utils.GetEnv("S3_REGION", "us-east-1"),
+		S3Bucket:    utils.GetEnv("S3_BUCKET", "storage-test"),
+		S3AccessKey: utils.GetEnv("S3_ACCESS_KEY", "storage-test"),
+		S3SecretKey: utils.GetEnv("S3_SECRET_KEY", "storage-test-secret"),
+		S3UseSSL:    useSSL,
+	})
+
+	if err != nil {
+		t.Fatalf("NewS3Storage = %v, want nil", err)
+	}
+
+	testStorageContract(t, s, "http://assets.test")
+}
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"log"
+	"main/core/types"
+	"main/data/models"
+	"main/internal/providers/mysql"
+)
+
+// AdminAuditLogRepository is a struct that defines the admin audit log repository.
+type AdminAuditLogRepository struct{}
+
+// NewAdminAuditLogRepository is a factory function that returns a new instance of the admin audit log repository.
+//
+// Returns a new instance of the admin audit log repository.
+func NewAdminAuditLogRepository() *AdminAuditLogRepository {
+	return &AdminAuditLogRepository{}
+}
+
+// Create is a function that records a new admin audit log.
+//
+// auditLog: The admin audit log object.
+//
+// Returns an error if any.
+func (*AdminAuditLogRepository) Create(auditLog *models.AdminAuditLog) error {
+	// Create a new admin audit log
+	err := mysql.Conn.Create(auditLog).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating admin audit log: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Get is a function that returns a page of the admin audit logs, the logs are
+// filtered by the admin when the admin ID is given.
+//
+// adminID: The admin ID, nil for every admin.
+// page: The page of the admin audit logs.
+//
+// Returns the admin audit logs, the next cursor or nil on the last page, and an error if any.
+func (*AdminAuditLogRepository) Get(adminID *uint, page *types.Page) (*[]models.AdminAuditLog, *types.Cursor, error) {
+	// Create a new admin audit logs slice
+	var auditLogs []models.AdminAuditLog
+
+	// Create the admin audit logs query
+	query := mysql.Conn.Model(&models.AdminAuditLog{})
+
+	// Filter the admin if any
+	if adminID != nil {
+		query = query.Where("admin_id = ?", *adminID)
+	}
+
+	// Get the admin audit logs
+	err := query.Scopes(paginate(page, "admin_audit_logs.id")).Find(&auditLogs).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting admin audit logs: " + err.Error())
+
+		return nil, nil, err
+	}
+
+	// Get the next cursor
+	next := nextCursor(&auditLogs, page, func(auditLog *models.AdminAuditLog) uint { return auditLog.ID })
+
+	return &auditLogs, next, nil
+}
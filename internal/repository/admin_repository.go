@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+)
+
+// AdminRepository is a struct that defines the admin repository.
+type AdminRepository struct{}
+
+// NewAdminRepository is a factory function that returns a new instance of the admin repository.
+//
+// Returns a new instance of the admin repository.
+func NewAdminRepository() *AdminRepository {
+	return &AdminRepository{}
+}
+
+// Create is a function that creates a new admin.
+//
+// admin: The admin object.
+//
+// Returns an error if any.
+func (*AdminRepository) Create(admin *models.Admin) error {
+	// Create a new admin
+	err := mysql.Conn.Create(admin).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating admin: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetUsingID is a function that returns an admin by ID.
+//
+// adminID: The admin ID.
+//
+// Returns the admin object and an error if any.
+func (*AdminRepository) GetUsingID(adminID uint) (*models.Admin, error) {
+	// Create a new admin object
+	var admin models.Admin
+
+	// Get the admin by ID
+	err := mysql.Conn.First(&admin, "id = ?", adminID).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting admin using id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &admin, nil
+}
+
+// GetUsingUsername is a function that returns an admin by username.
+//
+// username: The admin username.
+//
+// Returns the admin object and an error if any.
+func (*AdminRepository) GetUsingUsername(username string) (*models.Admin, error) {
+	// Create a new admin object
+	var admin models.Admin
+
+	// Get the admin by username
+	err := mysql.Conn.First(&admin, "username = ?", username).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting admin using username: " + err.Error())
+
+		return nil, err
+	}
+
+	return &admin, nil
+}
+
+// IsUsernameTaken is a function that checks if an admin username is taken.
+//
+// username: The admin username.
+//
+// Returns true if the username is taken, false otherwise, and an error if any.
+func (*AdminRepository) IsUsernameTaken(username string) (bool, error) {
+	// Create a new count variable
+	var count int64
+
+	// Count the admins with the username
+	err := mysql.Conn.Model(&models.Admin{}).Where("username = ?", username).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error checking if admin username is taken: " + err.Error())
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -2,8 +2,12 @@ package repository
 
 import (
 	"log"
+	"main/core/enums"
+	"main/core/types"
 	"main/data/models"
 	"main/internal/providers/mysql"
+	"main/pkg/utils"
+	"time"
 )
 
 // AdvertisementRepository is the repository for the advertisement.
@@ -35,23 +39,195 @@ func (*AdvertisementRepository) Create(ad *models.Advertisement) error {
 	return nil
 }
 
-// GetAll is a method to get all advertisements.
+// Get is a method to get a page of the advertisements, newest first.
+//
+// page: The page of the advertisements.
+//
+// Returns the advertisements, the next cursor or nil on the last page, and an error if any.
+func (*AdvertisementRepository) Get(page *types.Page) (*[]models.Advertisement, *types.Cursor, error) {
+	// Create a variable to store advertisements
+	var ads []models.Advertisement
+
+	// Get the advertisements
+	err := mysql.Conn.Preload("Vendor").Preload("CourtType").
+		Scopes(paginate(page, "advertisements.id")).Find(&ads).Error
+
+	// Log the error if any
+	if err != nil {
+		log.Println("Error get advertisements: " + err.Error())
+
+		return nil, nil, err
+	}
+
+	// Get the next cursor
+	next := nextCursor(&ads, page, func(ad *models.Advertisement) uint { return ad.ID })
+
+	return &ads, next, nil
+}
+
+// GetActive is a method to get the advertisements running on the date,
+// the paused advertisements and the advertisements of the unapproved vendors are excluded.
+//
+// courtType: The targeted court type, empty for every court type.
+// date: The date the advertisements are running on.
 //
 // Returns a slice of advertisements and an error if any.
-func (*AdvertisementRepository) GetAll() (*[]models.Advertisement, error) {
+func (*AdvertisementRepository) GetActive(courtType string, date time.Time) (*[]models.Advertisement, error) {
+	// Create a variable to store advertisements
+	var ads []models.Advertisement
+
+	// Format the date
+	formattedDate := date.Format("2006-01-02")
+
+	// Create the active advertisements query
+	query := mysql.Conn.Preload("Vendor").Preload("CourtType").
+		Joins("JOIN vendors ON vendors.id = advertisements.vendor_id").
+		Where("vendors.status = ?", enums.VendorApproved.Label()).
+		Where("advertisements.paused_at IS NULL").
+		Where("(advertisements.start_date IS NULL OR advertisements.start_date <= ?)", formattedDate).
+		Where("(advertisements.end_date IS NULL OR advertisements.end_date >= ?)", formattedDate)
+
+	// Filter the targeted court type if any
+	if !utils.IsBlank(courtType) {
+		query = query.Joins("JOIN court_types ON court_types.id = advertisements.court_type_id").
+			Where("court_types.type = ?", courtType)
+	}
+
+	// Get the active advertisements
+	err := query.Order("advertisements.id").Find(&ads).Error
+
+	// Log the error if any
+	if err != nil {
+		log.Println("Error get active advertisements: " + err.Error())
+
+		return nil, err
+	}
+
+	return &ads, nil
+}
+
+// GetUsingID is a method to get an advertisement by ID.
+//
+// adID: The advertisement ID.
+//
+// Returns the advertisement and an error if any.
+func (*AdvertisementRepository) GetUsingID(adID uint) (*models.Advertisement, error) {
+	// Create a variable to store the advertisement
+	var ad models.Advertisement
+
+	// Get the advertisement
+	err := mysql.Conn.Preload("Vendor").Preload("CourtType").First(&ad, "id = ?", adID).Error
+
+	// Log the error if any
+	if err != nil {
+		log.Println("Error get advertisement using id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &ad, nil
+}
+
+// GetUsingVendorID is a method to get the advertisements of a vendor, newest first.
+//
+// vendorID: The vendor ID.
+//
+// Returns the advertisements and an error if any.
+func (*AdvertisementRepository) GetUsingVendorID(vendorID uint) (*[]models.Advertisement, error) {
 	// Create a variable to store advertisements
 	var ads []models.Advertisement
 
-	// Get all advertisements
-	err := mysql.Conn.Preload("Vendor").Preload("CourtType").Find(&ads).Error
+	// Get the advertisements
+	err := mysql.Conn.Preload("Vendor").Preload("CourtType").
+		Where("vendor_id = ?", vendorID).Order("id desc").Find(&ads).Error
 
 	// Log the error if any
 	if err != nil {
-		log.Println("Error get all advertisements: " + err.Error())
+		log.Println("Error get advertisements using vendor id: " + err.Error())
 
 		return nil, err
 	}
 
-	// Return the advertisements and error if any
-	return &ads, err
+	return &ads, nil
+}
+
+// IsImageUsed is a method to check if an image file is used by any advertisement.
+//
+// fileName: The image file name.
+//
+// Returns a boolean indicates the image is used and an error if any.
+func (*AdvertisementRepository) IsImageUsed(fileName string) (bool, error) {
+	// Create a counter variable
+	var count int64
+
+	// Check if the image is used
+	err := mysql.Conn.Model(&models.Advertisement{}).Where("image = ?", fileName).Limit(1).Count(&count).Error
+
+	// Log the error if any
+	if err != nil {
+		log.Println("Error check if advertisement image is used: " + err.Error())
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// DeleteUsingID is a method to delete an advertisement by ID.
+//
+// adID: The advertisement ID.
+//
+// Returns an error if any.
+func (*AdvertisementRepository) DeleteUsingID(adID uint) error {
+	// Delete the advertisement
+	err := mysql.Conn.Delete(&models.Advertisement{}, "id = ?", adID).Error
+
+	// Log the error if any
+	if err != nil {
+		log.Println("Error delete advertisement using id: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Update is a method to update the advertisement campaign fields.
+//
+// adID: The advertisement ID.
+// fields: The advertisement columns mapped to their new values.
+//
+// Returns an error if any.
+func (*AdvertisementRepository) Update(adID uint, fields map[string]any) error {
+	// Update the advertisement
+	err := mysql.Conn.Model(&models.Advertisement{}).Where("id = ?", adID).Updates(fields).Error
+
+	// Log the error if any
+	if err != nil {
+		log.Println("Error update advertisement: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// UpdatePausedAt is a method to pause or resume an advertisement.
+//
+// adID: The advertisement ID.
+// pausedAt: The time the advertisement is paused, nil to resume the advertisement.
+//
+// Returns an error if any.
+func (*AdvertisementRepository) UpdatePausedAt(adID uint, pausedAt *time.Time) error {
+	// Update the advertisement paused time
+	err := mysql.Conn.Model(&models.Advertisement{}).Where("id = ?", adID).Update("paused_at", pausedAt).Error
+
+	// Log the error if any
+	if err != nil {
+		log.Println("Error update advertisement paused time: " + err.Error())
+
+		return err
+	}
+
+	return nil
 }
@@ -0,0 +1,72 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// AdvertisementStatRepository is a struct that defines the advertisement stat repository.
+type AdvertisementStatRepository struct{}
+
+// NewAdvertisementStatRepository is a factory function that returns a new instance of the advertisement stat repository.
+//
+// Returns a new instance of the advertisement stat repository.
+func NewAdvertisementStatRepository() *AdvertisementStatRepository {
+	return &AdvertisementStatRepository{}
+}
+
+// GetUsingAdvertisementIDsDateRange is a function that returns the stats of the
+// advertisements between two dates ordered by the date.
+//
+// adIDs: The advertisement IDs.
+// from: The first date, formatted as YYYY-MM-DD.
+// to: The last date, formatted as YYYY-MM-DD.
+//
+// Returns the advertisement stats and an error if any.
+func (*AdvertisementStatRepository) GetUsingAdvertisementIDsDateRange(adIDs []uint, from string, to string) (*[]models.AdvertisementStat, error) {
+	// Create advertisement stats array
+	var stats []models.AdvertisementStat
+
+	// Get the advertisement stats
+	err := mysql.Conn.Where("advertisement_id IN ?", adIDs).Where("date BETWEEN ? AND ?", from, to).Order("date asc").Find(&stats).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting advertisement stats using advertisement ids and date range: " + err.Error())
+
+		return nil, err
+	}
+
+	return &stats, nil
+}
+
+// Increment is a function that adds the impressions and clicks to the stats of the
+// advertisements, the stat is created when the advertisement has no stat on the date.
+//
+// stats: The impressions and clicks to add.
+//
+// Returns an error if any.
+func (*AdvertisementStatRepository) Increment(stats *[]models.AdvertisementStat) error {
+	// Create or add to the advertisement stats
+	err := mysql.Conn.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "advertisement_id"}, {Name: "date"}},
+		DoUpdates: clause.Assignments(map[string]any{
+			"impressions": gorm.Expr("impressions + VALUES(impressions)"),
+			"clicks":      gorm.Expr("clicks + VALUES(clicks)"),
+			"updated_at":  gorm.Expr("VALUES(updated_at)"),
+		}),
+	}).Create(stats).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error incrementing advertisement stats: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -54,7 +54,7 @@ func (*BlacklistedTokenRepository) IsBlacklisted(token string) (bool, error) {
 // Returns an error if the operation was not successful
 func (*BlacklistedTokenRepository) Clear() error {
 	// Delete all the expired tokens
-	err := mysql.Conn.Delete(&models.BlacklistedToken{}, "expires_at > ?", time.Now()).Error
+	err := mysql.Conn.Delete(&models.BlacklistedToken{}, "expires_at < ?", time.Now()).Error
 
 	// Return an error if any
 	if err != nil {
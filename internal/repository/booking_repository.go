@@ -3,8 +3,10 @@ package repository
 import (
 	"log"
 	"main/core/enums"
+	"main/core/types"
 	"main/data/models"
 	"main/internal/providers/mysql"
+	"time"
 
 	"gorm.io/gorm"
 )
@@ -39,32 +41,91 @@ func (*BookingRepository) Create(tx *gorm.DB, booking *models.Booking) error {
 	return nil
 }
 
-// CheckUserHasBookCourt is a method that checks if the user has booked the court.
+// completedBookings is a helper function that returns the scope to get the completed bookings,
+// the bookings of the paid orders that have ended. A booking ending at midnight ends on the next day.
 //
+// now: The current time.
+//
+// Returns the scope.
+func completedBookings(now time.Time) func(db *gorm.DB) *gorm.DB {
+	// Get the current date and time
+	date, clock := now.Format("2006-01-02"), now.Format("15:04:05")
+
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Joins("JOIN orders ON orders.id = bookings.order_id").
+			Where("orders.status = ?", enums.Success.Label()).
+			Where("(bookings.date < ? OR (bookings.date = ? AND bookings.book_end_time > bookings.book_start_time AND bookings.book_end_time <= ?))", date, date, clock)
+	}
+}
+
+// GetCompletedUsingIDUserID is a method that returns the completed booking of the user using the booking ID.
+//
+// id: The ID of the booking.
 // userID: The ID of the user.
-// vendorID: The ID of the vendor.
-// courtType: The type of the court.
 //
-// Returns true if the user has booked the court and an error if any.
-func (*BookingRepository) CheckUserHasBookCourt(userID uint, vendorID uint, courtType string) (bool, error) {
-	// count is a placeholder for the count
-	var count int64
+// Returns the booking and an error if any.
+func (*BookingRepository) GetCompletedUsingIDUserID(id uint, userID uint) (*models.Booking, error) {
+	// booking is a placeholder for the booking
+	var booking models.Booking
+
+	// Get the booking from the database
+	err :=
+		mysql.Conn.Preload("CourtType").Scopes(completedBookings(time.Now())).
+			Where("bookings.id = ? AND bookings.user_id = ?", id, userID).
+			First(&booking).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting completed booking using id and user id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &booking, nil
+}
+
+// GetAwaitingReviewUsingUserID is a method that returns the completed bookings of the user
+// waiting for a review, the latest booking of each vendor court type the user hasn't reviewed yet.
+//
+// userID: The ID of the user.
+// page: The page of the bookings.
+//
+// Returns the bookings, the next cursor or nil on the last page, and an error if any.
+func (*BookingRepository) GetAwaitingReviewUsingUserID(userID uint, page *types.Page) (*[]models.Booking, *types.Cursor, error) {
+	// bookings is a placeholder for the bookings
+	var bookings []models.Booking
+
+	// Query to get the latest completed booking of the user for each vendor court type
+	latestQuery :=
+		mysql.Conn.Model(&models.Booking{}).Select("MAX(bookings.id)").
+			Scopes(completedBookings(time.Now())).
+			Where("bookings.user_id = ?", userID).
+			Group("bookings.vendor_id, bookings.court_type_id")
+
+	// Query to get the reviews of the user for the court of the booking
+	reviewsQuery :=
+		mysql.Conn.Model(&models.Review{}).Select("1").
+			Where("reviews.user_id = bookings.user_id AND reviews.vendor_id = bookings.vendor_id AND reviews.court_type_id = bookings.court_type_id")
 
 	// Get the bookings from the database
 	err :=
-		mysql.Conn.Model(&models.Booking{}).Joins("JOIN courts ON courts.id = bookings.court_id").
-			Joins("JOIN court_types ON court_types.id = courts.court_type_id").
-			Where("user_id = ? AND bookings.vendor_id = ? AND court_types.type = ?", userID, vendorID, courtType).
-			Count(&count).Error
+		mysql.Conn.Joins("Court").Preload("Court.Vendor").Preload("CourtType").
+			Where("bookings.id IN (?)", latestQuery).
+			Where("NOT EXISTS (?)", reviewsQuery).
+			Scopes(paginate(page, "bookings.id")).
+			Find(&bookings).Error
 
 	// Return an error if any
 	if err != nil {
-		log.Println("Error checking if user has booked the court: " + err.Error())
+		log.Println("Error getting bookings awaiting review using user id: " + err.Error())
 
-		return false, err
+		return nil, nil, err
 	}
 
-	return count > 0, nil
+	// Get the next cursor
+	next := nextCursor(&bookings, page, func(booking *models.Booking) uint { return booking.ID })
+
+	return &bookings, next, nil
 }
 
 // CheckAvailability is a method that checks if the court is available.
@@ -93,6 +154,7 @@ func (*BookingRepository) CheckAvailability(courtID uint, bookDate string, bookS
 }
 
 // GetusingVendorIDCourtTypeDate is a method to get bookings using vendor id, court type, and date.
+// Bookings of courts linked to the court type are included whatever court type they were booked as.
 //
 // vendorID: the id of the vendor
 // courtType: the type of the court
@@ -104,7 +166,7 @@ func (*BookingRepository) GetUsingVendorIDCourtTypeDate(vendorID uint, courtType
 	var bookings []models.Booking
 
 	err :=
-		mysql.Conn.Joins("Court").Preload("Court.Vendor").Joins("JOIN orders ON orders.id = bookings.order_id").Where("orders.status = ?", enums.Success.Label()).Where("bookings.vendor_id = ?", vendorID).Where("Court.court_type_id = ?", enums.GetCourtTypeID(courtType)).Where("date = ?", date).Find(&bookings).Error
+		mysql.Conn.Joins("Court").Preload("Court.Vendor").Joins("JOIN orders ON orders.id = bookings.order_id").Where("orders.status = ?", enums.Success.Label()).Where("bookings.vendor_id = ?", vendorID).Joins("JOIN court_type_links ON court_type_links.court_id = bookings.court_id").Joins("JOIN court_types ON court_types.id = court_type_links.court_type_id").Where("court_types.type = ?", courtType).Where("date = ?", date).Find(&bookings).Error
 
 	// Return an error if any
 	if err != nil {
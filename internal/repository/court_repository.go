@@ -1,11 +1,17 @@
 package repository
 
 import (
+	"fmt"
 	"log"
+	"main/core/constants"
 	"main/core/enums"
 	"main/core/types"
 	"main/data/models"
 	"main/internal/providers/mysql"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // CourtRepository is a struct that defines the court repository.
@@ -36,6 +42,47 @@ func (*CourtRepository) Create(court *models.Court) error {
 	return nil
 }
 
+// courtsFromLinks is a helper function that converts the court type links into courts.
+// The court type of each court is set to the linked court type, so the court
+// is shown as the court type it was listed in.
+//
+// links: The court type links.
+//
+// Returns the courts.
+func courtsFromLinks(links *[]models.CourtTypeLink) *[]models.Court {
+	// Create courts array
+	courts := make([]models.Court, len(*links))
+
+	// Loop through the links
+	for i, link := range *links {
+		courts[i] = link.Court
+		courts[i].CourtType = link.CourtType
+	}
+
+	return &courts
+}
+
+// withinBoundingBox is a helper function that returns the scope to filter the court type links
+// of the vendors located in the bounding box, a nil bounding box doesn't filter.
+//
+// box: The bounding box.
+//
+// Returns the scope.
+func withinBoundingBox(box *types.BoundingBox) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		// Return the query if there is no bounding box
+		if box == nil {
+			return db
+		}
+
+		// Subquery to get the vendors located in the bounding box
+		subQuery :=
+			mysql.Conn.Model(&models.Vendor{}).Select("id").Where("latitude BETWEEN ? AND ?", box.MinLatitude, box.MaxLatitude).Where("longitude BETWEEN ? AND ?", box.MinLongitude, box.MaxLongitude)
+
+		return db.Where("courts.vendor_id IN (?)", subQuery)
+	}
+}
+
 // GetNewestUsingVendorIDCourtType is a function that returns the newest court by vendor ID and court type.
 //
 // vendorID: The vendor ID.
@@ -43,12 +90,12 @@ func (*CourtRepository) Create(court *models.Court) error {
 //
 // Returns the court and an error if any.
 func (*CourtRepository) GetNewestUsingVendorIDCourtType(vendorID uint, courtType string) (*models.Court, error) {
-	// Create a new court object
-	var court models.Court
+	// Create a new court type link object
+	var link models.CourtTypeLink
 
-	// Get the courts by vendor ID and court type
+	// Get the newest court linked to the court type by vendor ID
 	err :=
-		mysql.Conn.Preload("Vendor").Joins("CourtType").Where("vendor_id = ?", vendorID).Where("CourtType.type = ?", courtType).Order("created_at desc").First(&court).Error
+		mysql.Conn.Preload("Court.Vendor").Joins("CourtType").Joins("JOIN courts ON courts.id = court_type_links.court_id").Where("courts.vendor_id = ?", vendorID).Where("CourtType.type = ?", courtType).Order("courts.created_at desc").First(&link).Error
 
 	// Return an error if any
 	if err != nil {
@@ -57,114 +104,237 @@ func (*CourtRepository) GetNewestUsingVendorIDCourtType(vendorID uint, courtType
 		return nil, err
 	}
 
+	// Set the court type to the linked court type
+	court := link.Court
+	court.CourtType = link.CourtType
+
 	return &court, nil
 }
 
-// Get is a function that returns all the courts.
+// courtSortColumns is the catalogue columns sorted by each of the sort orders.
+var courtSortColumns = map[string]string{
+	"rating":   "total_rating",
+	"reviews":  "total_reviews",
+	"price":    "price",
+	"newest":   "created_at",
+	"distance": "distance",
+}
+
+// distanceExpression is a helper function that returns the SQL expression of the
+// great-circle distance in kilometers between the vendors and the location, using the haversine formula.
 //
-// Returns the courts and an error if any.
-func (*CourtRepository) Get() (*[]models.Court, error) {
-	// Create courts array
-	var courts []models.Court
+// latitude: The latitude of the location.
+// longitude: The longitude of the location.
+//
+// Returns the distance expression.
+func distanceExpression(latitude float64, longitude float64) clause.Expr {
+	return gorm.Expr(
+		"? * 2 * ASIN(LEAST(1, SQRT(POWER(SIN(RADIANS(vendors.latitude - ?) / 2), 2) + COS(RADIANS(?)) * COS(RADIANS(vendors.latitude)) * POWER(SIN(RADIANS(vendors.longitude - ?) / 2), 2))))",
+		constants.EARTH_RADIUS_KM, latitude, latitude, longitude,
+	)
+}
 
-	// Subquery to get the minimum id for each vendor id and court type id
-	subQuery :=
-		mysql.Conn.Model(&models.Court{}).Select("MIN(id)").Group("vendor_id, court_type_id")
+// GetCatalogue is a function that returns a page of the courts catalogue, the first court
+// of each vendor and court type is listed with its rating, review count, distance and the
+// price range of the courts of the vendor and court type within the price filters.
+// Every filter and the sort order are applied in the database.
+//
+// filter: The courts filter.
+//
+// Returns the court maps, the next cursor or nil on the last page, and an error if any.
+func (*CourtRepository) GetCatalogue(filter *types.CourtsFilter) (*[]types.CourtMap, *types.Cursor, error) {
+	// Create the catalogue rows array
+	var rows []struct {
+		ID           uint
+		Price        float64
+		MaxPrice     float64
+		CreatedAt    time.Time
+		TotalRating  float64
+		TotalReviews int64
+		Distance     *float64
+	}
 
-	// Get the courts
-	err := mysql.Conn.Preload("Vendor").Preload("CourtType").
-		Where("id IN (?)", subQuery).Find(&courts).Error
+	// Subquery to get the first court type link and the price range of the courts for each vendor id and court type id
+	groupsSubQuery :=
+		mysql.Conn.Model(&models.CourtTypeLink{}).Select("MIN(court_type_links.id) AS link_id, MIN(courts.price) AS min_price, MAX(courts.price) AS max_price").Joins("JOIN courts ON courts.id = court_type_links.court_id").Group("courts.vendor_id, court_type_links.court_type_id")
 
-	// Return an error if any
-	if err != nil {
-		log.Println("Error getting all courts: " + err.Error())
+	// Filter the minimum price, only the courts within the price range are grouped
+	if filter.MinPrice != nil {
+		groupsSubQuery = groupsSubQuery.Where("courts.price >= ?", *filter.MinPrice)
+	}
 
-		return nil, err
+	// Filter the maximum price
+	if filter.MaxPrice != nil {
+		groupsSubQuery = groupsSubQuery.Where("courts.price <= ?", *filter.MaxPrice)
 	}
 
-	return &courts, nil
-}
+	// Get the distance expression, null if the courts are not searched near a location
+	distance := gorm.Expr("NULL")
 
-// GetUsingVendorName is a function that returns all the courts by vendor name.
-//
-// vendorName: The vendor name.
-//
-// Returns the courts and an error if any.
-func (*CourtRepository) GetUsingVendorName(vendorName string) (*[]models.Court, error) {
-	// Create courts array
-	var courts []models.Court
+	if filter.Latitude != nil && filter.Longitude != nil {
+		distance = distanceExpression(*filter.Latitude, *filter.Longitude)
+	}
 
-	// Subquery to get the minimum id for each vendor id and court type id
-	subQuery :=
-		mysql.Conn.Model(&models.Court{}).Select("MIN(courts.id)").Joins("JOIN vendors ON vendors.id = courts.vendor_id").Where("vendors.name LIKE ?", "%"+vendorName+"%").Group("vendor_id, court_type_id")
+	// Query to get the catalogue columns of the filtered courts
+	catalogueQuery := mysql.Conn.Model(&models.CourtTypeLink{}).
+		Select("court_type_links.id, court_groups.min_price AS price, court_groups.max_price, courts.created_at, COALESCE(review_stats.rating_sum / NULLIF(review_stats.review_count, 0), 0) AS total_rating, COALESCE(review_stats.review_count, 0) AS total_reviews, ? AS distance", distance).
+		Joins("JOIN (?) AS court_groups ON court_groups.link_id = court_type_links.id", groupsSubQuery).
+		Joins("JOIN courts ON courts.id = court_type_links.court_id").
+		Joins("JOIN vendors ON vendors.id = courts.vendor_id").
+		Joins("JOIN court_types ON court_types.id = court_type_links.court_type_id").
+		Joins("LEFT JOIN review_summaries AS review_stats ON review_stats.vendor_id = courts.vendor_id AND review_stats.court_type_id = court_type_links.court_type_id").
+		Where("vendors.status = ?", enums.VendorApproved.Label()).
+		Scopes(withinBoundingBox(filter.Box))
+
+	// Filter the court types
+	if len(filter.CourtTypes) > 0 {
+		catalogueQuery = catalogueQuery.Where("court_types.type IN ?", filter.CourtTypes)
+	}
 
-	// Get the courts
-	err := mysql.Conn.Preload("Vendor").Preload("CourtType").
-		Where("id IN (?)", subQuery).Find(&courts).Error
+	// Filter the vendor name
+	if filter.Search != "" {
+		catalogueQuery = catalogueQuery.Where("vendors.name LIKE ?", "%"+filter.Search+"%")
+	}
 
-	// Return an error if any
-	if err != nil {
-		log.Println("Error getting all courts using vendor name: " + err.Error())
+	// Filter the vendors open at the time, the special day of the date takes precedence
+	// over the opening hour of the day of the week and the vendor open and close time
+	if filter.OpenAt != "" {
+		catalogueQuery = catalogueQuery.
+			Joins("LEFT JOIN special_days ON special_days.vendor_id = vendors.id AND special_days.date = ?", filter.OpenOn.Format("2006-01-02")).
+			Joins("LEFT JOIN opening_hours ON opening_hours.vendor_id = vendors.id AND opening_hours.weekday = ?", int(filter.OpenOn.Weekday())).
+			Where("NOT COALESCE(special_days.is_closed, opening_hours.is_closed, FALSE)").
+			Where("COALESCE(special_days.open_time, opening_hours.open_time, vendors.open_time) <= CAST(? AS TIME)", filter.OpenAt).
+			Where("COALESCE(special_days.close_time, opening_hours.close_time, vendors.close_time) > CAST(? AS TIME)", filter.OpenAt)
+	}
 
-		return nil, err
+	// Query to get the page of the catalogue
+	query := mysql.Conn.Table("(?) AS catalogue", catalogueQuery)
+
+	// Filter the minimum rating
+	if filter.MinRating != nil {
+		query = query.Where("total_rating >= ?", *filter.MinRating)
 	}
 
-	return &courts, nil
-}
+	// Filter the exact distance within the search radius
+	if filter.Latitude != nil && filter.Longitude != nil {
+		query = query.Where("distance <= ?", filter.RadiusKm)
+	}
 
-// GetUsingCourtType is a function that returns all the courts by court type.
-//
-// courtType: The court type.
-//
-// Returns the courts and an error if any.
-func (*CourtRepository) GetUsingCourtType(courtType string) (*[]models.Court, error) {
-	// Create courts array
-	var courts []models.Court
+	// Get the sorted column and the sort direction
+	column := courtSortColumns[filter.Sort]
+
+	direction, operator := "ASC", ">"
+
+	if filter.Descending {
+		direction, operator = "DESC", "<"
+	}
 
-	// Subquery to get the minimum id for each vendor id and court type id
-	subQuery :=
-		mysql.Conn.Model(&models.Court{}).Select("MIN(courts.id)").Joins("JOIN court_types ON court_types.id = courts.court_type_id").Where("court_types.type = ?", courtType).Group("vendor_id, court_type_id")
+	// Start the page after the cursor
+	if filter.Cursor != nil {
+		query = query.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND id > ?))", column, operator, column), filter.Cursor.Value, filter.Cursor.Value, filter.Cursor.ID)
+	}
 
-	// Get the courts
-	err := mysql.Conn.Preload("Vendor").Preload("CourtType").
-		Where("id IN (?)", subQuery).Find(&courts).Error
+	// Get the catalogue rows, one more row is taken to know whether there is a next page
+	err := query.Order(fmt.Sprintf("%s %s", column, direction)).Order("id ASC").Limit(filter.Limit + 1).Scan(&rows).Error
 
 	// Return an error if any
 	if err != nil {
-		log.Println("Error getting courts using court type: " + err.Error())
+		log.Println("Error getting courts catalogue: " + err.Error())
 
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &courts, nil
-}
+	// Create the next cursor
+	var next *types.Cursor
+
+	// Check if there is a next page
+	if len(rows) > filter.Limit {
+		// Remove the extra row
+		rows = rows[:filter.Limit]
+
+		// Get the last row
+		last := rows[len(rows)-1]
+
+		// Get the sorted value of the last row
+		values := map[string]any{
+			"rating":   last.TotalRating,
+			"reviews":  last.TotalReviews,
+			"price":    last.Price,
+			"newest":   last.CreatedAt,
+			"distance": last.Distance,
+		}
+
+		next = &types.Cursor{
+			Value: values[filter.Sort],
+			ID:    last.ID,
+		}
+	}
 
-// GetUsingCourtTypeVendorName is a function that returns all the courts by court type and vendor name.
-//
-// courtType: The court type.
-// vendorName: The vendor name.
-//
-// Returns the courts and an error if any.
-func (*CourtRepository) GetUsingCourtTypeVendorName(courtType string, vendorName string) (*[]models.Court, error) {
-	// Create courts array
-	var courts []models.Court
+	// Create the court type link ids slice
+	ids := make([]uint, len(rows))
+
+	// Loop through the rows
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
 
-	// Subquery to get the minimum id for each vendor id and court type id
-	subQuery :=
-		mysql.Conn.Model(&models.Court{}).Select("MIN(courts.id)").Joins("JOIN vendors ON vendors.id = courts.vendor_id").Joins("JOIN court_types ON court_types.id = courts.court_type_id").Where("vendors.name LIKE ?", "%"+vendorName+"%").Where("court_types.type = ?", courtType).Group("vendor_id, court_type_id")
+	// Create court type links array
+	var links []models.CourtTypeLink
 
-	// Get the courts
-	err := mysql.Conn.Preload("Vendor").Preload("CourtType").
-		Where("id IN (?)", subQuery).Find(&courts).Error
+	// Get the courts of the page
+	err = mysql.Conn.Preload("Court.Vendor").Preload("Court.CourtTypeLinks.CourtType").Preload("Court.GalleryImages", orderedGalleryImages).Preload("Court.Vendor.GalleryImages", venueGalleryImages).Preload("CourtType").
+		Where("id IN ?", ids).Find(&links).Error
 
 	// Return an error if any
 	if err != nil {
-		log.Println("Error getting all courts using vendor name and court type: " + err.Error())
+		log.Println("Error getting courts catalogue: " + err.Error())
 
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &courts, nil
+	// Create the links map keyed by the court type link id
+	linksMap := make(map[uint]models.CourtTypeLink, len(links))
+
+	// Loop through the links
+	for _, link := range links {
+		linksMap[link.ID] = link
+	}
+
+	// Create a new court maps slice
+	courtMaps := make([]types.CourtMap, 0, len(rows))
+
+	// Loop through the rows in the sorted order
+	for _, row := range rows {
+		// Get the court type link
+		link, ok := linksMap[row.ID]
+
+		// Skip the link if it was removed in between the queries
+		if !ok {
+			continue
+		}
+
+		// Set the court type to the linked court type
+		court := link.Court
+		court.CourtType = link.CourtType
+
+		// Create the court map
+		courtMap := types.CourtMap{
+			"court":         court,
+			"price":         row.Price,
+			"max_price":     row.MaxPrice,
+			"total_rating":  row.TotalRating,
+			"total_reviews": row.TotalReviews,
+		}
+
+		// Set the distance if the courts are searched near a location
+		if row.Distance != nil {
+			courtMap["distance"] = *row.Distance
+		}
+
+		courtMaps = append(courtMaps, courtMap)
+	}
+
+	return &courtMaps, next, nil
 }
 
 // GetUsingID is a function that returns the courts by ID.
@@ -190,6 +360,30 @@ func (*CourtRepository) GetUsingID(courtID uint) (*models.Court, error) {
 	return &court, nil
 }
 
+// GetUsingIDsVendorID is a function that returns the courts by IDs and vendor ID.
+//
+// courtIDs: The court IDs.
+// vendorID: The vendor ID.
+//
+// Returns the courts and an error if any.
+func (*CourtRepository) GetUsingIDsVendorID(courtIDs []uint, vendorID uint) (*[]models.Court, error) {
+	// Create courts array
+	var courts []models.Court
+
+	// Get the courts by IDs and vendor ID
+	err :=
+		mysql.Conn.Preload("CourtType").Where("id IN (?)", courtIDs).Where("vendor_id = ?", vendorID).Find(&courts).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting courts using ids and vendor id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &courts, nil
+}
+
 // GetUsingVendorIDCourtType is a function that returns the courts by vendor ID and court type.
 //
 // vendorID: The vendor ID.
@@ -197,12 +391,12 @@ func (*CourtRepository) GetUsingID(courtID uint) (*models.Court, error) {
 //
 // Returns the vendor courts and an error if any.
 func (*CourtRepository) GetUsingVendorIDCourtType(vendorID uint, courtType string) (*[]models.Court, error) {
-	// Create a new court object
-	var courts []models.Court
+	// Create court type links array
+	var links []models.CourtTypeLink
 
-	// Get the courts by vendor ID and court type
+	// Get the courts linked to the court type by vendor ID
 	err :=
-		mysql.Conn.Preload("Vendor").Joins("CourtType").Where("vendor_id = ?", vendorID).Where("CourtType.type = ?", courtType).Find(&courts).Error
+		mysql.Conn.Preload("Court.Vendor").Preload("Court.CourtTypeLinks.CourtType").Preload("Court.GalleryImages", orderedGalleryImages).Preload("Court.Vendor.GalleryImages", venueGalleryImages).Joins("CourtType").Joins("JOIN courts ON courts.id = court_type_links.court_id").Where("courts.vendor_id = ?", vendorID).Where("CourtType.type = ?", courtType).Order("court_type_links.court_id asc").Find(&links).Error
 
 	// Return an error if any
 	if err != nil {
@@ -211,7 +405,7 @@ func (*CourtRepository) GetUsingVendorIDCourtType(vendorID uint, courtType strin
 		return nil, err
 	}
 
-	return &courts, nil
+	return courtsFromLinks(&links), nil
 }
 
 // CheckExistsUsingVendorIDCourtType is a function that checks if the courts exist by vendor ID and court type.
@@ -224,9 +418,9 @@ func (*CourtRepository) CheckExistUsingVendorIDCourtType(vendorID uint, courtTyp
 	// count is the number of courts
 	var count int64
 
-	// Get the courts by vendor ID and court type
+	// Get the courts linked to the court type by vendor ID
 	err :=
-		mysql.Conn.Model(&models.Court{}).Joins("CourtType").Where("vendor_id = ?", vendorID).Where("CourtType.type = ?", courtType).Limit(1).Count(&count).Error
+		mysql.Conn.Model(&models.CourtTypeLink{}).Joins("CourtType").Joins("JOIN courts ON courts.id = court_type_links.court_id").Where("courts.vendor_id = ?", vendorID).Where("CourtType.type = ?", courtType).Limit(1).Count(&count).Error
 
 	// Return an error if any
 	if err != nil {
@@ -238,30 +432,51 @@ func (*CourtRepository) CheckExistUsingVendorIDCourtType(vendorID uint, courtTyp
 	return count > 0, nil
 }
 
+// CheckLinkedUsingIDCourtType is a function that checks if the court is linked to the court type.
+//
+// courtID: The court ID.
+// courtType: The court type.
+//
+// Returns a boolean and an error if any.
+func (*CourtRepository) CheckLinkedUsingIDCourtType(courtID uint, courtType string) (bool, error) {
+	// count is the number of court type links
+	var count int64
+
+	// Get the court type links by court ID and court type
+	err :=
+		mysql.Conn.Model(&models.CourtTypeLink{}).Joins("CourtType").Where("court_type_links.court_id = ?", courtID).Where("CourtType.type = ?", courtType).Limit(1).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error checking court linked using id and court type: " + err.Error())
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
 // GetCountsUsingVendorID is a function that returns the counts of the courts by vendor ID.
+// A court linked to several court types is counted in each of them.
 //
 // vendorID: The vendor ID.
 //
 // Returns the counts of the courts map and an error if any.
 func (*CourtRepository) GetCountsUsingVendorID(vendorID uint) (*types.CourtCountsMap, error) {
-	// Create a new struct for the results
-	var results struct {
-		FootballCount   int64
-		BasketballCount int64
-		VolleyballCount int64
-		TennisCount     int64
-		BadmintonCount  int64
-	}
-
-	// Get the counts of the courts by vendor ID
-	err := mysql.Conn.Model(&models.Court{}).Select(`
-        COUNT(CASE WHEN court_type_id = ? THEN 1 END) AS football_count,
-        COUNT(CASE WHEN court_type_id = ? THEN 1 END) AS basketball_count,
-        COUNT(CASE WHEN court_type_id = ? THEN 1 END) AS volleyball_count,
-        COUNT(CASE WHEN court_type_id = ? THEN 1 END) AS tennis_count,
-        COUNT(CASE WHEN court_type_id = ? THEN 1 END) AS badminton_count
-    `, enums.GetCourtTypeID(enums.Football.Label()), enums.GetCourtTypeID(enums.Basketball.Label()), enums.GetCourtTypeID(enums.Volleyball.Label()), enums.GetCourtTypeID(enums.Tennis.Label()), enums.GetCourtTypeID(enums.Badminton.Label())).
-		Where("vendor_id = ?", vendorID).Scan(&results).Error
+	// Create a new slice for the results
+	var results []struct {
+		Type  string
+		Count int64
+	}
+
+	// Get the counts of the courts by vendor ID for each court type,
+	// retired court types are only included when the vendor still has courts in it
+	err := mysql.Conn.Model(&models.CourtType{}).Select("court_types.type, COUNT(courts.id) AS count").
+		Joins("LEFT JOIN court_type_links ON court_type_links.court_type_id = court_types.id").
+		Joins("LEFT JOIN courts ON courts.id = court_type_links.court_id AND courts.vendor_id = ?", vendorID).
+		Group("court_types.id, court_types.type").
+		Having("COUNT(courts.id) > 0 OR MAX(court_types.retired_at) IS NULL").
+		Scan(&results).Error
 
 	// Return an error if any
 	if err != nil {
@@ -270,16 +485,93 @@ func (*CourtRepository) GetCountsUsingVendorID(vendorID uint) (*types.CourtCount
 		return nil, err
 	}
 
-	return &types.CourtCountsMap{
-		enums.Football.Label():   results.FootballCount,
-		enums.Basketball.Label(): results.BasketballCount,
-		enums.Volleyball.Label(): results.VolleyballCount,
-		enums.Tennis.Label():     results.TennisCount,
-		enums.Badminton.Label():  results.BadmintonCount,
-	}, nil
+	// Create a new court counts map
+	courtCounts := make(types.CourtCountsMap)
+
+	// Loop through the results
+	for _, result := range results {
+		courtCounts[result.Type] = result.Count
+	}
+
+	return &courtCounts, nil
+}
+
+// GetCourtTypeSummariesUsingVendorID is a function that returns the court count and the starting
+// price of each court type offered by the vendor. A court linked to several court types is counted in each of them.
+//
+// vendorID: The vendor ID.
+//
+// Returns the court type summaries and an error if any.
+func (*CourtRepository) GetCourtTypeSummariesUsingVendorID(vendorID uint) (*[]types.CourtTypeSummary, error) {
+	// Create court type summaries array
+	var summaries []types.CourtTypeSummary
+
+	// Get the court count and the lowest price of the courts by vendor ID for each court type
+	err := mysql.Conn.Model(&models.CourtTypeLink{}).Select("court_types.type, COUNT(courts.id) AS court_count, MIN(courts.price) AS starting_price").
+		Joins("JOIN courts ON courts.id = court_type_links.court_id").
+		Joins("JOIN court_types ON court_types.id = court_type_links.court_type_id").
+		Where("courts.vendor_id = ?", vendorID).
+		Group("court_types.id, court_types.type").
+		Order("court_types.type ASC").
+		Scan(&summaries).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting court type summaries using vendor id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &summaries, nil
+}
+
+// GetTotalCountUsingVendorID is a function that returns the count of the physical courts by vendor ID.
+//
+// vendorID: The vendor ID.
+//
+// Returns the count of the courts and an error if any.
+func (*CourtRepository) GetTotalCountUsingVendorID(vendorID uint) (int64, error) {
+	// count is the number of courts
+	var count int64
+
+	// Get the count of the courts by vendor ID
+	err := mysql.Conn.Model(&models.Court{}).Where("vendor_id = ?", vendorID).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting total court count using vendor id: " + err.Error())
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetCountUsingImage is a function that returns the count of the courts
+// using the image file.
+//
+// image: The image file name.
+//
+// Returns the count of the courts and an error if any.
+func (*CourtRepository) GetCountUsingImage(image string) (int64, error) {
+	// count is the number of courts
+	var count int64
+
+	// Get the count of the courts
+	err := mysql.Conn.Model(&models.Court{}).Where("image = ?", image).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting courts count using image: " + err.Error())
+
+		return 0, err
+	}
+
+	return count, nil
 }
 
 // UpdateUsingVendorIDCourtType is a method to update court using the given vendor id and court type.
+// Courts linked to several court types share the same price.
 //
 // vendorID: The vendor id
 // courtType: The court type
@@ -288,7 +580,7 @@ func (*CourtRepository) GetCountsUsingVendorID(vendorID uint) (*types.CourtCount
 // Return error if any
 func (*CourtRepository) UpdateUsingVendorIDCourtType(vendorID uint, courtType string, pricePerHour float64) error {
 	// Update court
-	err := mysql.Conn.Model(models.Court{}).Where("vendor_id = ?", vendorID).Where("court_type_id = ?", enums.GetCourtTypeID(courtType)).Updates(models.Court{
+	err := mysql.Conn.Model(models.Court{}).Where("vendor_id = ?", vendorID).Where("id IN (?)", mysql.Conn.Model(&models.CourtTypeLink{}).Select("court_type_links.court_id").Joins("JOIN court_types ON court_types.id = court_type_links.court_type_id").Where("court_types.type = ?", courtType)).Updates(models.Court{
 		Price: pricePerHour,
 	}).Error
 
@@ -321,3 +613,26 @@ func (*CourtRepository) DeleteUsingCourtIDsVendorID(courtIDs []uint, vendorID ui
 
 	return nil
 }
+
+// GetUsingVendorID is a function that returns every court of the vendor
+// along with its linked court types.
+//
+// vendorID: The vendor ID.
+//
+// Returns the vendor courts and an error if any.
+func (*CourtRepository) GetUsingVendorID(vendorID uint) (*[]models.Court, error) {
+	// Create courts array
+	var courts []models.Court
+
+	// Get the courts by vendor ID
+	err := mysql.Conn.Preload("CourtType").Preload("CourtTypeLinks.CourtType").Preload("GalleryImages", orderedGalleryImages).Where("vendor_id = ?", vendorID).Order("id asc").Find(&courts).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting courts using vendor id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &courts, nil
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+
+	"gorm.io/gorm/clause"
+)
+
+// CourtTypeLinkRepository is a struct that defines the court type link repository.
+type CourtTypeLinkRepository struct{}
+
+// NewCourtTypeLinkRepository is a factory function that returns a new instance of the court type link repository.
+//
+// Returns a new instance of the court type link repository.
+func NewCourtTypeLinkRepository() *CourtTypeLinkRepository {
+	return &CourtTypeLinkRepository{}
+}
+
+// Create is a function that creates the court type links,
+// links that already exist are ignored.
+//
+// links: The court type links.
+//
+// Returns an error if any.
+func (*CourtTypeLinkRepository) Create(links *[]models.CourtTypeLink) error {
+	// Create the court type links
+	err := mysql.Conn.Clauses(clause.Insert{Modifier: "ignore"}).Create(links).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating court type links: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUsingCourtIDsCourtTypeID is a function that deletes the court type links
+// using court IDs and court type ID.
+//
+// courtIDs: The court IDs.
+// courtTypeID: The court type ID.
+//
+// Returns an error if any.
+func (*CourtTypeLinkRepository) DeleteUsingCourtIDsCourtTypeID(courtIDs []uint, courtTypeID uint) error {
+	// Delete the court type links
+	err := mysql.Conn.Where("court_id IN (?)", courtIDs).Where("court_type_id = ?", courtTypeID).Delete(models.CourtTypeLink{}).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error deleting court type links using court ids and court type id: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+	"time"
+)
+
+// CourtTypeRepository is a struct that defines the court type repository.
+type CourtTypeRepository struct{}
+
+// NewCourtTypeRepository is a factory function that returns a new instance of the court type repository.
+//
+// Returns a new instance of the court type repository.
+func NewCourtTypeRepository() *CourtTypeRepository {
+	return &CourtTypeRepository{}
+}
+
+// Create is a function that creates a new court type.
+//
+// courtType: The court type object.
+//
+// Returns an error if any.
+func (*CourtTypeRepository) Create(courtType *models.CourtType) error {
+	// Create the court type
+	err := mysql.Conn.Create(courtType).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating court type: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetAll is a function that returns all the court types, including the retired ones.
+//
+// Returns the court types and an error if any.
+func (*CourtTypeRepository) GetAll() (*[]models.CourtType, error) {
+	// Create court types array
+	var courtTypes []models.CourtType
+
+	// Get the court types
+	err := mysql.Conn.Order("id asc").Find(&courtTypes).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting all court types: " + err.Error())
+
+		return nil, err
+	}
+
+	return &courtTypes, nil
+}
+
+// GetActive is a function that returns all the court types that are not retired.
+//
+// Returns the court types and an error if any.
+func (*CourtTypeRepository) GetActive() (*[]models.CourtType, error) {
+	// Create court types array
+	var courtTypes []models.CourtType
+
+	// Get the court types
+	err := mysql.Conn.Where("retired_at IS NULL").Order("id asc").Find(&courtTypes).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting active court types: " + err.Error())
+
+		return nil, err
+	}
+
+	return &courtTypes, nil
+}
+
+// GetUsingID is a function that returns the court type by ID.
+//
+// courtTypeID: The court type ID.
+//
+// Returns the court type and an error if any.
+func (*CourtTypeRepository) GetUsingID(courtTypeID uint) (*models.CourtType, error) {
+	// Create a new court type object
+	var courtType models.CourtType
+
+	// Get the court type by ID
+	err := mysql.Conn.First(&courtType, "id = ?", courtTypeID).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting court type using id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &courtType, nil
+}
+
+// GetUsingType is a function that returns the court type by its type name.
+//
+// courtType: The court type name.
+//
+// Returns the court type and an error if any.
+func (*CourtTypeRepository) GetUsingType(courtType string) (*models.CourtType, error) {
+	// Create a new court type object
+	var record models.CourtType
+
+	// Get the court type by type name
+	err := mysql.Conn.First(&record, "type = ?", courtType).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting court type using type: " + err.Error())
+
+		return nil, err
+	}
+
+	return &record, nil
+}
+
+// IsTypeExists is a function that checks if the court type exists.
+//
+// courtType: The court type name.
+//
+// Returns a boolean and an error if any.
+func (*CourtTypeRepository) IsTypeExists(courtType string) (bool, error) {
+	// count is the number of court types
+	var count int64
+
+	// Check if the court type exists
+	err := mysql.Conn.Model(&models.CourtType{}).Where("type = ?", courtType).Limit(1).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error checking court type exists: " + err.Error())
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// GetCountUsingIcon is a function that returns the count of the court types
+// using the icon file.
+//
+// icon: The icon file name.
+//
+// Returns the count of the court types and an error if any.
+func (*CourtTypeRepository) GetCountUsingIcon(icon string) (int64, error) {
+	// count is the number of court types
+	var count int64
+
+	// Get the count of the court types
+	err := mysql.Conn.Model(&models.CourtType{}).Where("icon = ?", icon).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting court types count using icon: " + err.Error())
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// UpdateType is a function that renames the court type.
+//
+// courtTypeID: The court type ID.
+// courtType: The new court type name.
+//
+// Returns an error if any.
+func (*CourtTypeRepository) UpdateType(courtTypeID uint, courtType string) error {
+	// Update the court type name
+	err := mysql.Conn.Model(&models.CourtType{}).Where("id = ?", courtTypeID).Update("type", courtType).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error updating court type name: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// UpdateIcon is a function that updates the court type icon.
+//
+// courtTypeID: The court type ID.
+// icon: The icon file name.
+//
+// Returns an error if any.
+func (*CourtTypeRepository) UpdateIcon(courtTypeID uint, icon string) error {
+	// Update the court type icon
+	err := mysql.Conn.Model(&models.CourtType{}).Where("id = ?", courtTypeID).Update("icon", icon).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error updating court type icon: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// UpdateRetiredAt is a function that retires or restores the court type.
+//
+// courtTypeID: The court type ID.
+// retiredAt: The retire time, nil to restore the court type.
+//
+// Returns an error if any.
+func (*CourtTypeRepository) UpdateRetiredAt(courtTypeID uint, retiredAt *time.Time) error {
+	// Update the court type retire time
+	err := mysql.Conn.Model(&models.CourtType{}).Where("id = ?", courtTypeID).Update("retired_at", retiredAt).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error updating court type retired at: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+
+	"gorm.io/gorm"
+)
+
+// GalleryImageRepository is a struct that defines the gallery image repository.
+type GalleryImageRepository struct{}
+
+// NewGalleryImageRepository is a factory function that returns a new instance of the gallery image repository.
+//
+// Returns a new instance of the gallery image repository.
+func NewGalleryImageRepository() *GalleryImageRepository {
+	return &GalleryImageRepository{}
+}
+
+// orderedGalleryImages is a preload scope that orders the gallery images by position.
+//
+// db: The database query.
+//
+// Returns the database query.
+func orderedGalleryImages(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc, id asc")
+}
+
+// venueGalleryImages is a preload scope that only gets the venue gallery images
+// ordered by position.
+//
+// db: The database query.
+//
+// Returns the database query.
+func venueGalleryImages(db *gorm.DB) *gorm.DB {
+	return orderedGalleryImages(db.Where("court_id IS NULL"))
+}
+
+// galleryScope is a helper function that filters the gallery images of a court
+// or the venue gallery images when the court ID is nil.
+//
+// db: The database query.
+// vendorID: The vendor ID.
+// courtID: The court ID.
+//
+// Returns the database query.
+func galleryScope(db *gorm.DB, vendorID uint, courtID *uint) *gorm.DB {
+	// Filter the venue gallery images
+	if courtID == nil {
+		return db.Where("vendor_id = ?", vendorID).Where("court_id IS NULL")
+	}
+
+	return db.Where("vendor_id = ?", vendorID).Where("court_id = ?", *courtID)
+}
+
+// Create is a function that creates a new gallery image.
+//
+// image: The gallery image object.
+//
+// Returns an error if any.
+func (*GalleryImageRepository) Create(image *models.GalleryImage) error {
+	// Create the gallery image
+	err := mysql.Conn.Create(image).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating gallery image: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetUsingIDVendorID is a function that returns the gallery image by ID and vendor ID.
+//
+// imageID: The gallery image ID.
+// vendorID: The vendor ID.
+//
+// Returns the gallery image and an error if any.
+func (*GalleryImageRepository) GetUsingIDVendorID(imageID uint, vendorID uint) (*models.GalleryImage, error) {
+	// Create a new gallery image object
+	var image models.GalleryImage
+
+	// Get the gallery image by ID and vendor ID
+	err := mysql.Conn.Where("id = ?", imageID).Where("vendor_id = ?", vendorID).First(&image).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting gallery image using id and vendor id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &image, nil
+}
+
+// GetUsingVendorIDCourtID is a function that returns the gallery images of a court,
+// or the venue gallery images when the court ID is nil.
+//
+// vendorID: The vendor ID.
+// courtID: The court ID.
+//
+// Returns the gallery images and an error if any.
+func (*GalleryImageRepository) GetUsingVendorIDCourtID(vendorID uint, courtID *uint) (*[]models.GalleryImage, error) {
+	// Create gallery images array
+	var images []models.GalleryImage
+
+	// Get the gallery images
+	err := orderedGalleryImages(galleryScope(mysql.Conn, vendorID, courtID)).Find(&images).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting gallery images using vendor id and court id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &images, nil
+}
+
+// GetUsingCourtIDsVendorID is a function that returns the gallery images of the courts.
+//
+// courtIDs: The court IDs.
+// vendorID: The vendor ID.
+//
+// Returns the gallery images and an error if any.
+func (*GalleryImageRepository) GetUsingCourtIDsVendorID(courtIDs []uint, vendorID uint) (*[]models.GalleryImage, error) {
+	// Create gallery images array
+	var images []models.GalleryImage
+
+	// Get the gallery images
+	err := mysql.Conn.Where("court_id IN (?)", courtIDs).Where("vendor_id = ?", vendorID).Find(&images).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting gallery images using court ids and vendor id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &images, nil
+}
+
+// GetCountUsingVendorIDCourtID is a function that returns the count of the gallery images
+// of a court, or of the venue when the court ID is nil.
+//
+// vendorID: The vendor ID.
+// courtID: The court ID.
+//
+// Returns the count of the gallery images and an error if any.
+func (*GalleryImageRepository) GetCountUsingVendorIDCourtID(vendorID uint, courtID *uint) (int64, error) {
+	// count is the number of gallery images
+	var count int64
+
+	// Get the count of the gallery images
+	err := galleryScope(mysql.Conn.Model(&models.GalleryImage{}), vendorID, courtID).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting gallery images count using vendor id and court id: " + err.Error())
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetCountUsingImage is a function that returns the count of the gallery images
+// stored in the image file.
+//
+// image: The image file name.
+//
+// Returns the count of the gallery images and an error if any.
+func (*GalleryImageRepository) GetCountUsingImage(image string) (int64, error) {
+	// count is the number of gallery images
+	var count int64
+
+	// Get the count of the gallery images
+	err := mysql.Conn.Model(&models.GalleryImage{}).Where("image = ?", image).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting gallery images count using image: " + err.Error())
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// GetLastPositionUsingVendorIDCourtID is a function that returns the last position of the
+// gallery images of a court, or of the venue when the court ID is nil.
+//
+// vendorID: The vendor ID.
+// courtID: The court ID.
+//
+// Returns the last position, -1 if there is no gallery image, and an error if any.
+func (*GalleryImageRepository) GetLastPositionUsingVendorIDCourtID(vendorID uint, courtID *uint) (int, error) {
+	// position is the last position
+	var position int
+
+	// Get the last position of the gallery images
+	err := galleryScope(mysql.Conn.Model(&models.GalleryImage{}), vendorID, courtID).Select("COALESCE(MAX(position), -1)").Scan(&position).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting gallery images last position using vendor id and court id: " + err.Error())
+
+		return 0, err
+	}
+
+	return position, nil
+}
+
+// UpdateCaptionUsingID is a function that updates the gallery image caption.
+//
+// imageID: The gallery image ID.
+// caption: The caption.
+//
+// Returns an error if any.
+func (*GalleryImageRepository) UpdateCaptionUsingID(imageID uint, caption string) error {
+	// Update the gallery image caption
+	err := mysql.Conn.Model(&models.GalleryImage{}).Where("id = ?", imageID).Update("caption", caption).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error updating gallery image caption: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// UpdateCoverUsingID is a function that sets the gallery image as the cover image
+// of its court or venue.
+//
+// image: The gallery image object.
+//
+// Returns an error if any.
+func (*GalleryImageRepository) UpdateCoverUsingID(image *models.GalleryImage) error {
+	// Update the cover image in a transaction
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Unset the current cover image
+		err := galleryScope(tx.Model(&models.GalleryImage{}), image.VendorID, image.CourtID).Where("is_cover = ?", true).Update("is_cover", false).Error
+
+		// Return an error if any
+		if err != nil {
+			return err
+		}
+
+		// Set the new cover image
+		return tx.Model(&models.GalleryImage{}).Where("id = ?", image.ID).Update("is_cover", true).Error
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error updating gallery image cover: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// UpdatePositionsUsingIDs is a function that updates the gallery images position
+// following the order of the given IDs.
+//
+// imageIDs: The ordered gallery image IDs.
+// vendorID: The vendor ID.
+// courtID: The court ID.
+//
+// Returns an error if any.
+func (*GalleryImageRepository) UpdatePositionsUsingIDs(imageIDs []uint, vendorID uint, courtID *uint) error {
+	// Update the positions in a transaction
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Loop through the gallery image IDs
+		for i, imageID := range imageIDs {
+			err := galleryScope(tx.Model(&models.GalleryImage{}), vendorID, courtID).Where("id = ?", imageID).Update("position", i).Error
+
+			// Return an error if any
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error updating gallery images position: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUsingID is a function that deletes the gallery image by ID.
+//
+// imageID: The gallery image ID.
+//
+// Returns an error if any.
+func (*GalleryImageRepository) DeleteUsingID(imageID uint) error {
+	// Delete the gallery image
+	err := mysql.Conn.Where("id = ?", imageID).Delete(&models.GalleryImage{}).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error deleting gallery image: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"log"
+	"main/core/types"
+	"main/data/models"
+	"main/internal/providers/mysql"
+	"time"
+)
+
+// NotificationRepository is a struct that defines the notification repository.
+type NotificationRepository struct{}
+
+// NewNotificationRepository is a factory function that returns a new instance of the notification repository.
+//
+// Returns a new instance of the notification repository.
+func NewNotificationRepository() *NotificationRepository {
+	return &NotificationRepository{}
+}
+
+// Create is a function that creates a new notification.
+//
+// notification: The notification object.
+//
+// Returns an error if any.
+func (*NotificationRepository) Create(notification *models.Notification) error {
+	// Create a new notification
+	err := mysql.Conn.Create(notification).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating notification: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetUsingUserID is a function that returns a page of the notifications of the user.
+//
+// userID: The user ID.
+// page: The page of the notifications.
+//
+// Returns the notifications, the next cursor or nil on the last page, and an error if any.
+func (*NotificationRepository) GetUsingUserID(userID uint, page *types.Page) (*[]models.Notification, *types.Cursor, error) {
+	// Create a new notifications slice
+	var notifications []models.Notification
+
+	// Get the notifications by user ID
+	err := mysql.Conn.Where("user_id = ?", userID).
+		Scopes(paginate(page, "notifications.id")).
+		Find(&notifications).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting notifications using user id: " + err.Error())
+
+		return nil, nil, err
+	}
+
+	// Get the next cursor
+	next := nextCursor(&notifications, page, func(notification *models.Notification) uint { return notification.ID })
+
+	return &notifications, next, nil
+}
+
+// GetUnreadCountUsingUserID is a function that returns the count of the unread notifications of the user.
+//
+// userID: The user ID.
+//
+// Returns the count and an error if any.
+func (*NotificationRepository) GetUnreadCountUsingUserID(userID uint) (int64, error) {
+	// Create new count variable
+	var count int64
+
+	// Get the count of the unread notifications
+	err := mysql.Conn.Model(&models.Notification{}).Where("user_id = ?", userID).Where("read_at IS NULL").Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting unread notifications count using user id: " + err.Error())
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// MarkRead is a function that marks the notification of the user as read,
+// the read notification is kept as it is.
+//
+// userID: The user ID.
+// notificationID: The notification ID.
+//
+// Returns whether the notification was found and an error if any.
+func (*NotificationRepository) MarkRead(userID uint, notificationID uint) (bool, error) {
+	// Create new count variable
+	var count int64
+
+	// Check if the notification of the user exists
+	err := mysql.Conn.Model(&models.Notification{}).Where("id = ?", notificationID).Where("user_id = ?", userID).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error checking notification: " + err.Error())
+
+		return false, err
+	}
+
+	// Return early if the notification is not found
+	if count == 0 {
+		return false, nil
+	}
+
+	// Mark the notification as read
+	err = mysql.Conn.Model(&models.Notification{}).Where("id = ?", notificationID).Where("read_at IS NULL").Update("read_at", time.Now()).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error marking notification as read: " + err.Error())
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// MarkAllRead is a function that marks every unread notification of the user as read.
+//
+// userID: The user ID.
+//
+// Returns an error if any.
+func (*NotificationRepository) MarkAllRead(userID uint) error {
+	// Mark the unread notifications as read
+	err := mysql.Conn.Model(&models.Notification{}).Where("user_id = ?", userID).Where("read_at IS NULL").Update("read_at", time.Now()).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error marking notifications as read: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+
+	"gorm.io/gorm"
+)
+
+// OpeningHourRepository is a struct that defines the opening hour repository.
+type OpeningHourRepository struct{}
+
+// NewOpeningHourRepository is a factory function that returns a new instance of the opening hour repository.
+//
+// Returns a new instance of the opening hour repository.
+func NewOpeningHourRepository() *OpeningHourRepository {
+	return &OpeningHourRepository{}
+}
+
+// GetUsingVendorID is a function that returns the opening hours of the vendor
+// ordered by the day of the week.
+//
+// vendorID: The vendor ID.
+//
+// Returns the opening hours and an error if any.
+func (*OpeningHourRepository) GetUsingVendorID(vendorID uint) (*[]models.OpeningHour, error) {
+	// Create opening hours array
+	var hours []models.OpeningHour
+
+	// Get the opening hours
+	err := mysql.Conn.Where("vendor_id = ?", vendorID).Order("weekday asc").Find(&hours).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting opening hours using vendor id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &hours, nil
+}
+
+// ReplaceUsingVendorID is a function that replaces the opening hours of the vendor.
+//
+// vendorID: The vendor ID.
+// hours: The new opening hours.
+//
+// Returns an error if any.
+func (*OpeningHourRepository) ReplaceUsingVendorID(vendorID uint, hours *[]models.OpeningHour) error {
+	// Replace the opening hours in a transaction
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Delete the old opening hours
+		err := tx.Where("vendor_id = ?", vendorID).Delete(&models.OpeningHour{}).Error
+
+		// Return an error if any
+		if err != nil {
+			return err
+		}
+
+		// Keep the vendor without opening hours
+		if len(*hours) == 0 {
+			return nil
+		}
+
+		return tx.Create(hours).Error
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error replacing opening hours: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"log"
 	"main/core/enums"
+	"main/core/types"
 	"main/data/models"
 	"main/internal/providers/mysql"
 	"time"
@@ -42,123 +43,137 @@ func (*OrderRepository) Create(tx *gorm.DB, order *models.Order) error {
 // GetUsingUserID is a method that returns the orders by the given user ID.
 //
 // userID: The ID of the user.
+// page: The page of the orders.
 //
-// Returns the orders and an error if any.
-func (*OrderRepository) GetUsingUserID(userID uint) (*[]models.Order, error) {
+// Returns the orders, the next cursor or nil on the last page, and an error if any.
+func (*OrderRepository) GetUsingUserID(userID uint, page *types.Page) (*[]models.Order, *types.Cursor, error) {
 	// orders is a placeholder for the orders
 	var orders []models.Order
 
 	// Get the orders from the database
 	err :=
 		mysql.Conn.Preload("Bookings").Preload("Bookings.Vendor").
-			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").
+			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").Preload("Bookings.CourtType").
 			Joins("JOIN bookings ON bookings.order_id = orders.id").
 			Where("bookings.user_id = ?", userID).Group("orders.id").
-			Order("orders.created_at DESC").
+			Scopes(paginate(page, "orders.id")).
 			Find(&orders).Error
 
 	// Return an error if any
 	if err != nil {
 		log.Println("Error getting orders using user id: " + err.Error())
 
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &orders, nil
+	// Get the next cursor
+	next := nextCursor(&orders, page, func(order *models.Order) uint { return order.ID })
+
+	return &orders, next, nil
 }
 
 // GetUsingVendorID is a method that returns the orders by the given vendor ID.
 //
 // vendorID: The ID of the vendor.
+// page: The page of the orders.
 //
-// Returns the orders and an error if any.
-func (*OrderRepository) GetUsingVendorID(vendorID uint) (*[]models.Order, error) {
+// Returns the orders, the next cursor or nil on the last page, and an error if any.
+func (*OrderRepository) GetUsingVendorID(vendorID uint, page *types.Page) (*[]models.Order, *types.Cursor, error) {
 	// orders is a placeholder for the orders
 	var orders []models.Order
 
 	// Get the orders from the database
 	err :=
 		mysql.Conn.Preload("Bookings").Preload("Bookings.Vendor").
-			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").
-			Preload("Bookings.User").
+			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").Preload("Bookings.CourtType").
+			Preload("Bookings.User").Preload("Bookings.Vendor").
 			Joins("JOIN bookings ON bookings.order_id = orders.id").
 			Where("bookings.vendor_id = ?", vendorID).Group("orders.id").
-			Order("orders.created_at DESC").
+			Scopes(paginate(page, "orders.id")).
 			Find(&orders).Error
 
 	// Return an error if any
 	if err != nil {
 		log.Println("Error getting orders using vendor id: " + err.Error())
 
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &orders, nil
+	// Get the next cursor
+	next := nextCursor(&orders, page, func(order *models.Order) uint { return order.ID })
+
+	return &orders, next, nil
 }
 
 // GetUsingUserIDCourtType is a method that returns the orders by the given user ID and court type.
 //
 // userID: The ID of the user.
 // courtType: The type of the court.
+// page: The page of the orders.
 //
-// Returns the orders and an error if any.
-func (*OrderRepository) GetUsingUserIDCourtType(userID uint, courtType string) (*[]models.Order, error) {
+// Returns the orders, the next cursor or nil on the last page, and an error if any.
+func (*OrderRepository) GetUsingUserIDCourtType(userID uint, courtType string, page *types.Page) (*[]models.Order, *types.Cursor, error) {
 	// orders is a placeholder for the orders
 	var orders []models.Order
 
 	// Get the orders from the database
 	err :=
 		mysql.Conn.Preload("Bookings").Preload("Bookings.Vendor").
-			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").
+			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").Preload("Bookings.CourtType").
 			Joins("JOIN bookings ON bookings.order_id = orders.id").
-			Joins("JOIN courts ON courts.id = bookings.court_id").
-			Joins("JOIN court_types ON court_types.id = courts.court_type_id").
+			Joins("JOIN court_types ON court_types.id = bookings.court_type_id").
 			Where("bookings.user_id = ?", userID).Group("orders.id").
 			Where("court_types.type = ?", courtType).
-			Order("orders.created_at desc").
+			Scopes(paginate(page, "orders.id")).
 			Find(&orders).Error
 
 	// Return an error if any
 	if err != nil {
 		log.Println("Error getting orders using user id and court type: " + err.Error())
 
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &orders, nil
+	// Get the next cursor
+	next := nextCursor(&orders, page, func(order *models.Order) uint { return order.ID })
+
+	return &orders, next, nil
 }
 
 // GetUsingVendorIDCourtType is a method that returns the orders by the given vendor ID and court type.
 //
 // vendorID: The ID of the vendor.
 // courtType: The type of the court.
+// page: The page of the orders.
 //
-// Returns the orders and an error if any.
-func (*OrderRepository) GetUsingVendorIDCourtType(vendorID uint, courtType string) (*[]models.Order, error) {
+// Returns the orders, the next cursor or nil on the last page, and an error if any.
+func (*OrderRepository) GetUsingVendorIDCourtType(vendorID uint, courtType string, page *types.Page) (*[]models.Order, *types.Cursor, error) {
 	// orders is a placeholder for the orders
 	var orders []models.Order
 
 	// Get the orders from the database
 	err :=
 		mysql.Conn.Preload("Bookings").Preload("Bookings.Vendor").
-			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").
-			Preload("Bookings.User").
+			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").Preload("Bookings.CourtType").
+			Preload("Bookings.User").Preload("Bookings.Vendor").
 			Joins("JOIN bookings ON bookings.order_id = orders.id").
-			Joins("JOIN courts ON courts.id = bookings.court_id").
-			Joins("JOIN court_types ON court_types.id = courts.court_type_id").
+			Joins("JOIN court_types ON court_types.id = bookings.court_type_id").
 			Where("bookings.vendor_id = ?", vendorID).Group("orders.id").
 			Where("court_types.type = ?", courtType).
-			Order("orders.created_at desc").
+			Scopes(paginate(page, "orders.id")).
 			Find(&orders).Error
 
 	// Return an error if any
 	if err != nil {
 		log.Println("Error getting orders using user id and court type: " + err.Error())
 
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &orders, nil
+	// Get the next cursor
+	next := nextCursor(&orders, page, func(order *models.Order) uint { return order.ID })
+
+	return &orders, next, nil
 }
 
 // GetUsingID is a method that returns the order by the given order ID.
@@ -175,7 +190,8 @@ func (*OrderRepository) GetUsingID(orderID uint) (*models.Order, error) {
 		mysql.Conn.Preload("Bookings", func(db *gorm.DB) *gorm.DB {
 			return db.Order("Bookings.book_start_time ASC")
 		}).Preload("Bookings.Court.Vendor").
-			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").
+			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").Preload("Bookings.CourtType").
+			Preload("Bookings.User").Preload("Bookings.Vendor").
 			Joins("JOIN bookings ON bookings.order_id = orders.id").
 			Where("orders.id = ?", orderID).
 			First(&order).Error
@@ -294,8 +310,8 @@ func (*OrderRepository) GetNLatestUsingVendorID(vendorID uint, n int) (*[]models
 	// Get the orders from the database
 	err :=
 		mysql.Conn.Preload("Bookings").Preload("Bookings.Vendor").
-			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").
-			Preload("Bookings.User").
+			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").Preload("Bookings.CourtType").
+			Preload("Bookings.User").Preload("Bookings.Vendor").
 			Joins("JOIN bookings ON bookings.order_id = orders.id").
 			Where("bookings.vendor_id = ?", vendorID).Group("orders.id").
 			Order("orders.created_at DESC").Limit(n).
@@ -310,3 +326,41 @@ func (*OrderRepository) GetNLatestUsingVendorID(vendorID uint, n int) (*[]models
 
 	return &orders, nil
 }
+
+// Get is a method that returns a page of the orders, the orders are
+// filtered by the status when the status is given.
+//
+// status: The status of the orders, empty for every status.
+// page: The page of the orders.
+//
+// Returns the orders, the next cursor or nil on the last page, and an error if any.
+func (*OrderRepository) Get(status string, page *types.Page) (*[]models.Order, *types.Cursor, error) {
+	// orders is a placeholder for the orders
+	var orders []models.Order
+
+	// Create the orders query
+	query :=
+		mysql.Conn.Preload("Bookings").Preload("Bookings.Vendor").
+			Preload("Bookings.Court").Preload("Bookings.Court.CourtType").Preload("Bookings.CourtType").
+			Preload("Bookings.User")
+
+	// Filter the status if any
+	if status != "" {
+		query = query.Where("orders.status = ?", status)
+	}
+
+	// Get the orders from the database
+	err := query.Scopes(paginate(page, "orders.id")).Find(&orders).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting orders: " + err.Error())
+
+		return nil, nil, err
+	}
+
+	// Get the next cursor
+	next := nextCursor(&orders, page, func(order *models.Order) uint { return order.ID })
+
+	return &orders, next, nil
+}
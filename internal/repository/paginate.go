@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"main/core/types"
+
+	"gorm.io/gorm"
+)
+
+// paginate is a helper function that returns the scope to get a page of a list
+// sorted by the id column in descending order, newest first.
+// One more item is taken to know whether there is a next page.
+//
+// page: The page.
+// column: The id column of the list.
+//
+// Returns the scope.
+func paginate(page *types.Page, column string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		// Start the page after the cursor
+		if page.Cursor != nil {
+			db = db.Where(column+" < ?", page.Cursor.ID)
+		}
+
+		return db.Order(column + " DESC").Limit(page.Limit + 1)
+	}
+}
+
+// nextCursor is a helper function that removes the extra item taken by the paginate scope
+// and returns the cursor of the next page.
+//
+// items: The items of the page.
+// page: The page.
+// id: The function to get the id of an item.
+//
+// Returns the next cursor, nil on the last page.
+func nextCursor[T any](items *[]T, page *types.Page, id func(item *T) uint) *types.Cursor {
+	// Return nil if there is no next page
+	if len(*items) <= page.Limit {
+		return nil
+	}
+
+	// Remove the extra item
+	*items = (*items)[:page.Limit]
+
+	return &types.Cursor{
+		ID: id(&(*items)[page.Limit-1]),
+	}
+}
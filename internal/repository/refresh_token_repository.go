@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// RefreshTokenRepository is a struct that defines the refresh token repository.
+type RefreshTokenRepository struct{}
+
+// NewRefreshTokenRepository is a factory function that returns a new instance of the refresh token repository.
+//
+// Returns a new instance of the refresh token repository.
+func NewRefreshTokenRepository() *RefreshTokenRepository {
+	return &RefreshTokenRepository{}
+}
+
+// Create is a function that creates a refresh token.
+//
+// token: The refresh token object.
+//
+// Returns an error if any.
+func (*RefreshTokenRepository) Create(token *models.RefreshToken) error {
+	// Create the refresh token
+	err := mysql.Conn.Create(token).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating refresh token: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetUsingTokenHash is a function that returns the refresh token by the token hash.
+//
+// tokenHash: The SHA-256 hash of the refresh token.
+//
+// Returns the refresh token and an error if any.
+func (*RefreshTokenRepository) GetUsingTokenHash(tokenHash string) (*models.RefreshToken, error) {
+	// Create a new refresh token object
+	var token models.RefreshToken
+
+	// Get the refresh token by the token hash
+	err := mysql.Conn.First(&token, "token_hash = ?", tokenHash).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting refresh token using token hash: " + err.Error())
+
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// Rotate is a function that marks the refresh token as used and creates the next
+// token of its family, nothing is changed when the token is already used or revoked.
+//
+// token: The used refresh token object.
+// next: The next refresh token object.
+//
+// Returns true if the token is rotated and an error if any.
+func (*RefreshTokenRepository) Rotate(token *models.RefreshToken, next *models.RefreshToken) (bool, error) {
+	// rotated is whether the token is rotated
+	rotated := false
+
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Mark the token as used if it's still usable
+		result :=
+			tx.Model(&models.RefreshToken{}).
+				Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", token.ID).
+				Update("used_at", time.Now())
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		// Skip the token used by another request
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		rotated = true
+
+		// Create the next token
+		return tx.Create(next).Error
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error rotating refresh token: " + err.Error())
+
+		return false, err
+	}
+
+	return rotated, nil
+}
+
+// RevokeFamily is a function that revokes every refresh token of the token family.
+//
+// familyID: The id of the token family.
+//
+// Returns an error if any.
+func (*RefreshTokenRepository) RevokeFamily(familyID string) error {
+	// Revoke the tokens of the family
+	err :=
+		mysql.Conn.Model(&models.RefreshToken{}).
+			Where("family_id = ? AND revoked_at IS NULL", familyID).
+			Update("revoked_at", time.Now()).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error revoking refresh token family: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// RevokeUsingClient is a function that revokes every refresh token of the client,
+// the tokens of the vendor staff are revoked along with the vendor.
+//
+// clientID: The id of the client.
+// clientType: The client type.
+//
+// Returns an error if any.
+func (*RefreshTokenRepository) RevokeUsingClient(clientID uint, clientType int) error {
+	// Revoke the tokens of the client
+	err :=
+		mysql.Conn.Model(&models.RefreshToken{}).
+			Where("client_id = ? AND client_type = ? AND revoked_at IS NULL", clientID, clientType).
+			Update("revoked_at", time.Now()).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error revoking refresh tokens using client: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// RevokeUsingAccount is a function that revokes every refresh token of a single account,
+// the vendor account or one of its staff for a vendor.
+//
+// clientID: The id of the client, the vendor id for a vendor staff.
+// clientType: The client type.
+// staffID: The id of the vendor staff, 0 for the account of the client itself.
+//
+// Returns an error if any.
+func (*RefreshTokenRepository) RevokeUsingAccount(clientID uint, clientType int, staffID uint) error {
+	// Create the query of the tokens of the client
+	query :=
+		mysql.Conn.Model(&models.RefreshToken{}).
+			Where("client_id = ? AND client_type = ? AND revoked_at IS NULL", clientID, clientType)
+
+	// Filter the tokens of the vendor staff or the client itself
+	if staffID != 0 {
+		query = query.Where("staff_id = ?", staffID)
+	} else {
+		query = query.Where("staff_id IS NULL")
+	}
+
+	// Revoke the tokens of the account
+	err := query.Update("revoked_at", time.Now()).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error revoking refresh tokens using account: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Clear is a function that deletes all the expired refresh tokens.
+//
+// Returns an error if any.
+func (*RefreshTokenRepository) Clear() error {
+	// Delete all the expired tokens
+	err := mysql.Conn.Delete(&models.RefreshToken{}, "expires_at < ?", time.Now()).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error clearing refresh tokens: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+)
+
+// ReviewImageRepository is a struct that defines the review image repository.
+type ReviewImageRepository struct{}
+
+// NewReviewImageRepository is a factory function that returns a new instance of the review image repository.
+//
+// Returns a new instance of the review image repository.
+func NewReviewImageRepository() *ReviewImageRepository {
+	return &ReviewImageRepository{}
+}
+
+// GetCountUsingImage is a function that returns the count of the review images
+// stored in the image file.
+//
+// image: The image file name.
+//
+// Returns the count of the review images and an error if any.
+func (*ReviewImageRepository) GetCountUsingImage(image string) (int64, error) {
+	// count is the number of review images
+	var count int64
+
+	// Get the count of the review images
+	err := mysql.Conn.Model(&models.ReviewImage{}).Where("image = ?", image).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting review images count using image: " + err.Error())
+
+		return 0, err
+	}
+
+	return count, nil
+}
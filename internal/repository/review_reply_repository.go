@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+
+	"gorm.io/gorm/clause"
+)
+
+// ReviewReplyRepository is a struct that defines the review reply repository.
+type ReviewReplyRepository struct{}
+
+// NewReviewReplyRepository is a factory function that returns a new instance of the review reply repository.
+//
+// Returns a new instance of the review reply repository.
+func NewReviewReplyRepository() *ReviewReplyRepository {
+	return &ReviewReplyRepository{}
+}
+
+// GetUsingReviewID is a function that returns the reply of the review.
+//
+// reviewID: The review ID.
+//
+// Returns the review reply and an error if any.
+func (*ReviewReplyRepository) GetUsingReviewID(reviewID uint) (*models.ReviewReply, error) {
+	// Create a new review reply object
+	var reply models.ReviewReply
+
+	// Get the review reply
+	err := mysql.Conn.First(&reply, "review_id = ?", reviewID).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting review reply using review id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &reply, nil
+}
+
+// Save is a function that creates the reply of the review or replaces the
+// existing reply of the review.
+//
+// reply: The review reply object.
+//
+// Returns an error if any.
+func (*ReviewReplyRepository) Save(reply *models.ReviewReply) error {
+	// Create or update the review reply
+	err := mysql.Conn.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "review_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"reply", "updated_at"}),
+	}).Create(reply).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error saving review reply: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUsingReviewID is a function that deletes the reply of the review.
+//
+// reviewID: The review ID.
+//
+// Returns whether the reply was deleted and an error if any.
+func (*ReviewReplyRepository) DeleteUsingReviewID(reviewID uint) (bool, error) {
+	// Delete the review reply
+	result := mysql.Conn.Delete(&models.ReviewReply{}, "review_id = ?", reviewID)
+
+	// Return an error if any
+	if result.Error != nil {
+		log.Println("Error deleting review reply using review id: " + result.Error.Error())
+
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+)
+
+// ReviewReportRepository is a struct that defines the review report repository.
+type ReviewReportRepository struct{}
+
+// NewReviewReportRepository is a factory function that returns a new instance of the review report repository.
+//
+// Returns a new instance of the review report repository.
+func NewReviewReportRepository() *ReviewReportRepository {
+	return &ReviewReportRepository{}
+}
+
+// Create is a function that creates a new review report.
+//
+// report: The review report object.
+//
+// Returns an error if any.
+func (*ReviewReportRepository) Create(report *models.ReviewReport) error {
+	// Create a new review report
+	err := mysql.Conn.Create(report).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating review report: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// CheckUnresolvedExists is a function that checks if the reporter has an unresolved report of the review.
+//
+// reviewID: The review ID.
+// reporterType: The client type of the reporter.
+// reporterID: The reporter ID.
+//
+// Returns true if the unresolved report exists and an error if any.
+func (*ReviewReportRepository) CheckUnresolvedExists(reviewID uint, reporterType string, reporterID uint) (bool, error) {
+	// Create new count variable
+	var count int64
+
+	// Get the count of the unresolved reports of the reporter
+	err := mysql.Conn.Model(&models.ReviewReport{}).
+		Where("review_id = ?", reviewID).
+		Where("reporter_type = ?", reporterType).
+		Where("reporter_id = ?", reporterID).
+		Where("resolved_at IS NULL").
+		Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error checking unresolved review report: " + err.Error())
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
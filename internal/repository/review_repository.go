@@ -1,10 +1,16 @@
 package repository
 
 import (
+	"fmt"
 	"log"
+	"main/core/constants"
+	"main/core/enums"
 	"main/core/types"
 	"main/data/models"
 	"main/internal/providers/mysql"
+	"time"
+
+	"gorm.io/gorm"
 )
 
 // ReviewRepository is a struct that defines the ReviewRepository
@@ -17,14 +23,25 @@ func NewReviewRepository() *ReviewRepository {
 	return &ReviewRepository{}
 }
 
-// Create is a function that creates a new review.
+// Create is a function that creates a new review and adds it to the review summary.
 //
 // review: The review to create.
 //
 // Returns an error if any.
 func (*ReviewRepository) Create(review *models.Review) error {
-	// Create the review
-	err := mysql.Conn.Create(review).Error
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Create the review
+		if err := tx.Create(review).Error; err != nil {
+			return err
+		}
+
+		// Skip the review summary if the review is not published
+		if review.Status != enums.ReviewPublished.Label() {
+			return nil
+		}
+
+		return applyReviewSummary(tx, review, 1)
+	})
 
 	// Return an error if any
 	if err != nil {
@@ -36,7 +53,8 @@ func (*ReviewRepository) Create(review *models.Review) error {
 	return nil
 }
 
-// GetCountUsingVendorID is a function that returns the count of reviews for vendor ID.
+// GetCountUsingVendorID is a function that returns the count of reviews for vendor ID
+// from the review summaries.
 //
 // vendorID: The vendor ID.
 //
@@ -46,7 +64,7 @@ func (*ReviewRepository) GetCountUsingVendorID(vendorID uint) (int64, error) {
 	var count int64
 
 	// Get the count of courts by vendor ID
-	err := mysql.Conn.Model(&models.Review{}).Where("vendor_id = ?", vendorID).Count(&count).Error
+	err := mysql.Conn.Model(&models.ReviewSummary{}).Where("vendor_id = ?", vendorID).Select("COALESCE(SUM(review_count), 0)").Scan(&count).Error
 
 	// Return an error if any
 	if err != nil {
@@ -58,7 +76,8 @@ func (*ReviewRepository) GetCountUsingVendorID(vendorID uint) (int64, error) {
 	return count, nil
 }
 
-// GetCountUsingVendorIDCourtType is a function that returns the count of reviews for vendor ID and court type.
+// GetCountUsingVendorIDCourtType is a function that returns the count of reviews for vendor ID and court type
+// from the review summaries.
 //
 // vendorID: The vendor ID.
 // courtType: The court type.
@@ -70,7 +89,7 @@ func (*ReviewRepository) GetCountUsingVendorIDCourtType(vendorID uint, courtType
 
 	// Get the count of courts by vendor ID and court type
 	err :=
-		mysql.Conn.Model(&models.Review{}).Joins("CourtType").Where("vendor_id = ?", vendorID).Where("CourtType.type = ?", courtType).Count(&count).Error
+		mysql.Conn.Model(&models.ReviewSummary{}).Scopes(reviewSummaryOfCourtType(courtType)).Where("vendor_id = ?", vendorID).Select("COALESCE(SUM(review_count), 0)").Scan(&count).Error
 
 	// Return an error if any
 	if err != nil {
@@ -82,7 +101,8 @@ func (*ReviewRepository) GetCountUsingVendorIDCourtType(vendorID uint, courtType
 	return count, nil
 }
 
-// GetStarCountsUsingVendorID is a function that returns the star count by vendor ID.
+// GetStarCountsUsingVendorID is a function that returns the star count by vendor ID
+// from the review summaries.
 //
 // vendorID: The vendor ID.
 //
@@ -99,13 +119,7 @@ func (*ReviewRepository) GetStarCountsUsingVendorID(vendorID uint) (*types.StarC
 
 	// Get the courts by vendor ID and star
 	err :=
-		mysql.Conn.Model(&models.Review{}).Select(`
-        COUNT(CASE WHEN rating = 1 THEN 1 END) AS one_star,
-        COUNT(CASE WHEN rating = 2 THEN 1 END) AS two_star,
-        COUNT(CASE WHEN rating = 3 THEN 1 END) AS three_star,
-        COUNT(CASE WHEN rating = 4 THEN 1 END) AS four_star,
-        COUNT(CASE WHEN rating = 5 THEN 1 END) AS five_star
-    `).
+		mysql.Conn.Model(&models.ReviewSummary{}).Select(reviewSummaryStarCounts).
 			Where("vendor_id = ?", vendorID).Scan(&results).Error
 
 	// Return an error if any
@@ -124,7 +138,8 @@ func (*ReviewRepository) GetStarCountsUsingVendorID(vendorID uint) (*types.StarC
 	}, nil
 }
 
-// GetStarCountsUsingVendorIDCourtType is a function that returns the star count by vendor ID and court type.
+// GetStarCountsUsingVendorIDCourtType is a function that returns the star count by vendor ID and court type
+// from the review summaries.
 //
 // vendorID: The vendor ID.
 // courtType: The court type.
@@ -142,14 +157,8 @@ func (*ReviewRepository) GetStarCountsUsingVendorIDCourtType(vendorID uint, cour
 
 	// Get the courts by vendor ID and court type
 	err :=
-		mysql.Conn.Model(&models.Review{}).Joins("JOIN court_types on court_types.id = reviews.court_type_id").Select(`
-        COUNT(CASE WHEN rating = 1 THEN 1 END) AS one_star,
-        COUNT(CASE WHEN rating = 2 THEN 1 END) AS two_star,
-        COUNT(CASE WHEN rating = 3 THEN 1 END) AS three_star,
-        COUNT(CASE WHEN rating = 4 THEN 1 END) AS four_star,
-        COUNT(CASE WHEN rating = 5 THEN 1 END) AS five_star
-    `).
-			Where("vendor_id = ?", vendorID).Where("court_types.type = ?", courtType).Scan(&results).Error
+		mysql.Conn.Model(&models.ReviewSummary{}).Select(reviewSummaryStarCounts).
+			Scopes(reviewSummaryOfCourtType(courtType)).Where("vendor_id = ?", vendorID).Scan(&results).Error
 
 	// Return an error if any
 	if err != nil {
@@ -171,47 +180,57 @@ func (*ReviewRepository) GetStarCountsUsingVendorIDCourtType(vendorID uint, cour
 //
 // vendorID: The vendor ID.
 // courtType: The court type.
+// filter: The filters and the sort order of the reviews.
+// page: The page of the reviews.
 //
-// Returns the reviews and an error if any.
-func (*ReviewRepository) GetUsingVendorIDCourtType(vendorID uint, courtType string) (*[]models.Review, error) {
+// Returns the reviews, the next cursor or nil on the last page, and an error if any.
+func (*ReviewRepository) GetUsingVendorIDCourtType(vendorID uint, courtType string, filter *types.ReviewsFilter, page *types.Page) (*[]models.Review, *types.Cursor, error) {
 	// reviews is a slice of maps containing the reviews of the court
 	var reviews []models.Review
 
 	// Get the reviews using the vendor ID and court type
 	err :=
-		mysql.Conn.Preload("User").Preload("Vendor").Joins("CourtType").Where("vendor_id = ?", vendorID).Where("CourtType.type = ?", courtType).Order("reviews.date DESC").Find(&reviews).Error
+		mysql.Conn.Preload("User").Preload("Vendor").Preload("Reply").Preload("Images", orderedReviewImages).Joins("CourtType").Scopes(publishedReviews).Where("vendor_id = ?", vendorID).Where("CourtType.type = ?", courtType).Scopes(reviewsWithPhotos(filter.WithPhotos)).Scopes(sortReviews(filter.Sort, page)).Find(&reviews).Error
 
 	// Return an error if any
 	if err != nil {
 		log.Println("Error getting reviews using vendor id and court type: " + err.Error())
 
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &reviews, err
+	// Get the next cursor
+	next := reviewsNextCursor(&reviews, filter.Sort, page)
+
+	return &reviews, next, nil
 }
 
 // GetUsingVendorID is a function that returns the reviews using the vendor ID.
 //
 // vendorID: The vendor ID.
+// filter: The filters and the sort order of the reviews.
+// page: The page of the reviews.
 //
-// Returns the reviews and an error if any.
-func (*ReviewRepository) GetUsingVendorID(vendorID uint) (*[]models.Review, error) {
+// Returns the reviews, the next cursor or nil on the last page, and an error if any.
+func (*ReviewRepository) GetUsingVendorID(vendorID uint, filter *types.ReviewsFilter, page *types.Page) (*[]models.Review, *types.Cursor, error) {
 	// reviews is a slice of maps containing the reviews of the court
 	var reviews []models.Review
 
 	// Get the reviews using the vendor ID
 	err :=
-		mysql.Conn.Preload("User").Preload("Vendor").Preload("CourtType").Where("vendor_id = ?", vendorID).Order("reviews.date DESC").Find(&reviews).Error
+		mysql.Conn.Preload("User").Preload("Vendor").Preload("Reply").Preload("Images", orderedReviewImages).Preload("CourtType").Scopes(publishedReviews).Where("vendor_id = ?", vendorID).Scopes(reviewsWithPhotos(filter.WithPhotos)).Scopes(sortReviews(filter.Sort, page)).Find(&reviews).Error
 
 	// Return an error if any
 	if err != nil {
 		log.Println("Error getting reviews using vendor id: " + err.Error())
 
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &reviews, err
+	// Get the next cursor
+	next := reviewsNextCursor(&reviews, filter.Sort, page)
+
+	return &reviews, next, nil
 }
 
 // CheckUserHasReviewCourtType is a function that checks if the user has a review for the court type.
@@ -244,52 +263,62 @@ func (*ReviewRepository) CheckUserHasReviewCourtType(userID uint, vendorID uint,
 // vendorID: The vendor ID.
 // courtType: The court type.
 // rating: The rating.
+// filter: The filters and the sort order of the reviews.
+// page: The page of the reviews.
 //
-// Returns the reviews and an error if any.
-func (*ReviewRepository) GetUsingVendorIDCourtTypeRating(vendorID uint, courtType string, rating int) (*[]models.Review, error) {
+// Returns the reviews, the next cursor or nil on the last page, and an error if any.
+func (*ReviewRepository) GetUsingVendorIDCourtTypeRating(vendorID uint, courtType string, rating int, filter *types.ReviewsFilter, page *types.Page) (*[]models.Review, *types.Cursor, error) {
 	// reviews is a slice of maps containing the reviews of the court
 	var reviews []models.Review
 
 	// Get the reviews using the vendor ID and court type
 	err :=
-		mysql.Conn.Preload("User").Preload("Vendor").Joins("CourtType").Where("vendor_id = ?", vendorID).Where("rating = ?", rating).Where("CourtType.type = ?", courtType).Order("reviews.date DESC").Find(&reviews).Error
+		mysql.Conn.Preload("User").Preload("Vendor").Preload("Reply").Preload("Images", orderedReviewImages).Joins("CourtType").Scopes(publishedReviews).Where("vendor_id = ?", vendorID).Where("rating = ?", rating).Where("CourtType.type = ?", courtType).Scopes(reviewsWithPhotos(filter.WithPhotos)).Scopes(sortReviews(filter.Sort, page)).Find(&reviews).Error
 
 	// Return an error if any
 	if err != nil {
 		log.Println("Error getting reviews using vendor id, court type, and rating: " + err.Error())
 
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &reviews, err
+	// Get the next cursor
+	next := reviewsNextCursor(&reviews, filter.Sort, page)
+
+	return &reviews, next, nil
 }
 
 // GetUsingVendorIDRating is a function that returns the reviews using the vendor ID and rating.
 //
 // vendorID: The vendor ID.
 // rating: The rating.
+// filter: The filters and the sort order of the reviews.
+// page: The page of the reviews.
 //
-// Returns the reviews and an error if any.
-func (*ReviewRepository) GetUsingVendorIDRating(vendorID uint, rating int) (*[]models.Review, error) {
+// Returns the reviews, the next cursor or nil on the last page, and an error if any.
+func (*ReviewRepository) GetUsingVendorIDRating(vendorID uint, rating int, filter *types.ReviewsFilter, page *types.Page) (*[]models.Review, *types.Cursor, error) {
 	// reviews is a slice of maps containing the reviews of the court
 	var reviews []models.Review
 
 	// Get the reviews using the vendor ID and court type
 	err :=
-		mysql.Conn.Preload("User").Preload("Vendor").Preload("CourtType").Where("vendor_id = ?", vendorID).Where("rating = ?", rating).Order("reviews.date DESC").Find(&reviews).Error
+		mysql.Conn.Preload("User").Preload("Vendor").Preload("Reply").Preload("Images", orderedReviewImages).Preload("CourtType").Scopes(publishedReviews).Where("vendor_id = ?", vendorID).Where("rating = ?", rating).Scopes(reviewsWithPhotos(filter.WithPhotos)).Scopes(sortReviews(filter.Sort, page)).Find(&reviews).Error
 
 	// Return an error if any
 	if err != nil {
 		log.Println("Error getting reviews using vendor id and rating: " + err.Error())
 
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &reviews, err
+	// Get the next cursor
+	next := reviewsNextCursor(&reviews, filter.Sort, page)
+
+	return &reviews, next, nil
 }
 
 // GetAvgRatingUsingCourtTypeVendorID is a function that returns the average rating using
-// the court type and vendor ID.
+// the court type and vendor ID from the review summaries.
 //
 // courtType: The court type.
 // vendorID: The vendor ID.
@@ -301,7 +330,7 @@ func (*ReviewRepository) GetAvgRatingUsingCourtTypeVendorID(courtType string, ve
 
 	// Get the count of courts by vendor ID and court type
 	err :=
-		mysql.Conn.Model(&models.Review{}).Joins("JOIN court_types ON court_types.id = reviews.court_type_id").Where("vendor_id = ?", vendorID).Where("court_types.type = ?", courtType).Select("COALESCE(ROUND(AVG(rating), 1), 0)").Scan(&avgRating).Error
+		mysql.Conn.Model(&models.ReviewSummary{}).Scopes(reviewSummaryOfCourtType(courtType)).Where("vendor_id = ?", vendorID).Select(reviewSummaryAvgRating).Scan(&avgRating).Error
 
 	// Return an error if any
 	if err != nil {
@@ -313,7 +342,8 @@ func (*ReviewRepository) GetAvgRatingUsingCourtTypeVendorID(courtType string, ve
 	return avgRating, nil
 }
 
-// GetAvgRatingUsingVendorID is a function that returns the average rating using the vendor ID.
+// GetAvgRatingUsingVendorID is a function that returns the average rating using the vendor ID
+// from the review summaries.
 //
 // vendorID: The vendor ID.
 //
@@ -324,7 +354,7 @@ func (*ReviewRepository) GetAvgRatingUsingVendorID(vendorID uint) (float64, erro
 
 	// Get the count of courts by vendor ID and court type
 	err :=
-		mysql.Conn.Model(&models.Review{}).Where("vendor_id = ?", vendorID).Select("COALESCE(ROUND(AVG(rating), 1), 0)").Scan(&avgRating).Error
+		mysql.Conn.Model(&models.ReviewSummary{}).Where("vendor_id = ?", vendorID).Select(reviewSummaryAvgRating).Scan(&avgRating).Error
 
 	// Return an error if any
 	if err != nil {
@@ -335,3 +365,333 @@ func (*ReviewRepository) GetAvgRatingUsingVendorID(vendorID uint) (float64, erro
 
 	return avgRating, nil
 }
+
+// Get is a function that returns a page of the reviews, the reviews are
+// filtered by the vendor when the vendor ID is given.
+//
+// vendorID: The vendor ID, nil for every vendor.
+// page: The page of the reviews.
+//
+// Returns the reviews, the next cursor or nil on the last page, and an error if any.
+func (*ReviewRepository) Get(vendorID *uint, page *types.Page) (*[]models.Review, *types.Cursor, error) {
+	// reviews is a slice of the reviews
+	var reviews []models.Review
+
+	// Create the reviews query
+	query := mysql.Conn.Preload("User").Preload("Vendor").Preload("Reply").Preload("Images", orderedReviewImages).Preload("CourtType")
+
+	// Filter the vendor if any
+	if vendorID != nil {
+		query = query.Where("vendor_id = ?", *vendorID)
+	}
+
+	// Get the reviews
+	err := query.Scopes(paginate(page, "reviews.id")).Find(&reviews).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting reviews: " + err.Error())
+
+		return nil, nil, err
+	}
+
+	// Get the next cursor
+	next := nextCursor(&reviews, page, func(review *models.Review) uint { return review.ID })
+
+	return &reviews, next, nil
+}
+
+// GetUsingID is a function that returns the review using the review ID.
+//
+// reviewID: The review ID.
+//
+// Returns the review and an error if any.
+func (*ReviewRepository) GetUsingID(reviewID uint) (*models.Review, error) {
+	// Create a new review object
+	var review models.Review
+
+	// Get the review
+	err := mysql.Conn.Preload("User").Preload("Vendor").Preload("Reply").Preload("Images", orderedReviewImages).Preload("CourtType").First(&review, "id = ?", reviewID).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting review using id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+// GetUsingIDWithDeleted is a function that returns the review using the review ID,
+// including the deleted review.
+//
+// reviewID: The review ID.
+//
+// Returns the review and an error if any.
+func (*ReviewRepository) GetUsingIDWithDeleted(reviewID uint) (*models.Review, error) {
+	// Create a new review object
+	var review models.Review
+
+	// Get the review
+	err := mysql.Conn.Unscoped().Preload("User").Preload("Vendor").Preload("Reply").Preload("Images", orderedReviewImages).Preload("CourtType").First(&review, "id = ?", reviewID).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting review using id with deleted: " + err.Error())
+
+		return nil, err
+	}
+
+	return &review, nil
+}
+
+// UpdateWithRevision is a function that keeps the current version of the review
+// as a revision and updates the review and its review summary.
+//
+// revision: The revision of the current version.
+// fields: The updated fields of the review.
+//
+// Returns an error if any.
+func (*ReviewRepository) UpdateWithRevision(revision *models.ReviewRevision, fields map[string]any) error {
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Create the revision
+		if err := tx.Create(revision).Error; err != nil {
+			return err
+		}
+
+		return updateReviewWithSummary(tx, revision.ReviewID, func(tx *gorm.DB) error {
+			return tx.Model(&models.Review{}).Where("id = ?", revision.ReviewID).Updates(fields).Error
+		})
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error updating review with revision: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetModerationQueue is a function that returns a page of the reviews waiting for moderation,
+// the reviews held by the word filter and the reviews with unresolved reports.
+//
+// page: The page of the reviews.
+//
+// Returns the reviews with their unresolved reports, the next cursor or nil on the last page, and an error if any.
+func (*ReviewRepository) GetModerationQueue(page *types.Page) (*[]models.Review, *types.Cursor, error) {
+	// reviews is a slice of the reviews
+	var reviews []models.Review
+
+	// Subquery to get the reviews with unresolved reports
+	reportedSubQuery :=
+		mysql.Conn.Model(&models.ReviewReport{}).Select("review_id").Where("resolved_at IS NULL")
+
+	// Get the reviews waiting for moderation
+	err := mysql.Conn.Preload("User").Preload("Vendor").Preload("CourtType").Preload("Reply").Preload("Images", orderedReviewImages).
+		Preload("Reports", "resolved_at IS NULL").
+		Where("reviews.status = ? OR reviews.id IN (?)", enums.ReviewHeld.Label(), reportedSubQuery).
+		Scopes(paginate(page, "reviews.id")).
+		Find(&reviews).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting review moderation queue: " + err.Error())
+
+		return nil, nil, err
+	}
+
+	// Get the next cursor
+	next := nextCursor(&reviews, page, func(review *models.Review) uint { return review.ID })
+
+	return &reviews, next, nil
+}
+
+// UpdateStatus is a function that updates the moderation status of the review
+// and its review summary, and resolves its reports.
+//
+// reviewID: The review ID.
+// status: The review status.
+//
+// Returns an error if any.
+func (*ReviewRepository) UpdateStatus(reviewID uint, status string) error {
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Update the review status
+		err := updateReviewWithSummary(tx, reviewID, func(tx *gorm.DB) error {
+			return tx.Model(&models.Review{}).Where("id = ?", reviewID).Update("status", status).Error
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return resolveReviewReports(tx, reviewID)
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error updating review status: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUsingID is a function that deletes the review using the review ID, removes it from
+// the review summary and resolves its reports, the deleted review is kept for moderation.
+//
+// reviewID: The review ID.
+//
+// Returns whether the review was deleted and an error if any.
+func (*ReviewRepository) DeleteUsingID(reviewID uint) (bool, error) {
+	// deleted is whether the review was deleted
+	var deleted bool
+
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Delete the review
+		err := updateReviewWithSummary(tx, reviewID, func(tx *gorm.DB) error {
+			result := tx.Delete(&models.Review{}, "id = ?", reviewID)
+
+			deleted = result.RowsAffected > 0
+
+			return result.Error
+		})
+
+		if err != nil {
+			return err
+		}
+
+		return resolveReviewReports(tx, reviewID)
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error deleting review using id: " + err.Error())
+
+		return false, err
+	}
+
+	return deleted, nil
+}
+
+// resolveReviewReports is a helper function that resolves the unresolved reports of the review.
+//
+// tx: The database transaction.
+// reviewID: The review ID.
+//
+// Returns an error if any.
+func resolveReviewReports(tx *gorm.DB, reviewID uint) error {
+	return tx.Model(&models.ReviewReport{}).Where("review_id = ?", reviewID).Where("resolved_at IS NULL").Update("resolved_at", time.Now()).Error
+}
+
+// publishedReviews is a helper function that returns the scope to get only the published
+// reviews, the held and hidden reviews are not shown nor counted in the rating.
+//
+// db: The database query.
+//
+// Returns the scoped database query.
+func publishedReviews(db *gorm.DB) *gorm.DB {
+	return db.Where("reviews.status = ?", enums.ReviewPublished.Label())
+}
+
+// reviewsWithPhotos is a helper function that returns the scope to get only the reviews
+// with photos when requested.
+//
+// withPhotos: Whether to get only the reviews with photos.
+//
+// Returns the scope function.
+func reviewsWithPhotos(withPhotos bool) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		// Return the query as is if the filter is not requested
+		if !withPhotos {
+			return db
+		}
+
+		return db.Where("EXISTS (SELECT 1 FROM review_images WHERE review_images.review_id = reviews.id)")
+	}
+}
+
+// orderedReviewImages is a preload scope that orders the review images by position.
+//
+// db: The database query.
+//
+// Returns the database query.
+func orderedReviewImages(db *gorm.DB) *gorm.DB {
+	return db.Order("position asc, id asc")
+}
+
+// reviewSortColumns is the review columns sorted by each of the sort orders,
+// the newest reviews are sorted by the id.
+var reviewSortColumns = map[string]string{
+	"highest_rating": "reviews.rating",
+	"lowest_rating":  "reviews.rating",
+	"most_helpful":   "reviews.helpful_count",
+}
+
+// sortReviews is a helper function that returns the scope to sort and paginate the reviews,
+// the reviews with the same sorted value are sorted from the newest.
+//
+// sort: The sort order of the reviews.
+// page: The page of the reviews.
+//
+// Returns the scope function.
+func sortReviews(sort string, page *types.Page) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		// Get the sorted column
+		column, ok := reviewSortColumns[sort]
+
+		// Paginate the newest reviews by the id
+		if !ok {
+			return paginate(page, "reviews.id")(db)
+		}
+
+		// Get the sort direction
+		direction, operator := "ASC", ">"
+
+		if constants.REVIEW_SORT_ORDERS[sort] {
+			direction, operator = "DESC", "<"
+		}
+
+		// Start the page after the cursor
+		if page.Cursor != nil {
+			db = db.Where(fmt.Sprintf("(%s %s ? OR (%s = ? AND reviews.id < ?))", column, operator, column), page.Cursor.Value, page.Cursor.Value, page.Cursor.ID)
+		}
+
+		return db.Order(fmt.Sprintf("%s %s", column, direction)).Order("reviews.id DESC").Limit(page.Limit + 1)
+	}
+}
+
+// reviewsNextCursor is a helper function that removes the extra review taken by the sort scope
+// and returns the cursor of the next page with the sorted value of the last review.
+//
+// reviews: The reviews of the page.
+// sort: The sort order of the reviews.
+// page: The page of the reviews.
+//
+// Returns the next cursor, nil on the last page.
+func reviewsNextCursor(reviews *[]models.Review, sort string, page *types.Page) *types.Cursor {
+	// Get the next cursor
+	next := nextCursor(reviews, page, func(review *models.Review) uint { return review.ID })
+
+	// Return nil if there is no next page
+	if next == nil {
+		return nil
+	}
+
+	// Get the last review
+	last := (*reviews)[len(*reviews)-1]
+
+	// Get the sorted value of the last review
+	values := map[string]any{
+		"highest_rating": last.Rating,
+		"lowest_rating":  last.Rating,
+		"most_helpful":   last.HelpfulCount,
+	}
+
+	next.Value = values[sort]
+
+	return next
+}
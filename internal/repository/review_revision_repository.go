@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+)
+
+// ReviewRevisionRepository is a struct that defines the review revision repository.
+type ReviewRevisionRepository struct{}
+
+// NewReviewRevisionRepository is a factory function that returns a new instance of the review revision repository.
+//
+// Returns a new instance of the review revision repository.
+func NewReviewRevisionRepository() *ReviewRevisionRepository {
+	return &ReviewRevisionRepository{}
+}
+
+// GetUsingReviewID is a function that returns the revisions of the review, newest first.
+//
+// reviewID: The review ID.
+//
+// Returns the review revisions and an error if any.
+func (*ReviewRevisionRepository) GetUsingReviewID(reviewID uint) (*[]models.ReviewRevision, error) {
+	// Create review revisions array
+	var revisions []models.ReviewRevision
+
+	// Get the review revisions
+	err := mysql.Conn.Where("review_id = ?", reviewID).Order("id desc").Find(&revisions).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting review revisions using review id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &revisions, nil
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"log"
+	"main/core/enums"
+	"main/data/models"
+	"main/internal/providers/mysql"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// reviewSummaryStarColumns is the review summary column counting the reviews of each rating.
+var reviewSummaryStarColumns = map[int8]string{
+	1: "one_star",
+	2: "two_star",
+	3: "three_star",
+	4: "four_star",
+	5: "five_star",
+}
+
+// reviewSummaryStarCounts is the select columns of the star counts summed from the review summaries.
+const reviewSummaryStarCounts = `COALESCE(SUM(one_star), 0) AS one_star, COALESCE(SUM(two_star), 0) AS two_star,
+	COALESCE(SUM(three_star), 0) AS three_star, COALESCE(SUM(four_star), 0) AS four_star,
+	COALESCE(SUM(five_star), 0) AS five_star`
+
+// reviewSummaryAvgRating is the select column of the average rating rounded to one decimal
+// from the review summaries, 0 when there is no review.
+const reviewSummaryAvgRating = "COALESCE(ROUND(SUM(rating_sum) / NULLIF(SUM(review_count), 0), 1), 0)"
+
+// ReviewSummaryRepository is a struct that defines the review summary repository.
+type ReviewSummaryRepository struct{}
+
+// NewReviewSummaryRepository is a factory function that returns a new instance of the review summary repository.
+//
+// Returns a new instance of the review summary repository.
+func NewReviewSummaryRepository() *ReviewSummaryRepository {
+	return &ReviewSummaryRepository{}
+}
+
+// Rebuild is a function that recomputes every review summary from the published reviews.
+//
+// Returns the number of the review summaries and an error if any.
+func (*ReviewSummaryRepository) Rebuild() (int64, error) {
+	// count is the number of the review summaries
+	var count int64
+
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Delete the review summaries
+		if err := tx.Where("1 = 1").Delete(&models.ReviewSummary{}).Error; err != nil {
+			return err
+		}
+
+		// Query to get the summary of the published reviews of each vendor and court type
+		summariesQuery := tx.Model(&models.Review{}).Select(`vendor_id, court_type_id, COUNT(id), SUM(rating),
+			COUNT(CASE WHEN rating = 1 THEN 1 END), COUNT(CASE WHEN rating = 2 THEN 1 END),
+			COUNT(CASE WHEN rating = 3 THEN 1 END), COUNT(CASE WHEN rating = 4 THEN 1 END),
+			COUNT(CASE WHEN rating = 5 THEN 1 END), NOW()`).
+			Scopes(publishedReviews).Group("vendor_id, court_type_id")
+
+		// Create the review summaries
+		result := tx.Exec(`INSERT INTO review_summaries (vendor_id, court_type_id, review_count, rating_sum,
+			one_star, two_star, three_star, four_star, five_star, updated_at) ?`, summariesQuery)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		count = result.RowsAffected
+
+		return nil
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error rebuilding review summaries: " + err.Error())
+
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// reviewSummaryOfCourtType is a helper function that returns the scope to get the review
+// summaries of the court type.
+//
+// courtType: The court type.
+//
+// Returns the scope function.
+func reviewSummaryOfCourtType(courtType string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Joins("JOIN court_types ON court_types.id = review_summaries.court_type_id").Where("court_types.type = ?", courtType)
+	}
+}
+
+// updateReviewWithSummary is a helper function that updates the review and moves it between
+// the review summaries in the transaction, the summaries only count the published reviews.
+//
+// tx: The database transaction.
+// reviewID: The review ID.
+// update: The function that updates the review.
+//
+// Returns an error if any.
+func updateReviewWithSummary(tx *gorm.DB, reviewID uint, update func(tx *gorm.DB) error) error {
+	// Get the summarized review before the update
+	before, err := getSummarizedReview(tx, reviewID)
+
+	if err != nil {
+		return err
+	}
+
+	// Update the review
+	if err := update(tx); err != nil {
+		return err
+	}
+
+	// Get the summarized review after the update
+	after, err := getSummarizedReview(tx, reviewID)
+
+	if err != nil {
+		return err
+	}
+
+	// Keep the review summary if the summarized review is unchanged
+	if before != nil && after != nil && before.Rating == after.Rating {
+		return nil
+	}
+
+	// Remove the review from the review summary
+	if before != nil {
+		if err := applyReviewSummary(tx, before, -1); err != nil {
+			return err
+		}
+	}
+
+	// Add the review to the review summary
+	if after != nil {
+		return applyReviewSummary(tx, after, 1)
+	}
+
+	return nil
+}
+
+// getSummarizedReview is a helper function that locks the review and returns it
+// if it's counted in the review summary.
+//
+// tx: The database transaction.
+// reviewID: The review ID.
+//
+// Returns the review, nil if it's not published or deleted, and an error if any.
+func getSummarizedReview(tx *gorm.DB, reviewID uint) (*models.Review, error) {
+	// Create a new review object
+	var review models.Review
+
+	// Get the review
+	err := tx.Unscoped().Clauses(clause.Locking{Strength: "UPDATE"}).
+		Select("id, vendor_id, court_type_id, rating, status, deleted_at").
+		First(&review, "id = ?", reviewID).Error
+
+	// Return nil if the review is not found
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Return nil if the review is not counted
+	if review.DeletedAt.Valid || review.Status != enums.ReviewPublished.Label() {
+		return nil, nil
+	}
+
+	return &review, nil
+}
+
+// applyReviewSummary is a helper function that adds the review to its review summary
+// or removes it, the review summary is created if it doesn't exist.
+//
+// tx: The database transaction.
+// review: The review.
+// delta: 1 to add the review, -1 to remove it.
+//
+// Returns an error if any.
+func applyReviewSummary(tx *gorm.DB, review *models.Review, delta int64) error {
+	// Get the star column of the rating
+	star := reviewSummaryStarColumns[review.Rating]
+
+	// Get the update time
+	now := time.Now()
+
+	return tx.Model(&models.ReviewSummary{}).Clauses(clause.OnConflict{
+		DoUpdates: clause.Assignments(map[string]any{
+			"review_count": gorm.Expr("review_count + ?", delta),
+			"rating_sum":   gorm.Expr("rating_sum + ?", delta*int64(review.Rating)),
+			star:           gorm.Expr(star+" + ?", delta),
+			"updated_at":   now,
+		}),
+	}).Create(map[string]any{
+		"vendor_id":     review.VendorID,
+		"court_type_id": review.CourtTypeID,
+		"review_count":  delta,
+		"rating_sum":    delta * int64(review.Rating),
+		star:            delta,
+		"updated_at":    now,
+	}).Error
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ReviewVoteRepository is a struct that defines the review vote repository.
+type ReviewVoteRepository struct{}
+
+// NewReviewVoteRepository is a factory function that returns a new instance of the review vote repository.
+//
+// Returns a new instance of the review vote repository.
+func NewReviewVoteRepository() *ReviewVoteRepository {
+	return &ReviewVoteRepository{}
+}
+
+// Create is a function that creates a new review vote and increases the helpful count
+// of the review, nothing is created when the user has already voted the review.
+//
+// vote: The review vote object.
+//
+// Returns whether the review vote was created and an error if any.
+func (*ReviewVoteRepository) Create(vote *models.ReviewVote) (bool, error) {
+	// created is whether the review vote was created
+	var created bool
+
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Create the review vote if the user hasn't voted the review
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(vote)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		created = result.RowsAffected > 0
+
+		// Skip the helpful count if the user has already voted the review
+		if !created {
+			return nil
+		}
+
+		return updateReviewHelpfulCount(tx, vote.ReviewID, 1)
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating review vote: " + err.Error())
+
+		return false, err
+	}
+
+	return created, nil
+}
+
+// DeleteUsingReviewIDUserID is a function that deletes the review vote of the user
+// and decreases the helpful count of the review.
+//
+// reviewID: The review ID.
+// userID: The user ID.
+//
+// Returns whether the review vote was deleted and an error if any.
+func (*ReviewVoteRepository) DeleteUsingReviewIDUserID(reviewID uint, userID uint) (bool, error) {
+	// deleted is whether the review vote was deleted
+	var deleted bool
+
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Delete the review vote
+		result := tx.Delete(&models.ReviewVote{}, "review_id = ? AND user_id = ?", reviewID, userID)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		deleted = result.RowsAffected > 0
+
+		// Skip the helpful count if the user hasn't voted the review
+		if !deleted {
+			return nil
+		}
+
+		return updateReviewHelpfulCount(tx, reviewID, -1)
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error deleting review vote: " + err.Error())
+
+		return false, err
+	}
+
+	return deleted, nil
+}
+
+// updateReviewHelpfulCount is a helper function that changes the helpful count of the review.
+//
+// tx: The database transaction.
+// reviewID: The review ID.
+// delta: The change of the helpful count.
+//
+// Returns an error if any.
+func updateReviewHelpfulCount(tx *gorm.DB, reviewID uint, delta int) error {
+	return tx.Model(&models.Review{}).Unscoped().Where("id = ?", reviewID).UpdateColumn("helpful_count", gorm.Expr("helpful_count + ?", delta)).Error
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+
+	"gorm.io/gorm/clause"
+)
+
+// SpecialDayRepository is a struct that defines the special day repository.
+type SpecialDayRepository struct{}
+
+// NewSpecialDayRepository is a factory function that returns a new instance of the special day repository.
+//
+// Returns a new instance of the special day repository.
+func NewSpecialDayRepository() *SpecialDayRepository {
+	return &SpecialDayRepository{}
+}
+
+// GetUsingVendorIDDateRange is a function that returns the special days of the vendor
+// between two dates ordered by the date.
+//
+// vendorID: The vendor ID.
+// from: The first date, formatted as YYYY-MM-DD.
+// to: The last date, formatted as YYYY-MM-DD.
+//
+// Returns the special days and an error if any.
+func (*SpecialDayRepository) GetUsingVendorIDDateRange(vendorID uint, from string, to string) (*[]models.SpecialDay, error) {
+	// Create special days array
+	var days []models.SpecialDay
+
+	// Get the special days
+	err := mysql.Conn.Where("vendor_id = ?", vendorID).Where("date BETWEEN ? AND ?", from, to).Order("date asc").Find(&days).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting special days using vendor id and date range: " + err.Error())
+
+		return nil, err
+	}
+
+	return &days, nil
+}
+
+// Save is a function that creates the special day or replaces the special day
+// of the vendor on the same date.
+//
+// day: The special day object.
+//
+// Returns an error if any.
+func (*SpecialDayRepository) Save(day *models.SpecialDay) error {
+	// Create or update the special day
+	err := mysql.Conn.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "vendor_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"is_closed", "open_time", "close_time", "note", "updated_at"}),
+	}).Create(day).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error saving special day: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUsingVendorIDDate is a function that deletes the special day of the vendor on a date.
+//
+// vendorID: The vendor ID.
+// date: The date, formatted as YYYY-MM-DD.
+//
+// Returns the number of deleted special days and an error if any.
+func (*SpecialDayRepository) DeleteUsingVendorIDDate(vendorID uint, date string) (int64, error) {
+	// Delete the special day
+	res := mysql.Conn.Where("vendor_id = ?", vendorID).Where("date = ?", date).Delete(&models.SpecialDay{})
+
+	// Return an error if any
+	if res.Error != nil {
+		log.Println("Error deleting special day: " + res.Error.Error())
+
+		return 0, res.Error
+	}
+
+	return res.RowsAffected, nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"log"
+	"main/core/types"
+	"main/data/models"
+	"main/internal/providers/mysql"
+)
+
+// StaffActivityRepository is a struct that defines the staff activity repository.
+type StaffActivityRepository struct{}
+
+// NewStaffActivityRepository is a factory function that returns a new instance of the staff activity repository.
+//
+// Returns a new instance of the staff activity repository.
+func NewStaffActivityRepository() *StaffActivityRepository {
+	return &StaffActivityRepository{}
+}
+
+// Create is a function that records a new staff activity.
+//
+// activity: The staff activity object.
+//
+// Returns an error if any.
+func (*StaffActivityRepository) Create(activity *models.StaffActivity) error {
+	// Create a new staff activity
+	err := mysql.Conn.Create(activity).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating staff activity: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetUsingVendorID is a function that returns a page of the staff activities of the vendor.
+//
+// vendorID: The vendor ID.
+// page: The page of the staff activities.
+//
+// Returns the staff activities, the next cursor or nil on the last page, and an error if any.
+func (*StaffActivityRepository) GetUsingVendorID(vendorID uint, page *types.Page) (*[]models.StaffActivity, *types.Cursor, error) {
+	// Create a new staff activities slice
+	var activities []models.StaffActivity
+
+	// Get the staff activities by vendor ID
+	err := mysql.Conn.Where("vendor_id = ?", vendorID).
+		Scopes(paginate(page, "staff_activities.id")).
+		Find(&activities).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting staff activities using vendor id: " + err.Error())
+
+		return nil, nil, err
+	}
+
+	// Get the next cursor
+	next := nextCursor(&activities, page, func(activity *models.StaffActivity) uint { return activity.ID })
+
+	return &activities, next, nil
+}
@@ -2,8 +2,10 @@ package repository
 
 import (
 	"log"
+	"main/core/types"
 	"main/data/models"
 	"main/internal/providers/mysql"
+	"time"
 )
 
 // UserRepository is a struct that defines the user repository.
@@ -181,6 +183,28 @@ func (*UserRepository) UpdateUsername(userID uint, newUsername string) error {
 	return nil
 }
 
+// IsProfilePictureUsed is a function that checks if a profile picture file is used by any user.
+//
+// fileName: The profile picture file name.
+//
+// Returns a boolean indicates the profile picture is used and an error if any.
+func (*UserRepository) IsProfilePictureUsed(fileName string) (bool, error) {
+	// Create a counter variable
+	var count int64
+
+	// Check if the profile picture is used
+	err := mysql.Conn.Model(&models.User{}).Where("profile_picture = ?", fileName).Limit(1).Count(&count).Error
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Failed to check if profile picture is used: " + err.Error())
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
 // UpdateProfilePicture is a function that updates a user's profile picture.
 //
 // userID: The user ID.
@@ -200,3 +224,58 @@ func (*UserRepository) UpdateProfilePicture(userID uint, newFileName string) err
 
 	return nil
 }
+
+// Get is a function that returns a page of the users, the users are
+// filtered by the username when the search is given.
+//
+// search: The username search, empty for every user.
+// page: The page of the users.
+//
+// Returns the users, the next cursor or nil on the last page, and an error if any.
+func (*UserRepository) Get(search string, page *types.Page) (*[]models.User, *types.Cursor, error) {
+	// Create a new users slice
+	var users []models.User
+
+	// Create the users query
+	query := mysql.Conn.Model(&models.User{})
+
+	// Filter the username if any
+	if search != "" {
+		query = query.Where("username LIKE ?", "%"+search+"%")
+	}
+
+	// Get the users
+	err := query.Scopes(paginate(page, "users.id")).Find(&users).Error
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Failed to get users: " + err.Error())
+
+		return nil, nil, err
+	}
+
+	// Get the next cursor
+	next := nextCursor(&users, page, func(user *models.User) uint { return user.ID })
+
+	return &users, next, nil
+}
+
+// UpdateDisabledAt is a function that disables or enables a user.
+//
+// userID: The user ID.
+// disabledAt: The time when the user is disabled, nil to enable the user.
+//
+// Returns an error if any.
+func (*UserRepository) UpdateDisabledAt(userID uint, disabledAt *time.Time) error {
+	// Update the user's disabled time
+	err := mysql.Conn.Model(&models.User{}).Where("id = ?", userID).Update("disabled_at", disabledAt).Error
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Failed to update user's disabled time: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// VendorEmailChangeRepository is a struct that defines the vendor email change repository.
+type VendorEmailChangeRepository struct{}
+
+// NewVendorEmailChangeRepository is a factory function that returns a new instance of the vendor email change repository.
+//
+// Returns a new instance of the vendor email change repository.
+func NewVendorEmailChangeRepository() *VendorEmailChangeRepository {
+	return &VendorEmailChangeRepository{}
+}
+
+// Save is a function that creates the email change or replaces the pending
+// email change of the vendor.
+//
+// change: The email change object.
+//
+// Returns an error if any.
+func (*VendorEmailChangeRepository) Save(change *models.VendorEmailChange) error {
+	// Create or update the email change
+	err := mysql.Conn.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "vendor_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"email", "token_hash", "expires_at", "created_at"}),
+	}).Create(change).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error saving vendor email change: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetUsingTokenHash is a function that returns the email change by the verification token hash.
+//
+// tokenHash: The SHA-256 hash of the verification token.
+//
+// Returns the email change and an error if any.
+func (*VendorEmailChangeRepository) GetUsingTokenHash(tokenHash string) (*models.VendorEmailChange, error) {
+	// Create a new email change object
+	var change models.VendorEmailChange
+
+	// Get the email change by the token hash
+	err := mysql.Conn.First(&change, "token_hash = ?", tokenHash).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting vendor email change using token hash: " + err.Error())
+
+		return nil, err
+	}
+
+	return &change, nil
+}
+
+// Apply is a function that updates the vendor email to the new email and
+// removes the email change.
+//
+// change: The email change object.
+//
+// Returns an error if any.
+func (*VendorEmailChangeRepository) Apply(change *models.VendorEmailChange) error {
+	err := mysql.Conn.Transaction(func(tx *gorm.DB) error {
+		// Update the vendor email
+		err := tx.Model(&models.Vendor{}).Where("id = ?", change.VendorID).Update("email", change.Email).Error
+
+		// Return an error if any
+		if err != nil {
+			return err
+		}
+
+		return tx.Delete(&models.VendorEmailChange{}, change.ID).Error
+	})
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error applying vendor email change: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// DeleteUsingVendorID is a function that deletes the pending email change of the vendor.
+//
+// vendorID: The vendor ID.
+//
+// Returns an error if any.
+func (*VendorEmailChangeRepository) DeleteUsingVendorID(vendorID uint) error {
+	// Delete the email change
+	err := mysql.Conn.Where("vendor_id = ?", vendorID).Delete(&models.VendorEmailChange{}).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error deleting vendor email change: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
@@ -2,8 +2,12 @@ package repository
 
 import (
 	"log"
+	"main/core/types"
 	"main/data/models"
 	"main/internal/providers/mysql"
+	"time"
+
+	"gorm.io/gorm"
 )
 
 // VendorRepository is a struct that defines the vendor repository.
@@ -45,7 +49,7 @@ func (*VendorRepository) GetUsingID(vendorID uint) (*models.Vendor, error) {
 	var vendor models.Vendor
 
 	// Get the vendor by ID
-	err := mysql.Conn.First(&vendor, "id = ?", vendorID).Error
+	err := mysql.Conn.Preload("GalleryImages", venueGalleryImages).First(&vendor, "id = ?", vendorID).Error
 
 	// Check if there is an error
 	if err != nil {
@@ -57,6 +61,52 @@ func (*VendorRepository) GetUsingID(vendorID uint) (*models.Vendor, error) {
 	return &vendor, err
 }
 
+// GetCurrentUsingID is a function that returns a vendor by ID along with its
+// unexpired pending email change.
+//
+// vendorID: The vendor ID.
+//
+// Returns the vendor object and an error if any.
+func (*VendorRepository) GetCurrentUsingID(vendorID uint) (*models.Vendor, error) {
+	// Create a new vendor object
+	var vendor models.Vendor
+
+	// Get the vendor by ID
+	err := mysql.Conn.Preload("GalleryImages", venueGalleryImages).Preload("EmailChange", "expires_at > ?", time.Now()).First(&vendor, "id = ?", vendorID).Error
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Failed to get current vendor using id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &vendor, nil
+}
+
+// GetStatusUsingID is a function that returns the approval status of a vendor by ID,
+// only the status columns of the vendor are loaded.
+//
+// vendorID: The vendor ID.
+//
+// Returns the vendor object and an error if any.
+func (*VendorRepository) GetStatusUsingID(vendorID uint) (*models.Vendor, error) {
+	// Create a new vendor object
+	var vendor models.Vendor
+
+	// Get the vendor status by ID
+	err := mysql.Conn.Select("id", "status", "rejection_reason").First(&vendor, "id = ?", vendorID).Error
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Failed to get vendor status using id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &vendor, nil
+}
+
 // IsEmailTaken is a function that checks if an email is taken.
 //
 // email: The email.
@@ -79,6 +129,28 @@ func (*VendorRepository) IsEmailTaken(email string) (bool, error) {
 	return count > 0, nil
 }
 
+// IsNameTaken is a function that checks if a vendor name is taken.
+//
+// name: The vendor name.
+//
+// Returns a boolean and an error if any.
+func (*VendorRepository) IsNameTaken(name string) (bool, error) {
+	// Create a counter variable
+	var count int64
+
+	// Check if the name is taken
+	err := mysql.Conn.Model(&models.Vendor{}).Where("name = ?", name).Limit(1).Count(&count).Error
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Failed to check if vendor name is taken: " + err.Error())
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
 // GetUsingEmail is a function that returns a vendor by email.
 //
 // email: The email.
@@ -101,6 +173,50 @@ func (*VendorRepository) GetUsingEmail(email string) (*models.Vendor, error) {
 	return &vendor, err
 }
 
+// UpdateLocation is a function that updates a vendor's location.
+//
+// vendorID: The vendor ID.
+// latitude: The latitude of the location.
+// longitude: The longitude of the location.
+//
+// Returns an error if any.
+func (*VendorRepository) UpdateLocation(vendorID uint, latitude float64, longitude float64) error {
+	// Update the vendor's location
+	err := mysql.Conn.Model(&models.Vendor{}).Where("id = ?", vendorID).Updates(map[string]any{
+		"latitude":  latitude,
+		"longitude": longitude,
+	}).Error
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Failed to update vendor location: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// UpdateProfile is a function that updates a vendor's profile fields.
+//
+// vendorID: The vendor ID.
+// fields: The profile columns mapped to their new values.
+//
+// Returns an error if any.
+func (*VendorRepository) UpdateProfile(vendorID uint, fields map[string]any) error {
+	// Update the vendor's profile
+	err := mysql.Conn.Model(&models.Vendor{}).Where("id = ?", vendorID).Updates(fields).Error
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Failed to update vendor profile: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
 // UpdatePassword is a function that updates a vendor's password.
 //
 // vendorID: The vendor ID.
@@ -120,3 +236,93 @@ func (*VendorRepository) UpdatePassword(vendorID uint, hashedNewPassword string)
 
 	return nil
 }
+
+// orderedVendorDocuments is a preload scope that gets the vendor documents
+// ordered by type.
+//
+// db: The database query.
+//
+// Returns the database query.
+func orderedVendorDocuments(db *gorm.DB) *gorm.DB {
+	return db.Order("type ASC")
+}
+
+// GetApplicationUsingID is a function that returns a vendor by ID along with
+// its registration documents and payout bank account.
+//
+// vendorID: The vendor ID.
+//
+// Returns the vendor object and an error if any.
+func (*VendorRepository) GetApplicationUsingID(vendorID uint) (*models.Vendor, error) {
+	// Create a new vendor object
+	var vendor models.Vendor
+
+	// Get the vendor by ID
+	err := mysql.Conn.Preload("Documents", orderedVendorDocuments).Preload("BankAccount").First(&vendor, "id = ?", vendorID).Error
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Failed to get vendor application using id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &vendor, nil
+}
+
+// GetApplicationsUsingStatus is a function that returns a page of the vendors with the status
+// along with their registration documents and payout bank account.
+//
+// status: The vendor status.
+// page: The page of the vendors.
+//
+// Returns the vendors, the next cursor or nil on the last page, and an error if any.
+func (*VendorRepository) GetApplicationsUsingStatus(status string, page *types.Page) (*[]models.Vendor, *types.Cursor, error) {
+	// Create a new vendors slice
+	var vendors []models.Vendor
+
+	// Get the vendors by status
+	err := mysql.Conn.Preload("Documents", orderedVendorDocuments).Preload("BankAccount").
+		Where("status = ?", status).
+		Scopes(paginate(page, "vendors.id")).
+		Find(&vendors).Error
+
+	// Check if there is an error
+	if err != nil {
+		log.Println("Failed to get vendor applications using status: " + err.Error())
+
+		return nil, nil, err
+	}
+
+	// Get the next cursor
+	next := nextCursor(&vendors, page, func(vendor *models.Vendor) uint { return vendor.ID })
+
+	return &vendors, next, nil
+}
+
+// UpdateStatus is a function that updates a vendor's approval status, the status
+// is only updated when the current status is one of the given statuses.
+//
+// vendorID: The vendor ID.
+// fromStatuses: The statuses the vendor can be updated from.
+// status: The new status.
+// reason: The rejection or suspension reason, empty otherwise.
+//
+// Returns whether the vendor was updated and an error if any.
+func (*VendorRepository) UpdateStatus(vendorID uint, fromStatuses []string, status string, reason string) (bool, error) {
+	// Update the vendor's status
+	result := mysql.Conn.Model(&models.Vendor{}).Where("id = ? AND status IN ?", vendorID, fromStatuses).Updates(map[string]any{
+		"status":           status,
+		"rejection_reason": reason,
+		"reviewed_at":      time.Now(),
+	})
+
+	// Check if there is an error
+	if result.Error != nil {
+		log.Println("Failed to update vendor status: " + result.Error.Error())
+
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"log"
+	"main/data/models"
+	"main/internal/providers/mysql"
+)
+
+// VendorStaffRepository is a struct that defines the vendor staff repository.
+type VendorStaffRepository struct{}
+
+// NewVendorStaffRepository is a factory function that returns a new instance of the vendor staff repository.
+//
+// Returns a new instance of the vendor staff repository.
+func NewVendorStaffRepository() *VendorStaffRepository {
+	return &VendorStaffRepository{}
+}
+
+// Create is a function that creates a new vendor staff.
+//
+// staff: The vendor staff object.
+//
+// Returns an error if any.
+func (*VendorStaffRepository) Create(staff *models.VendorStaff) error {
+	// Create a new vendor staff
+	err := mysql.Conn.Create(staff).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error creating vendor staff: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// GetUsingID is a function that returns a vendor staff of the vendor by ID.
+//
+// vendorID: The vendor ID.
+// staffID: The vendor staff ID.
+//
+// Returns the vendor staff object and an error if any.
+func (*VendorStaffRepository) GetUsingID(vendorID uint, staffID uint) (*models.VendorStaff, error) {
+	// Create a new vendor staff object
+	var staff models.VendorStaff
+
+	// Get the vendor staff by ID
+	err := mysql.Conn.First(&staff, "id = ? AND vendor_id = ?", staffID, vendorID).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting vendor staff using id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &staff, nil
+}
+
+// GetUsingEmail is a function that returns a vendor staff by email along with its vendor.
+//
+// email: The vendor staff email.
+//
+// Returns the vendor staff object and an error if any.
+func (*VendorStaffRepository) GetUsingEmail(email string) (*models.VendorStaff, error) {
+	// Create a new vendor staff object
+	var staff models.VendorStaff
+
+	// Get the vendor staff by email
+	err := mysql.Conn.Preload("Vendor").First(&staff, "email = ?", email).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting vendor staff using email: " + err.Error())
+
+		return nil, err
+	}
+
+	return &staff, nil
+}
+
+// GetUsingVendorID is a function that returns the staff of the vendor.
+//
+// vendorID: The vendor ID.
+//
+// Returns the vendor staff and an error if any.
+func (*VendorStaffRepository) GetUsingVendorID(vendorID uint) (*[]models.VendorStaff, error) {
+	// Create a new vendor staff slice
+	var staff []models.VendorStaff
+
+	// Get the vendor staff by vendor ID
+	err := mysql.Conn.Where("vendor_id = ?", vendorID).Order("id ASC").Find(&staff).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error getting vendor staff using vendor id: " + err.Error())
+
+		return nil, err
+	}
+
+	return &staff, nil
+}
+
+// IsEmailTaken is a function that checks if a vendor staff email is taken.
+//
+// email: The vendor staff email.
+//
+// Returns true if the email is taken, false otherwise, and an error if any.
+func (*VendorStaffRepository) IsEmailTaken(email string) (bool, error) {
+	// Create a new count variable
+	var count int64
+
+	// Count the vendor staff with the email
+	err := mysql.Conn.Model(&models.VendorStaff{}).Where("email = ?", email).Limit(1).Count(&count).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error checking if vendor staff email is taken: " + err.Error())
+
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Update is a function that updates the vendor staff fields.
+//
+// vendorID: The vendor ID.
+// staffID: The vendor staff ID.
+// fields: The updated fields.
+//
+// Returns an error if any.
+func (*VendorStaffRepository) Update(vendorID uint, staffID uint, fields map[string]any) error {
+	// Update the vendor staff
+	err := mysql.Conn.Model(&models.VendorStaff{}).Where("id = ? AND vendor_id = ?", staffID, vendorID).Updates(fields).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error updating vendor staff: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
+
+// Delete is a function that deletes a vendor staff of the vendor.
+//
+// vendorID: The vendor ID.
+// staffID: The vendor staff ID.
+//
+// Returns an error if any.
+func (*VendorStaffRepository) Delete(vendorID uint, staffID uint) error {
+	// Delete the vendor staff
+	err := mysql.Conn.Where("id = ? AND vendor_id = ?", staffID, vendorID).Delete(&models.VendorStaff{}).Error
+
+	// Return an error if any
+	if err != nil {
+		log.Println("Error deleting vendor staff: " + err.Error())
+
+		return err
+	}
+
+	return nil
+}
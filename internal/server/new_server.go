@@ -1,18 +1,24 @@
 package server
 
 import (
+	"context"
 	"main/core/config"
 	"main/core/constants"
+	"main/core/enums"
 	"main/delivery/http/router"
 	"main/internal/initializer"
+	"os"
+	"os/signal"
 	"strconv"
+	"syscall"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 )
 
 // NewServer is a factory function that returns a new instance of the echo.Echo server
-// with the given configuration.
+// with the given configuration, it runs the server until it's interrupted or terminated
+// and then shuts it down gracefully.
 //
 // Returns the echo.Echo server instance and an error if any.
 func NewServer() (*echo.Echo, error) {
@@ -34,12 +40,10 @@ func NewServer() (*echo.Echo, error) {
 	e.Use(middleware.Logger())
 	e.Use(middleware.CORS())
 
-	// Register static files
-	e.Static(router.UserProfiles, constants.PATH_TO_USER_PROFILE_PICTURES)
-
-	e.Static(router.CourtImages, constants.PATH_TO_COURT_IMAGES)
-
-	e.Static(router.Advertisements, constants.PATH_TO_ADVERTISEMENTS)
+	// Register static files of the local storage
+	if config.StorageConfig.Driver == "local" {
+		e.Static(router.Static, config.StorageConfig.LocalDir)
+	}
 
 	// Register prefix endpoint
 	prefix := e.Group("/api/v1")
@@ -48,6 +52,8 @@ func NewServer() (*echo.Echo, error) {
 	// Auth endpoints
 	authPrefix := prefix.Group("/auth")
 
+	authPrefix.POST("/refresh", c.LoginController.RefreshToken)
+
 	// User Auth endpoints
 	userAuthPrefix := authPrefix.Group("/user")
 
@@ -59,10 +65,19 @@ func NewServer() (*echo.Echo, error) {
 	// Vendor Auth endpoints
 	vendorAuthPrefix := authPrefix.Group("/vendor")
 
+	vendorAuthPrefix.POST("/register", c.RegisterController.VendorRegister, m.UploadMiddleware.DocumentsLimit)
 	vendorAuthPrefix.POST("/login", c.LoginController.VendorLogin)
+	vendorAuthPrefix.POST("/staff/login", c.LoginController.VendorStaffLogin)
+	vendorAuthPrefix.POST("/verify-email", c.VendorController.VerifyVendorEmail)
 	vendorAuthPrefix.POST("/verify-password", c.VerifyPasswordController.VendorVerifyPassword, m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield)
 	vendorAuthPrefix.POST("/logout", c.LogoutController.VendorLogout, m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield, m.VendorMiddleware.Shield)
 
+	// Admin Auth endpoints
+	adminAuthPrefix := authPrefix.Group("/admin")
+
+	adminAuthPrefix.POST("/login", c.LoginController.AdminLogin)
+	adminAuthPrefix.POST("/logout", c.LogoutController.AdminLogout, m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield, m.AdminMiddleware.Shield)
+
 	// Users endpoints
 	userPrefix := prefix.Group("/users")
 
@@ -75,16 +90,64 @@ func NewServer() (*echo.Echo, error) {
 
 	currentUserPrefix.PATCH("/password", c.UserController.UpdateCurrentUserPassword)
 
-	currentUserPrefix.PATCH("/profile-picture", c.UserController.UpdateCurrentUserProfilePicture)
+	currentUserPrefix.PATCH("/profile-picture", c.UserController.UpdateCurrentUserProfilePicture, m.UploadMiddleware.Limit)
+
+	// Current user reviews endpoints
+	currentUserReviewsPrefix := currentUserPrefix.Group("/reviews")
+
+	currentUserReviewsPrefix.GET("/pending", c.ReviewController.GetCurrentUserBookingsAwaitingReview)
+
+	currentUserReviewsPrefix.PATCH("/:id", c.ReviewController.UpdateCurrentUserReview)
+
+	currentUserReviewsPrefix.DELETE("/:id", c.ReviewController.DeleteCurrentUserReview)
+
+	// Current user notifications endpoints
+	currentUserNotificationsPrefix := currentUserPrefix.Group("/notifications")
+
+	currentUserNotificationsPrefix.GET("", c.NotificationController.GetCurrentUserNotifications)
+
+	currentUserNotificationsPrefix.POST("/read", c.NotificationController.ReadAllNotifications)
+
+	currentUserNotificationsPrefix.POST("/:id/read", c.NotificationController.ReadNotification)
 
 	// Vendors endpoints
 	vendorPrefix := prefix.Group("/vendors")
 
+	vendorPrefix.GET("/:id", c.VendorController.GetVendor)
+
 	// Current vendor endpoints
-	currentVendorPrefix := vendorPrefix.Group("/me", m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield, m.VendorMiddleware.Shield)
+	currentVendorPrefix := vendorPrefix.Group("/me", m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield, m.VendorMiddleware.Shield, m.StaffMiddleware.Shield)
 
 	currentVendorPrefix.GET("", c.VendorController.GetCurrentVendor)
-	currentVendorPrefix.PATCH("/password", c.VendorController.UpdateCurrentVendorPassword)
+	currentVendorPrefix.PATCH("", c.VendorController.UpdateCurrentVendorProfile, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+	currentVendorPrefix.PATCH("/password", c.VendorController.UpdateCurrentVendorPassword, m.StaffMiddleware.Require(enums.PermissionManageAccount))
+	currentVendorPrefix.PATCH("/location", c.VendorController.UpdateCurrentVendorLocation, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+
+	// Current vendor schedule endpoints
+	currentVendorSchedulePrefix := currentVendorPrefix.Group("/schedule")
+
+	currentVendorSchedulePrefix.GET("", c.ScheduleController.GetCurrentVendorSchedule)
+	currentVendorSchedulePrefix.PUT("/opening-hours", c.ScheduleController.UpdateCurrentVendorOpeningHours, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+	currentVendorSchedulePrefix.PUT("/special-days", c.ScheduleController.SaveCurrentVendorSpecialDay, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+	currentVendorSchedulePrefix.DELETE("/special-days/:date", c.ScheduleController.DeleteCurrentVendorSpecialDay, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+
+	// Current vendor gallery images endpoints
+	currentVendorImagesPrefix := currentVendorPrefix.Group("/images")
+
+	currentVendorImagesPrefix.GET("", c.GalleryController.GetCurrentVendorGalleryImages)
+
+	currentVendorImagesPrefix.POST("", c.GalleryController.CreateGalleryImage, m.StaffMiddleware.Require(enums.PermissionManageVenue), m.UploadMiddleware.Limit)
+
+	currentVendorImagesPrefix.PUT("/order", c.GalleryController.ReorderGalleryImages, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+
+	currentVendorImagesPrefix.PATCH("/:id", c.GalleryController.UpdateGalleryImage, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+
+	currentVendorImagesPrefix.DELETE("/:id", c.GalleryController.DeleteGalleryImage, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+
+	// Current vendor advertisements endpoints
+	currentVendorAdvertisementsPrefix := currentVendorPrefix.Group("/advertisements", m.StaffMiddleware.Require(enums.PermissionViewRevenue))
+
+	currentVendorAdvertisementsPrefix.GET("/stats", c.AdvertisementController.GetCurrentVendorAdvertisementsStats)
 
 	// Current user orders endpoints
 	currentUserOrdersPrefix := currentUserPrefix.Group("/orders")
@@ -96,14 +159,19 @@ func NewServer() (*echo.Echo, error) {
 	currentUserOrdersPrefix.GET("/:id", c.OrderController.GetCurrentUserOrderDetail)
 
 	// Current vendor orders endpoints
-	currentVendorOrdersPrefix := currentVendorPrefix.Group("/orders")
+	currentVendorOrdersPrefix := currentVendorPrefix.Group("/orders", m.StaffMiddleware.Require(enums.PermissionViewBookings))
 
 	currentVendorOrdersPrefix.GET("", c.OrderController.GetCurrentVendorOrders)
 
-	currentVendorOrdersPrefix.GET("/stats", c.OrderController.GetCurrentVendorOrdersStats)
+	currentVendorOrdersPrefix.GET("/stats", c.OrderController.GetCurrentVendorOrdersStats, m.StaffMiddleware.Require(enums.PermissionViewRevenue))
+
+	currentVendorOrdersPrefix.POST("/walk-in", c.OrderController.CreateWalkInOrder, m.StaffMiddleware.Require(enums.PermissionRecordWalkIns))
 
 	currentVendorOrdersPrefix.GET("/:id", c.OrderController.GetCurrentVendorOrderDetail)
 
+	// Court types endpoints
+	prefix.GET("/court-types", c.CourtTypeController.GetCourtTypes)
+
 	// Courts endpoints
 	courtPrefix := prefix.Group("/courts")
 
@@ -119,11 +187,11 @@ func NewServer() (*echo.Echo, error) {
 	vendorTypeCourtsPrefix.GET("/bookings", c.CourtController.GetCourtBookings)
 
 	// Current vendor courts endpoints
-	currentVendorCourtsPrefix := currentVendorPrefix.Group("/courts")
+	currentVendorCourtsPrefix := currentVendorPrefix.Group("/courts", m.StaffMiddleware.Require(enums.PermissionViewBookings))
 
 	currentVendorCourtsPrefix.GET("/stats", c.CourtController.GetCurrentVendorCourtStats)
 
-	currentVendorCourtsPrefix.DELETE("", c.CourtController.DeleteCourts)
+	currentVendorCourtsPrefix.DELETE("", c.CourtController.DeleteCourts, m.StaffMiddleware.Require(enums.PermissionManageCourts))
 
 	// Current vendor courts types endpoints
 	currentVendorCourtsTypePrefix := currentVendorCourtsPrefix.Group("/:type")
@@ -132,29 +200,195 @@ func NewServer() (*echo.Echo, error) {
 
 	currentVendorCourtsTypePrefix.GET("", c.CourtController.GetCurrentVendorCourtsUsingCourtType)
 
-	currentVendorCourtsTypePrefix.POST("", c.CourtController.AddCourt)
+	currentVendorCourtsTypePrefix.POST("", c.CourtController.AddCourt, m.StaffMiddleware.Require(enums.PermissionManageCourts))
+
+	currentVendorCourtsTypePrefix.PUT("", c.CourtController.UpdateCourtUsingCourtType, m.StaffMiddleware.Require(enums.PermissionManageCourts))
+
+	currentVendorCourtsTypePrefix.POST("/new", c.CourtController.CreateNewCourt, m.StaffMiddleware.Require(enums.PermissionManageCourts), m.UploadMiddleware.Limit)
 
-	currentVendorCourtsTypePrefix.PUT("", c.CourtController.UpdateCourtUsingCourtType)
+	currentVendorCourtsTypePrefix.POST("/links", c.CourtController.LinkCourts, m.StaffMiddleware.Require(enums.PermissionManageCourts))
 
-	currentVendorCourtsTypePrefix.POST("/new", c.CourtController.CreateNewCourt)
+	currentVendorCourtsTypePrefix.DELETE("/links", c.CourtController.UnlinkCourts, m.StaffMiddleware.Require(enums.PermissionManageCourts))
 
 	// Reviews endpoints
 	vendorTypeCourtsPrefix.GET("/reviews", c.ReviewController.GetCourtTypeReviews)
 
-	vendorTypeCourtsPrefix.POST("/reviews", c.ReviewController.CreateReview, m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield, m.UserMiddleware.Shield)
+	vendorTypeCourtsPrefix.POST("/reviews", c.ReviewController.CreateReview, m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield, m.UserMiddleware.Shield, m.UploadMiddleware.ReviewImagesLimit)
 
 	currentVendorPrefix.GET("/reviews", c.ReviewController.GetCurrentVendorReviews)
 
+	currentVendorPrefix.PUT("/reviews/:id/reply", c.ReviewController.ReplyReview, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+
+	currentVendorPrefix.DELETE("/reviews/:id/reply", c.ReviewController.DeleteReviewReply, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+
+	currentVendorPrefix.POST("/reviews/:id/report", c.ReviewController.ReportReview, m.StaffMiddleware.Require(enums.PermissionManageVenue))
+
+	prefix.POST("/reviews/:id/report", c.ReviewController.ReportReview, m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield, m.UserMiddleware.Shield)
+
+	prefix.POST("/reviews/:id/helpful", c.ReviewController.MarkReviewHelpful, m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield, m.UserMiddleware.Shield)
+
+	prefix.DELETE("/reviews/:id/helpful", c.ReviewController.UnmarkReviewHelpful, m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield, m.UserMiddleware.Shield)
+
+	// Current vendor staff endpoints
+	currentVendorStaffPrefix := currentVendorPrefix.Group("/staff", m.StaffMiddleware.Require(enums.PermissionManageAccount))
+
+	currentVendorStaffPrefix.GET("", c.StaffController.GetStaffMembers)
+
+	currentVendorStaffPrefix.POST("", c.StaffController.CreateStaff)
+
+	currentVendorStaffPrefix.GET("/activities", c.StaffController.GetStaffActivities)
+
+	currentVendorStaffPrefix.PATCH("/:id", c.StaffController.UpdateStaff)
+
+	currentVendorStaffPrefix.DELETE("/:id", c.StaffController.DeleteStaff)
+
 	// Fees endpoint
 	prefix.GET("/fees", c.FeesController.GetFees, m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield)
 
 	// Advertisements endpoints
-	prefix.GET("/advertisements", c.AdvertisementController.GetAdvertisements)
+	advertisementsPrefix := prefix.Group("/advertisements")
+
+	advertisementsPrefix.GET("", c.AdvertisementController.GetAdvertisements)
+
+	advertisementsPrefix.GET("/:id/click", c.AdvertisementController.ClickAdvertisement)
+
+	// Admin endpoints
+	adminPrefix := prefix.Group("/admin", m.AuthMiddleware.Shield, m.BlacklistedTokenMiddleware.Shield, m.AdminMiddleware.Shield)
+
+	adminPrefix.GET("/me", c.AdminController.GetCurrentAdmin)
+
+	adminPrefix.GET("/audit-logs", c.AdminController.GetAuditLogs)
+
+	// Admin users endpoints
+	adminUsersPrefix := adminPrefix.Group("/users")
+
+	adminUsersPrefix.GET("", c.UserController.GetUsers)
+
+	adminUsersPrefix.GET("/:id", c.UserController.GetUser)
+
+	adminUsersPrefix.POST("/:id/disable", c.UserController.DisableUser)
+
+	adminUsersPrefix.POST("/:id/enable", c.UserController.EnableUser)
+
+	// Admin court types endpoints
+	adminCourtTypesPrefix := adminPrefix.Group("/court-types")
+
+	adminCourtTypesPrefix.GET("", c.CourtTypeController.GetAllCourtTypes)
+
+	adminCourtTypesPrefix.POST("", c.CourtTypeController.CreateCourtType)
+
+	adminCourtTypesPrefix.PATCH("/:id", c.CourtTypeController.RenameCourtType)
+
+	adminCourtTypesPrefix.PATCH("/:id/icon", c.CourtTypeController.UpdateCourtTypeIcon)
+
+	adminCourtTypesPrefix.POST("/:id/retire", c.CourtTypeController.RetireCourtType)
+
+	adminCourtTypesPrefix.POST("/:id/restore", c.CourtTypeController.RestoreCourtType)
+
+	// Admin vendors endpoints
+	adminVendorsPrefix := adminPrefix.Group("/vendors")
+
+	adminVendorsPrefix.GET("", c.VendorApprovalController.GetVendors)
+
+	adminVendorsPrefix.GET("/:id", c.VendorApprovalController.GetVendor)
+
+	adminVendorsPrefix.GET("/:id/documents/:documentId", c.VendorApprovalController.GetVendorDocument)
+
+	adminVendorsPrefix.POST("/:id/approve", c.VendorApprovalController.ApproveVendor)
+
+	adminVendorsPrefix.POST("/:id/reject", c.VendorApprovalController.RejectVendor)
+
+	adminVendorsPrefix.POST("/:id/suspend", c.VendorApprovalController.SuspendVendor)
+
+	adminVendorsPrefix.POST("/:id/reactivate", c.VendorApprovalController.ReactivateVendor)
+
+	adminVendorsPrefix.GET("/:id/courts", c.CourtController.GetVendorCourts)
+
+	adminVendorsPrefix.DELETE("/:id/courts", c.CourtController.DeleteVendorCourts)
+
+	// Admin reviews endpoints
+	adminReviewsPrefix := adminPrefix.Group("/reviews")
+
+	adminReviewsPrefix.GET("", c.ReviewController.GetReviews)
+
+	adminReviewsPrefix.GET("/queue", c.ReviewController.GetModerationQueue)
+
+	adminReviewsPrefix.GET("/:id/history", c.ReviewController.GetReviewHistory)
+
+	adminReviewsPrefix.POST("/:id/approve", c.ReviewController.ApproveReview)
+
+	adminReviewsPrefix.POST("/:id/hide", c.ReviewController.HideReview)
+
+	adminReviewsPrefix.DELETE("/:id", c.ReviewController.DeleteReview)
+
+	// Admin orders endpoints
+	adminOrdersPrefix := adminPrefix.Group("/orders")
+
+	adminOrdersPrefix.GET("", c.OrderController.GetOrders)
+
+	adminOrdersPrefix.GET("/:id", c.OrderController.GetOrderDetail)
+
+	// Admin advertisements endpoints
+	adminAdvertisementsPrefix := adminPrefix.Group("/advertisements")
+
+	adminAdvertisementsPrefix.GET("", c.AdvertisementController.GetAllAdvertisements)
+
+	adminAdvertisementsPrefix.POST("", c.AdvertisementController.CreateAdvertisement, m.UploadMiddleware.Limit)
+
+	adminAdvertisementsPrefix.GET("/:id", c.AdvertisementController.GetAdvertisement)
+
+	adminAdvertisementsPrefix.GET("/:id/stats", c.AdvertisementController.GetAdvertisementStats)
+
+	adminAdvertisementsPrefix.PATCH("/:id", c.AdvertisementController.UpdateAdvertisement)
+
+	adminAdvertisementsPrefix.POST("/:id/pause", c.AdvertisementController.PauseAdvertisement)
+
+	adminAdvertisementsPrefix.POST("/:id/resume", c.AdvertisementController.ResumeAdvertisement)
+
+	adminAdvertisementsPrefix.DELETE("/:id", c.AdvertisementController.DeleteAdvertisement)
 
 	// Midtrans endpoints
 	midtransPrefix := e.Group("/midtrans")
 
 	midtransPrefix.POST("/payment-callback", c.MidtransController.PaymentCallback)
 
-	return e, e.Start(":" + strconv.Itoa(config.ServerConfig.Port))
+	// Stop the server when it's interrupted or terminated
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+
+	defer stop()
+
+	// Write the buffered advertisement impressions and clicks in the background
+	go u.AdvertisementStatsUseCase.FlushInterval(ctx, constants.ADVERTISEMENT_STATS_FLUSH_INTERVAL)
+
+	// Start the server in the background
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- e.Start(":" + strconv.Itoa(config.ServerConfig.Port))
+	}()
+
+	// Wait for the server to fail or to be stopped
+	select {
+	case err := <-errs:
+		// Write the buffered stats before exiting
+		u.AdvertisementStatsUseCase.Flush()
+
+		return e, err
+	case <-ctx.Done():
+	}
+
+	// Write the buffered stats before shutting down
+	u.AdvertisementStatsUseCase.Flush()
+
+	// Shut down the server after the running requests finish
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), constants.SERVER_SHUTDOWN_TIMEOUT)
+
+	defer cancel()
+
+	err := e.Shutdown(shutdownCtx)
+
+	// Write the stats buffered by the requests that finished during the shutdown
+	u.AdvertisementStatsUseCase.Flush()
+
+	return e, err
 }
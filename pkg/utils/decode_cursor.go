@@ -0,0 +1,41 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"main/core/types"
+)
+
+// DecodeCursor is a function that decodes an opaque cursor string.
+//
+// s: The encoded cursor.
+//
+// Returns the cursor and an error if any.
+func DecodeCursor(s string) (*types.Cursor, error) {
+	// Decode the base64 string
+	data, err := base64.RawURLEncoding.DecodeString(s)
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a new cursor
+	cursor := new(types.Cursor)
+
+	// Unmarshal the cursor
+	err = json.Unmarshal(data, cursor)
+
+	// Return an error if any
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the cursor points to an item
+	if cursor.ID == 0 {
+		return nil, errors.New("cursor has no id")
+	}
+
+	return cursor, nil
+}
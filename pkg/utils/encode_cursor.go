@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"main/core/types"
+)
+
+// EncodeCursor is a function that encodes a cursor into an opaque string.
+//
+// cursor: The cursor.
+//
+// Returns the encoded cursor.
+func EncodeCursor(cursor *types.Cursor) string {
+	// Marshal the cursor, a cursor always holds json values
+	data, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -0,0 +1,113 @@
+package utils
+
+import "encoding/binary"
+
+// GetJPEGOrientation is a function that reads the EXIF orientation of a JPEG image.
+//
+// data: The JPEG image bytes.
+//
+// Returns the EXIF orientation from 1 to 8, 1 if the image has no orientation.
+func GetJPEGOrientation(data []byte) int {
+	// Check the JPEG start of image marker
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	// Loop through the JPEG segments
+	for i := 2; i+4 <= len(data); {
+		// Stop if the segment marker is invalid
+		if data[i] != 0xFF {
+			return 1
+		}
+
+		// Get the segment marker
+		marker := data[i+1]
+
+		// Stop at the start of scan, the metadata is always before it
+		if marker == 0xDA || marker == 0xD9 {
+			return 1
+		}
+
+		// Get the segment length
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+
+		// Stop if the segment length is invalid
+		if length < 2 || i+2+length > len(data) {
+			return 1
+		}
+
+		// Read the orientation from the EXIF segment
+		if marker == 0xE1 {
+			if orientation := exifOrientation(data[i+4 : i+2+length]); orientation != 0 {
+				return orientation
+			}
+		}
+
+		i += 2 + length
+	}
+
+	return 1
+}
+
+// exifOrientation is a helper function that reads the orientation tag of an EXIF segment.
+//
+// segment: The EXIF segment bytes.
+//
+// Returns the orientation, 0 if the segment has no orientation.
+func exifOrientation(segment []byte) int {
+	// Check the EXIF header
+	if len(segment) < 14 || string(segment[:6]) != "Exif\x00\x00" {
+		return 0
+	}
+
+	// Get the TIFF header
+	tiff := segment[6:]
+
+	// Get the byte order of the TIFF header
+	var order binary.ByteOrder
+
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+
+	// Get the first image file directory offset
+	offset := int(order.Uint32(tiff[4:8]))
+
+	// Check the image file directory offset
+	if offset < 8 || offset+2 > len(tiff) {
+		return 0
+	}
+
+	// Get the image file directory entries count
+	count := int(order.Uint16(tiff[offset:]))
+
+	// Loop through the image file directory entries
+	for j := 0; j < count; j++ {
+		// Get the entry offset
+		entry := offset + 2 + j*12
+
+		// Stop if the entry is out of range
+		if entry+12 > len(tiff) {
+			return 0
+		}
+
+		// Check the orientation tag
+		if order.Uint16(tiff[entry:]) == 0x0112 {
+			orientation := int(order.Uint16(tiff[entry+8:]))
+
+			// Check the orientation value
+			if orientation < 1 || orientation > 8 {
+				return 0
+			}
+
+			return orientation
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,21 @@
+package utils
+
+import "strings"
+
+// ImageVariantName is a function that returns the file name of an image size variant.
+//
+// name: The original image file name.
+// size: The image size name.
+//
+// Returns the file name of the image size variant.
+func ImageVariantName(name string, size string) string {
+	// Get the file extension index
+	dot := strings.LastIndex(name, ".")
+
+	// Append the size when the file has no extension
+	if dot < 0 {
+		return name + "_" + size
+	}
+
+	return name[:dot] + "_" + size + name[dot:]
+}
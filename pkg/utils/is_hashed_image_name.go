@@ -0,0 +1,16 @@
+package utils
+
+import "regexp"
+
+// hashedImageNameRegex is the regex of the content hashed image file names.
+var hashedImageNameRegex = regexp.MustCompile(`^[0-9a-f]{64}\.jpg$`)
+
+// IsHashedImageName is a function that checks if an image file name is content hashed,
+// only content hashed images have the size variants.
+//
+// name: The image file name.
+//
+// Returns true if the image file name is content hashed, false otherwise.
+func IsHashedImageName(name string) bool {
+	return hashedImageNameRegex.MatchString(name)
+}
@@ -0,0 +1,16 @@
+package utils
+
+import "regexp"
+
+// IsValidPhoneNumber is a function that checks if a phone number is valid,
+// an optional leading plus sign followed by 8 to 15 digits.
+//
+// s: The phone number.
+//
+// Returns a boolean.
+func IsValidPhoneNumber(s string) bool {
+	// Regular expression for phone number validation
+	regex := regexp.MustCompile(`^\+?[0-9]{8,15}$`)
+
+	return regex.MatchString(s)
+}